@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	debugTest     string
+	debugInputs   string
+	debugHost     string
+	debugPort     int
+	debugUsername string
+)
+
+var DebugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "Print the eAPI requests a test would send",
+	Long: `The debug command builds a single test from its inputs, collects the commands
+it would run without contacting a device, and prints the eAPI JSON-RPC payload
+and a ready-to-paste curl command for each one, so a check can be reproduced
+on the box by hand.`,
+	Example: `  go-anta debug --test VerifyBGPPeers --inputs inputs.yml
+  go-anta debug --test routing/VerifyBGPPeers --inputs inputs.yml --host 192.0.2.10`,
+	RunE: runDebug,
+}
+
+func init() {
+	DebugCmd.Flags().StringVar(&debugTest, "test", "", "test name, optionally as module/name (required)")
+	DebugCmd.Flags().StringVar(&debugInputs, "inputs", "", "YAML file with the test's inputs")
+	DebugCmd.Flags().StringVar(&debugHost, "host", "DEVICE", "device host to use in the curl commands")
+	DebugCmd.Flags().IntVar(&debugPort, "port", 443, "eAPI port to use in the curl commands")
+	DebugCmd.Flags().StringVar(&debugUsername, "username", "admin", "username to use in the curl commands")
+
+	_ = DebugCmd.MarkFlagRequired("test")
+}
+
+func runDebug(cmd *cobra.Command, args []string) error {
+	reg := test.GetRegistry()
+	module, name, err := resolveTestName(reg, debugTest)
+	if err != nil {
+		return err
+	}
+
+	inputs := map[string]interface{}{}
+	if debugInputs != "" {
+		data, err := os.ReadFile(debugInputs)
+		if err != nil {
+			return fmt.Errorf("failed to read inputs: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &inputs); err != nil {
+			return fmt.Errorf("failed to parse inputs: %w", err)
+		}
+	}
+
+	t, err := reg.GetTestWithInputs(module, name, inputs)
+	if err != nil {
+		return err
+	}
+	if err := t.ValidateInput(inputs); err != nil {
+		return fmt.Errorf("%s/%s: invalid inputs: %w", module, name, err)
+	}
+
+	cmds, err := test.CollectCommands(context.Background(), t)
+	if err != nil {
+		return fmt.Errorf("dry run: %w", err)
+	}
+	if len(cmds) == 0 {
+		return fmt.Errorf("%s/%s issued no commands", module, name)
+	}
+
+	out := cmd.OutOrStdout()
+	for i, c := range cmds {
+		payload, err := device.EAPIPayload(c)
+		if err != nil {
+			return fmt.Errorf("command %q: %w", c.Template, err)
+		}
+		curl, err := device.EAPICurl(debugHost, debugPort, debugUsername, c)
+		if err != nil {
+			return fmt.Errorf("command %q: %w", c.Template, err)
+		}
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "# %s\n%s\n%s\n", c.Template, payload, curl)
+	}
+	return nil
+}
+
+// resolveTestName accepts "module/Name" or a bare test name, which must
+// then be registered in exactly one module.
+func resolveTestName(reg *test.Registry, ref string) (module, name string, err error) {
+	if module, name, ok := strings.Cut(ref, "/"); ok {
+		return module, name, nil
+	}
+	var modules []string
+	for _, info := range reg.List() {
+		if info.Name == ref {
+			modules = append(modules, info.Module)
+		}
+	}
+	switch len(modules) {
+	case 0:
+		return "", "", fmt.Errorf("test %s not found (see go-anta list-tests)", ref)
+	case 1:
+		return modules[0], ref, nil
+	default:
+		return "", "", fmt.Errorf("test %s is registered in several modules (%s); use module/name", ref, strings.Join(modules, ", "))
+	}
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fluidstackio/go-anta/pkg/test"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listModule string
+	listJSON   bool
+)
+
+var ListTestsCmd = &cobra.Command{
+	Use:   "list-tests",
+	Short: "List available tests",
+	Long: `The list-tests command prints every registered test with its module,
+categories, and a one-line description, to help author catalogs.`,
+	Example: `  go-anta list-tests
+  go-anta list-tests --module routing
+  go-anta list-tests --json`,
+	RunE: runListTests,
+}
+
+func init() {
+	ListTestsCmd.Flags().StringVarP(&listModule, "module", "m", "", "only list tests in this module")
+	ListTestsCmd.Flags().BoolVar(&listJSON, "json", false, "output as JSON")
+}
+
+func runListTests(cmd *cobra.Command, args []string) error {
+	infos := make([]test.TestInfo, 0)
+	for _, info := range test.GetRegistry().List() {
+		if listModule == "" || info.Module == listModule {
+			infos = append(infos, info)
+		}
+	}
+	if len(infos) == 0 && listModule != "" {
+		return fmt.Errorf("no tests registered in module %q", listModule)
+	}
+
+	out := cmd.OutOrStdout()
+	if listJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(infos)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODULE\tNAME\tCATEGORIES\tDESCRIPTION")
+	for _, info := range infos {
+		cats := "-"
+		if len(info.Categories) > 0 {
+			cats = strings.Join(info.Categories, ",")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", info.Module, info.Name, cats, info.Description)
+	}
+	return w.Flush()
+}
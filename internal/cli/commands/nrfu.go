@@ -12,7 +12,6 @@ import (
 	"time"
 
 	"github.com/fluidstackio/go-anta/internal/logger"
-	"github.com/fluidstackio/go-anta/pkg/device"
 	"github.com/fluidstackio/go-anta/pkg/inventory"
 	"github.com/fluidstackio/go-anta/pkg/reporter"
 	"github.com/fluidstackio/go-anta/pkg/test"
@@ -34,6 +33,9 @@ var (
 	concurrency    int
 	dryRun         bool
 	ignoreStatus   bool
+	failOn         string
+	testTimeout    time.Duration
+	baselineFile   string
 	hide           string
 	outputFile     string
 	logLevel       string
@@ -77,6 +79,9 @@ func init() {
 	NrfuCmd.Flags().IntVarP(&concurrency, "concurrency", "j", 10, "maximum concurrent connections")
 	NrfuCmd.Flags().BoolVar(&dryRun, "dry-run", false, "show what would be executed without running")
 	NrfuCmd.Flags().BoolVar(&ignoreStatus, "ignore-status", false, "always return exit code 0")
+	NrfuCmd.Flags().DurationVar(&testTimeout, "test-timeout", 0, "maximum time a single test may run on one device, e.g. 2m (0 for no limit; a catalog entry's timeout overrides it)")
+	NrfuCmd.Flags().StringVar(&baselineFile, "baseline", "", "JSON file of recorded command output; counter tests then check increases since it")
+	NrfuCmd.Flags().StringVar(&failOn, "fail-on", "minor", "lowest severity whose failures set a non-zero exit code (critical, major, minor)")
 	NrfuCmd.Flags().StringVar(&hide, "hide", "", "hide results by status (success, failure, error, skipped)")
 	NrfuCmd.Flags().StringVarP(&outputFile, "output", "o", "", "output file path (default: report.html in cwd; use - for stdout)")
 	NrfuCmd.Flags().StringVar(&logLevel, "log-level", "warn", "log level (trace, debug, info, warn, error, fatal)")
@@ -92,88 +97,47 @@ func runNrfu(cmd *cobra.Command, args []string) error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	// Validate the transport override early so a bad value produces a
-	// clear error instead of silently failing every device-construct
-	// call inside the connect loop.
-	switch transport {
-	case "", "eapi", "gnmi":
-	default:
-		return fmt.Errorf("unknown --transport value %q (supported: eapi, gnmi)", transport)
+	session := &runSession{
+		Inventory: InventoryLoadOptions{
+			Path:           inventoryFile,
+			SourceOverride: source,
+			NetboxURL:      netboxURL,
+			NetboxToken:    netboxToken,
+			NetboxQuery:    netboxQuery,
+			Region:         region,
+			Filter:         filter,
+			Defaults: inventory.DeviceDefaults{
+				Username:  deviceUsername,
+				Password:  devicePassword,
+				Transport: transport,
+				Insecure:  true, // existing default for lab use
+				Plaintext: plaintext,
+			},
+		},
+		CatalogFile:  catalogFile,
+		Transport:    transport,
+		Tags:         splitList(tags),
+		Devices:      splitList(devices),
+		Limit:        limit,
+		Tests:        splitList(tests),
+		Concurrency:  concurrency,
+		Timeout:      testTimeout,
+		BaselineFile: baselineFile,
+		FailOn:       failOn,
+		Progress:     progress && !quiet && !silent,
+		ConnectError: func(name string, err error) {
+			if !silent {
+				fmt.Fprintf(os.Stderr, "Warning: Failed to connect to %s: %v\n", name, err)
+			}
+		},
 	}
 
 	// Configure logging based on flags IMMEDIATELY before any other operations
 	configureLogging()
-	runStart := time.Now()
 
-	inv, err := LoadInventoryForRun(ctx, InventoryLoadOptions{
-		Path:           inventoryFile,
-		SourceOverride: source,
-		NetboxURL:      netboxURL,
-		NetboxToken:    netboxToken,
-		NetboxQuery:    netboxQuery,
-		Region:         region,
-		Filter:         filter,
-		Defaults: inventory.DeviceDefaults{
-			Username:  deviceUsername,
-			Password:  devicePassword,
-			Transport: transport,
-			Insecure:  true, // existing default for lab use
-			Plaintext: plaintext,
-		},
-	})
+	inv, catalog, err := session.load(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to load inventory: %w", err)
-	}
-
-	catalog, err := test.LoadCatalog(catalogFile)
-	if err != nil {
-		return fmt.Errorf("failed to load catalog: %w", err)
-	}
-
-	if tags != "" {
-		var fErr error
-		inv, fErr = inv.FilterByTags(strings.Split(tags, ","))
-		if fErr != nil {
-			return fmt.Errorf("--tags filter: %w", fErr)
-		}
-	}
-
-	if devices != "" {
-		var fErr error
-		inv, fErr = inv.FilterByNames(strings.Split(devices, ","))
-		if fErr != nil {
-			return fmt.Errorf("--devices filter: %w", fErr)
-		}
-	}
-
-	if limit != "" {
-		var fErr error
-		inv, fErr = inv.FilterByLimit(limit)
-		if fErr != nil {
-			return fmt.Errorf("--limit filter: %w", fErr)
-		}
-	}
-
-	if tests != "" {
-		var fErr error
-		catalog, fErr = catalog.FilterByName(strings.Split(tests, ","))
-		if fErr != nil {
-			return fmt.Errorf("--tests filter: %w", fErr)
-		}
-	}
-
-	// R7: catch typos in catalog (Module, Name) tuples upfront. Without
-	// this, an unknown test surfaces as a per-device "Test not found"
-	// — N devices × M unknown tests duplicate errors instead of one.
-	if err := catalog.ValidateAgainst(test.GetRegistry()); err != nil {
-		return fmt.Errorf("catalog: %w", err)
-	}
-
-	if len(inv.Devices) == 0 {
-		return fmt.Errorf("no devices to run against (check your inventory / filters)")
-	}
-	if len(catalog.Tests) == 0 {
-		return fmt.Errorf("no tests to run (check your catalog / filters)")
+		return err
 	}
 
 	if dryRun {
@@ -208,78 +172,24 @@ func runNrfu(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	deviceList := make([]device.Device, 0, len(inv.Devices))
-	deviceInfo := make([]reporter.DeviceInfo, 0, len(inv.Devices))
-	for _, devConfig := range inv.Devices {
-		if transport != "" {
-			devConfig.Transport = transport
-		}
-		info := reporter.DeviceInfo{
-			Name:      devConfig.Name,
-			Host:      devConfig.Host,
-			Transport: devConfig.Transport,
-			Port:      devConfig.Port,
-			Tags:      devConfig.Tags,
-		}
-		dev, err := device.New(devConfig)
-		if err != nil {
-			logger.Errorf("Failed to construct device %s: %v", devConfig.Name, err)
-			info.ConnectError = err.Error()
-			deviceInfo = append(deviceInfo, info)
-			continue
-		}
-		if err := dev.Connect(ctx); err != nil {
-			if !silent {
-				fmt.Fprintf(os.Stderr, "Warning: Failed to connect to %s: %v\n", devConfig.Name, err)
-			}
-			info.ConnectError = err.Error()
-			deviceInfo = append(deviceInfo, info)
-			continue
-		}
-		info.Connected = true
-		info.Model = dev.HardwareModel()
-		// EOS version is exposed in `show version`'s `version` field;
-		// Connect already ran the probe but doesn't store the version,
-		// so query once more here. Cheap, and only on devices the test
-		// run will actually use.
-		if v, err := dev.Execute(ctx, device.Command{Template: "show version", Format: "json", UseCache: true}); err == nil {
-			if m, ok := v.Output.(map[string]any); ok {
-				if ver, ok := m["version"].(string); ok {
-					info.EOSVersion = ver
-				}
-			}
-		}
-		deviceList = append(deviceList, dev)
-		deviceInfo = append(deviceInfo, info)
-		defer dev.Disconnect()
+	outcome, err := session.execute(ctx, inv, catalog)
+	if err != nil {
+		return err
 	}
-
-	if len(deviceList) == 0 {
+	if outcome.Connected == 0 {
 		return fmt.Errorf("no devices available for testing")
 	}
 
-	// Use progress runner if progress is enabled, otherwise use standard runner
-	var results []test.TestResult
-	if progress && !quiet && !silent {
-		progressRunner := test.NewProgressRunner(concurrency, true)
-		results, err = progressRunner.Run(ctx, catalog.Tests, deviceList)
-	} else {
-		runner := test.NewRunner(concurrency)
-		results, err = runner.Run(ctx, catalog.Tests, deviceList)
-	}
-	if err != nil {
-		return fmt.Errorf("failed to run tests: %w", err)
-	}
-
+	results := outcome.Results
 	if hide != "" {
 		results = filterResults(results, hide)
 	}
 
 	report := &reporter.Report{
 		Title:     fmt.Sprintf("nrfu — %s", catalogFile),
-		Started:   runStart,
+		Started:   outcome.Started,
 		Completed: time.Now(),
-		Devices:   deviceInfo,
+		Devices:   outcome.Devices,
 		Results:   results,
 	}
 
@@ -306,12 +216,8 @@ func runNrfu(cmd *cobra.Command, args []string) error {
 		fmt.Fprintf(os.Stderr, "Report written to %s\n", outPath)
 	}
 
-	if !ignoreStatus {
-		for _, result := range results {
-			if result.Status == test.TestFailure || result.Status == test.TestError {
-				return ErrTestsFailed
-			}
-		}
+	if !ignoreStatus && outcome.Failed {
+		return ErrTestsFailed
 	}
 
 	return nil
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/inventory"
+	"github.com/fluidstackio/go-anta/pkg/reporter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	runInventoryFile  string
+	runCatalogFile    string
+	runReporter       string
+	runOutputFile     string
+	runConcurrency    int
+	runTags           string
+	runCategories     string
+	runDeviceUsername string
+	runDevicePassword string
+	runTransport      string
+	runFailOn         string
+	runTestTimeout    time.Duration
+	runBaselineFile   string
+)
+
+// DeviceFactory constructs the device for each inventory entry in the run
+// command. Tests replace it to run against device.FakeDevice.
+var DeviceFactory = device.New
+
+var RunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run a test catalog against an inventory",
+	Long: `The run command executes every test in a catalog against the devices in an
+inventory and writes the results through the selected reporter. It is the
+scripting-friendly counterpart to nrfu: no progress bars, output to stdout by
+default, and a non-zero exit code when any test fails or errors.`,
+	Example: `  go-anta run --inventory inv.yml --catalog cat.yml --reporter json --concurrency 16
+  go-anta run -i inv.yml -c cat.yml --tags spine --categories bgp,routing`,
+	RunE: runRun,
+}
+
+func init() {
+	RunCmd.Flags().StringVarP(&runInventoryFile, "inventory", "i", "", "inventory file path (required)")
+	RunCmd.Flags().StringVarP(&runCatalogFile, "catalog", "c", "", "test catalog file path (required)")
+	RunCmd.Flags().StringVarP(&runReporter, "reporter", "r", "json", fmt.Sprintf("result reporter (%s)", strings.Join(reporter.Formats, ", ")))
+	RunCmd.Flags().StringVarP(&runOutputFile, "output", "o", "-", "output file path (- for stdout)")
+	RunCmd.Flags().IntVarP(&runConcurrency, "concurrency", "j", 10, "maximum concurrent test executions")
+	RunCmd.Flags().StringVarP(&runTags, "tags", "t", "", "filter devices by tags (comma-separated)")
+	RunCmd.Flags().StringVar(&runCategories, "categories", "", "filter tests by category (comma-separated)")
+	RunCmd.Flags().StringVar(&runDeviceUsername, "device-username", "", "device username (overrides DEVICE_USERNAME env var)")
+	RunCmd.Flags().StringVar(&runDevicePassword, "device-password", "", "device password (overrides DEVICE_PASSWORD env var)")
+	RunCmd.Flags().StringVar(&runTransport, "transport", "", "transport for device connections: eapi or gnmi (overrides per-device YAML transport)")
+	RunCmd.Flags().DurationVar(&runTestTimeout, "test-timeout", 0, "maximum time a single test may run on one device, e.g. 2m (0 for no limit; a catalog entry's timeout overrides it)")
+	RunCmd.Flags().StringVar(&runBaselineFile, "baseline", "", "JSON file of recorded command output; counter tests then check increases since it")
+	RunCmd.Flags().StringVar(&runFailOn, "fail-on", "minor", "lowest severity whose failures set a non-zero exit code (critical, major, minor)")
+
+	_ = RunCmd.MarkFlagRequired("inventory")
+	_ = RunCmd.MarkFlagRequired("catalog")
+}
+
+func runRun(cmd *cobra.Command, args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	render, err := reporter.ForFormat(runReporter)
+	if err != nil {
+		return err
+	}
+
+	session := &runSession{
+		Inventory: InventoryLoadOptions{
+			Path: runInventoryFile,
+			Defaults: inventory.DeviceDefaults{
+				Username:  runDeviceUsername,
+				Password:  runDevicePassword,
+				Transport: runTransport,
+				Insecure:  true, // existing default for lab use
+			},
+		},
+		CatalogFile:  runCatalogFile,
+		Transport:    runTransport,
+		Tags:         splitList(runTags),
+		Categories:   splitList(runCategories),
+		Concurrency:  runConcurrency,
+		Timeout:      runTestTimeout,
+		BaselineFile: runBaselineFile,
+		FailOn:       runFailOn,
+	}
+	inv, catalog, err := session.load(ctx)
+	if err != nil {
+		return err
+	}
+	outcome, err := session.execute(ctx, inv, catalog)
+	if err != nil {
+		return err
+	}
+
+	report := &reporter.Report{
+		Title:     fmt.Sprintf("run — %s", runCatalogFile),
+		Started:   outcome.Started,
+		Completed: time.Now(),
+		Devices:   outcome.Devices,
+		Results:   outcome.Results,
+	}
+
+	var output io.Writer = cmd.OutOrStdout()
+	if runOutputFile != "-" {
+		file, err := os.Create(runOutputFile)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer file.Close()
+		output = file
+	}
+	if err := render(output, report); err != nil {
+		return fmt.Errorf("failed to render %s report: %w", runReporter, err)
+	}
+
+	if outcome.ConnectFailed || outcome.Failed {
+		return ErrTestsFailed
+	}
+	return nil
+}
+
+// splitList splits a comma-separated flag value, dropping blanks.
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fluidstackio/go-anta/pkg/test"
+	"github.com/spf13/cobra"
+)
+
+var schemaTest string
+
+var SchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON schema of a test's inputs",
+	Long: `The schema command prints the JSON schema describing the inputs a test
+accepts, for editor completion and for checking catalogs in CI. validate-catalog
+applies the same schema before building each test. Not every test publishes a
+schema yet.`,
+	Example: `  go-anta schema --test VerifyBGPPeers
+  go-anta schema --test routing/VerifyBGPPeerSession > bgp-peer-session.schema.json`,
+	RunE: runSchema,
+}
+
+func init() {
+	SchemaCmd.Flags().StringVar(&schemaTest, "test", "", "test name, optionally as module/name (required)")
+
+	_ = SchemaCmd.MarkFlagRequired("test")
+}
+
+func runSchema(cmd *cobra.Command, args []string) error {
+	reg := test.GetRegistry()
+	module, name, err := resolveTestName(reg, schemaTest)
+	if err != nil {
+		return err
+	}
+	schema, ok := reg.InputSchema(module, name)
+	if !ok {
+		return fmt.Errorf("%s/%s does not publish an input schema", module, name)
+	}
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode schema: %w", err)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(data))
+	return nil
+}
@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluidstackio/go-anta/internal/logger"
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/inventory"
+	"github.com/fluidstackio/go-anta/pkg/reporter"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// runSession bundles the flags run and nrfu share: where the inventory and
+// catalog come from, how to narrow them, and how to execute the tests.
+// Each command fills in the fields it has flags for, then calls load and
+// execute; rendering the report and picking the exit code stay with the
+// command.
+type runSession struct {
+	Inventory   InventoryLoadOptions
+	CatalogFile string
+	Transport   string // --transport; overrides every device's transport
+
+	// Filters; empty means no filtering.
+	Tags       []string // --tags
+	Devices    []string // --devices
+	Limit      string   // --limit
+	Tests      []string // --tests
+	Categories []string // --categories
+
+	Concurrency  int
+	Timeout      time.Duration // --test-timeout
+	BaselineFile string        // --baseline
+	FailOn       string        // --fail-on
+	Progress     bool          // use the progress bar runner
+
+	// ConnectError reports a device that could not be constructed or
+	// connected. Nil logs it as an error.
+	ConnectError func(name string, err error)
+
+	threshold test.Severity
+	baselines test.Baselines
+}
+
+// runOutcome is what execute hands back for the command to report.
+type runOutcome struct {
+	Started   time.Time
+	Devices   []reporter.DeviceInfo
+	Results   []test.TestResult
+	Connected int // devices the tests ran against
+
+	ConnectFailed bool // at least one device could not be reached
+	Failed        bool // a result fails at the --fail-on threshold
+}
+
+// load checks the session's flags, then loads, validates and filters the
+// inventory and catalog. It fails when either ends up empty.
+func (s *runSession) load(ctx context.Context) (*inventory.Inventory, *test.Catalog, error) {
+	// Validate the transport override early so a bad value produces a
+	// clear error instead of silently failing every device-construct
+	// call inside the connect loop.
+	switch s.Transport {
+	case "", "eapi", "gnmi":
+	default:
+		return nil, nil, fmt.Errorf("unknown --transport value %q (supported: eapi, gnmi)", s.Transport)
+	}
+	threshold, err := test.ParseSeverity(s.FailOn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("--fail-on: %w", err)
+	}
+	s.threshold = threshold
+	if s.BaselineFile != "" {
+		if s.baselines, err = test.LoadBaselines(s.BaselineFile); err != nil {
+			return nil, nil, fmt.Errorf("--baseline: %w", err)
+		}
+	}
+
+	inv, err := LoadInventoryForRun(ctx, s.Inventory)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load inventory: %w", err)
+	}
+
+	catalog, err := test.LoadCatalog(s.CatalogFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load catalog: %w", err)
+	}
+	// R7: catch typos in catalog (Module, Name) tuples upfront. Without
+	// this, an unknown test surfaces as a per-device "Test not found"
+	// — N devices × M unknown tests duplicate errors instead of one.
+	if err := catalog.ValidateAgainst(test.GetRegistry()); err != nil {
+		return nil, nil, fmt.Errorf("catalog: %w", err)
+	}
+
+	if len(s.Tags) > 0 {
+		if inv, err = inv.FilterByTags(s.Tags); err != nil {
+			return nil, nil, fmt.Errorf("--tags filter: %w", err)
+		}
+	}
+	if len(s.Devices) > 0 {
+		if inv, err = inv.FilterByNames(s.Devices); err != nil {
+			return nil, nil, fmt.Errorf("--devices filter: %w", err)
+		}
+	}
+	if s.Limit != "" {
+		if inv, err = inv.FilterByLimit(s.Limit); err != nil {
+			return nil, nil, fmt.Errorf("--limit filter: %w", err)
+		}
+	}
+	if len(s.Tests) > 0 {
+		if catalog, err = catalog.FilterByName(s.Tests); err != nil {
+			return nil, nil, fmt.Errorf("--tests filter: %w", err)
+		}
+	}
+	if len(s.Categories) > 0 {
+		if catalog, err = catalog.FilterByCategories(s.Categories, test.GetRegistry()); err != nil {
+			return nil, nil, fmt.Errorf("--categories filter: %w", err)
+		}
+	}
+
+	if len(inv.Devices) == 0 {
+		return nil, nil, fmt.Errorf("no devices to run against (check your inventory / filters)")
+	}
+	if len(catalog.Tests) == 0 {
+		return nil, nil, fmt.Errorf("no tests to run (check your catalog / filters)")
+	}
+	return inv, catalog, nil
+}
+
+// execute connects to every device in inv and runs the catalog against
+// the ones that answered. Devices are disconnected before it returns.
+func (s *runSession) execute(ctx context.Context, inv *inventory.Inventory, catalog *test.Catalog) (*runOutcome, error) {
+	out := &runOutcome{Started: time.Now()}
+
+	deviceList := make([]device.Device, 0, len(inv.Devices))
+	out.Devices = make([]reporter.DeviceInfo, 0, len(inv.Devices))
+	for _, devConfig := range inv.Devices {
+		if s.Transport != "" {
+			devConfig.Transport = s.Transport
+		}
+		info := reporter.DeviceInfo{
+			Name:      devConfig.Name,
+			Host:      devConfig.Host,
+			Transport: devConfig.Transport,
+			Port:      devConfig.Port,
+			Tags:      devConfig.Tags,
+		}
+		dev, err := DeviceFactory(devConfig)
+		if err == nil {
+			err = dev.Connect(ctx)
+		}
+		if err != nil {
+			if s.ConnectError != nil {
+				s.ConnectError(devConfig.Name, err)
+			} else {
+				logger.Errorf("Failed to connect to %s: %v", devConfig.Name, err)
+			}
+			info.ConnectError = err.Error()
+			out.Devices = append(out.Devices, info)
+			out.ConnectFailed = true
+			continue
+		}
+		defer dev.Disconnect()
+		info.Connected = true
+		info.Model = dev.HardwareModel()
+		// EOS version is exposed in `show version`'s `version` field;
+		// Connect already ran the probe but doesn't store the version,
+		// so query once more here. Cheap, and only on devices the test
+		// run will actually use.
+		if v, err := dev.Execute(ctx, device.Command{Template: "show version", Format: "json", UseCache: true}); err == nil {
+			if m, ok := v.Output.(map[string]any); ok {
+				if ver, ok := m["version"].(string); ok {
+					info.EOSVersion = ver
+				}
+			}
+		}
+		deviceList = append(deviceList, dev)
+		out.Devices = append(out.Devices, info)
+	}
+	out.Connected = len(deviceList)
+
+	var runner interface {
+		Run(ctx context.Context, tests []test.TestDefinition, devices []device.Device) ([]test.TestResult, error)
+	}
+	if s.Progress {
+		progressRunner := test.NewProgressRunner(s.Concurrency, true)
+		progressRunner.SetTimeout(s.Timeout)
+		if s.baselines != nil {
+			progressRunner.SetBaselineProvider(s.baselines)
+		}
+		runner = progressRunner
+	} else {
+		plain := test.NewRunner(s.Concurrency)
+		plain.SetTimeout(s.Timeout)
+		if s.baselines != nil {
+			plain.SetBaselineProvider(s.baselines)
+		}
+		runner = plain
+	}
+	results, err := runner.Run(ctx, catalog.Tests, deviceList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run tests: %w", err)
+	}
+	out.Results = results
+	out.Failed = test.FailsAt(results, s.threshold)
+	return out, nil
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/fluidstackio/go-anta/pkg/test"
+	"github.com/spf13/cobra"
+)
+
+var validateCatalogFile string
+
+var ValidateCatalogCmd = &cobra.Command{
+	Use:   "validate-catalog",
+	Short: "Validate a test catalog without running it",
+	Long: `The validate-catalog command loads a catalog, constructs every test, and runs
+each test's input validation, reporting every invalid entry at once. No devices
+are contacted.`,
+	Example: `  go-anta validate-catalog --catalog catalog.yaml`,
+	RunE:    runValidateCatalog,
+}
+
+func init() {
+	ValidateCatalogCmd.Flags().StringVarP(&validateCatalogFile, "catalog", "c", "", "test catalog file path (required)")
+	_ = ValidateCatalogCmd.MarkFlagRequired("catalog")
+}
+
+func runValidateCatalog(cmd *cobra.Command, args []string) error {
+	catalog, err := test.LoadCatalog(validateCatalogFile)
+	if err != nil {
+		return fmt.Errorf("failed to load catalog: %w", err)
+	}
+
+	out := cmd.OutOrStdout()
+	errs := catalog.ValidateInputs(test.GetRegistry())
+	for _, err := range errs {
+		fmt.Fprintf(out, "invalid: %v\n", err)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%d of %d catalog tests are invalid", len(errs), len(catalog.Tests))
+	}
+
+	fmt.Fprintf(out, "Catalog OK: %d tests valid\n", len(catalog.Tests))
+	return nil
+}
@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func debugTest(t *testing.T, inputs string, args ...string) (string, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "inputs.yml")
+	if err := os.WriteFile(path, []byte(inputs), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	t.Cleanup(func() { rootCmd.SetOut(nil) })
+	rootCmd.SetArgs(append([]string{"debug", "--inputs", path, "--host=DEVICE", "--username=admin"}, args...))
+	err := Execute()
+	return out.String(), err
+}
+
+func TestDebugPrintsPayloadAndCurl(t *testing.T) {
+	out, err := debugTest(t, `
+bgp_peers:
+  - peer_address: 10.0.0.1
+    vrf: blue
+    advertised_routes: ["10.1.0.0/24"]
+    received_routes: ["10.2.0.0/24"]
+`, "--test", "VerifyBGPExchangedRoutes", "--host", "192.0.2.10")
+	if err != nil {
+		t.Fatalf("debug: %v", err)
+	}
+	for _, want := range []string{
+		"# show bgp neighbors 10.0.0.1 advertised-routes vrf blue\n" +
+			`{"id":"1","jsonrpc":"2.0","method":"runCmds","params":{"cmds":["show bgp neighbors 10.0.0.1 advertised-routes vrf blue"],"format":"json","version":1}}` + "\n",
+		`"cmds":["show bgp neighbors 10.0.0.1 received-routes vrf blue"]`,
+		`curl -k -u 'admin' -H 'Content-Type: application/json' -d '{"id":"1",`,
+		`https://192.0.2.10:443/command-api`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestDebugRejectsInvalidInputs(t *testing.T) {
+	_, err := debugTest(t, "bgp_peers:\n  - peer_address: \"\"\n", "--test", "routing/VerifyBGPExchangedRoutes")
+	if err == nil || !strings.Contains(err.Error(), "invalid inputs") {
+		t.Fatalf("err = %v, want invalid inputs", err)
+	}
+}
+
+func TestDebugUnknownTest(t *testing.T) {
+	_, err := debugTest(t, "{}\n", "--test", "VerifyNoSuchThing")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("err = %v, want not found", err)
+	}
+}
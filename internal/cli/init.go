@@ -8,4 +8,9 @@ func init() {
 	rootCmd.AddCommand(commands.NrfuCmd)
 	rootCmd.AddCommand(commands.CheckCmd)
 	rootCmd.AddCommand(commands.InventoryCmd)
+	rootCmd.AddCommand(commands.RunCmd)
+	rootCmd.AddCommand(commands.ListTestsCmd)
+	rootCmd.AddCommand(commands.ValidateCatalogCmd)
+	rootCmd.AddCommand(commands.DebugCmd)
+	rootCmd.AddCommand(commands.SchemaCmd)
 }
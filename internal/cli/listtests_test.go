@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func listTests(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	t.Cleanup(func() { rootCmd.SetOut(nil) })
+	rootCmd.SetArgs(append([]string{"list-tests", "--module=", "--json=false"}, args...))
+	err := Execute()
+	return out.String(), err
+}
+
+func TestListTests(t *testing.T) {
+	out, err := listTests(t)
+	if err != nil {
+		t.Fatalf("list-tests: %v", err)
+	}
+	for _, want := range []string{"VerifyHostname", "VerifyBGPExchangedRoutes", "VerifyLoggingErrors", "VerifyShowCommand"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("listing missing %s", want)
+		}
+	}
+	if !strings.HasPrefix(out, "MODULE") {
+		t.Errorf("listing should start with a header:\n%s", out[:min(len(out), 200)])
+	}
+}
+
+func TestListTestsModuleJSON(t *testing.T) {
+	out, err := listTests(t, "--module", "logging", "--json")
+	if err != nil {
+		t.Fatalf("list-tests: %v", err)
+	}
+	var infos []struct {
+		Module      string   `json:"module"`
+		Name        string   `json:"name"`
+		Categories  []string `json:"categories"`
+		Description string   `json:"description"`
+	}
+	if err := json.Unmarshal([]byte(out), &infos); err != nil {
+		t.Fatalf("decode: %v\n%s", err, out)
+	}
+	found := false
+	for _, info := range infos {
+		if info.Module != "logging" {
+			t.Errorf("module filter leaked %s/%s", info.Module, info.Name)
+		}
+		if info.Name == "VerifyLoggingErrors" {
+			found = true
+			if info.Description == "" || len(info.Categories) == 0 {
+				t.Errorf("VerifyLoggingErrors = %+v, want description and categories", info)
+			}
+		}
+	}
+	if !found {
+		t.Error("VerifyLoggingErrors missing from logging listing")
+	}
+
+	if _, err := listTests(t, "--module", "nope"); err == nil {
+		t.Error("expected error for unknown module")
+	}
+}
@@ -5,15 +5,17 @@ import (
 	"os"
 
 	"github.com/fluidstackio/go-anta/internal/logger"
+	"github.com/fluidstackio/go-anta/pkg/test"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	cfgFile  string
-	logLevel string
-	logFile  string
-	verbose  bool
+	cfgFile   string
+	logLevel  string
+	logFile   string
+	verbose   bool
+	selfCheck bool
 )
 
 var rootCmd = &cobra.Command{
@@ -27,6 +29,14 @@ with support for concurrent test execution, flexible inventory management, and c
 	// ErrTestsFailed sentinel can exit silently.
 	SilenceErrors: true,
 	SilenceUsage:  true,
+	// The registry self-check is opt-in: it constructs every test, which
+	// is cheap but pointless on every invocation once CI runs it.
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if viper.GetBool("self_check") {
+			return test.GetRegistry().SelfCheck()
+		}
+		return nil
+	},
 }
 
 func Execute() error {
@@ -43,6 +53,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&logLevel, "log-level", "l", "info", "log level (debug, info, warn, error)")
 	rootCmd.PersistentFlags().StringVar(&logFile, "log-file", "", "log file path")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output")
+	rootCmd.PersistentFlags().BoolVar(&selfCheck, "self-check", false, "check registered tests for duplicate names and bad categories before running (also GO_ANTA_SELF_CHECK)")
 
 	if err := viper.BindPFlag("log.level", rootCmd.PersistentFlags().Lookup("log-level")); err != nil {
 		fmt.Fprintf(os.Stderr, "Error binding flag: %v\n", err)
@@ -50,6 +61,9 @@ func init() {
 	if err := viper.BindPFlag("log.file", rootCmd.PersistentFlags().Lookup("log-file")); err != nil {
 		fmt.Fprintf(os.Stderr, "Error binding flag: %v\n", err)
 	}
+	if err := viper.BindPFlag("self_check", rootCmd.PersistentFlags().Lookup("self-check")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error binding flag: %v\n", err)
+	}
 }
 
 func initConfig() {
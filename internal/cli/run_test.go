@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/internal/cli/commands"
+	"github.com/fluidstackio/go-anta/pkg/device"
+	_ "github.com/fluidstackio/go-anta/tests"
+)
+
+// runSmoke executes `go-anta run` against fake devices answering
+// `show hostname` with their own inventory name.
+func runSmoke(t *testing.T, catalog string, extra ...string) (string, error) {
+	t.Helper()
+	dir := t.TempDir()
+	inv := filepath.Join(dir, "inv.yml")
+	cat := filepath.Join(dir, "cat.yml")
+	if err := os.WriteFile(inv, []byte(`
+kind: file
+devices:
+  - name: leaf1
+    host: 10.0.0.1
+    username: admin
+    password: pw
+    tags: [leaf]
+  - name: spine1
+    host: 10.0.0.2
+    username: admin
+    password: pw
+    tags: [spine]
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cat, []byte(catalog), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	orig := commands.DeviceFactory
+	t.Cleanup(func() { commands.DeviceFactory = orig })
+	commands.DeviceFactory = func(cfg device.DeviceConfig) (device.Device, error) {
+		dev := device.NewFakeDevice().OnJSON(t, "show hostname", `{"hostname": "`+cfg.Name+`", "fqdn": "`+cfg.Name+`.lab"}`)
+		dev.DeviceName = cfg.Name
+		return dev, nil
+	}
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	t.Cleanup(func() { rootCmd.SetOut(nil) })
+	args := append([]string{"run", "-i", inv, "-c", cat, "--reporter", "json", "--tags=", "--categories=", "--fail-on=minor"}, extra...)
+	rootCmd.SetArgs(args)
+	err := Execute()
+	return out.String(), err
+}
+
+const hostnameCatalog = `
+tests:
+  - name: VerifyHostname
+    module: services
+    inputs:
+      hostname: leaf1
+`
+
+func TestRunCommand(t *testing.T) {
+	out, err := runSmoke(t, hostnameCatalog, "--tags", "leaf", "--concurrency", "4")
+	if err != nil {
+		t.Fatalf("run: %v\n%s", err, out)
+	}
+
+	var report struct {
+		Devices []struct {
+			Name      string `json:"name"`
+			Connected bool   `json:"connected"`
+		} `json:"devices"`
+		Results []struct {
+			TestName   string `json:"test_name"`
+			DeviceName string `json:"device_name"`
+			Status     string `json:"status"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("decode report: %v\n%s", err, out)
+	}
+	if len(report.Devices) != 1 || report.Devices[0].Name != "leaf1" || !report.Devices[0].Connected {
+		t.Errorf("devices = %+v, want only leaf1 after --tags leaf", report.Devices)
+	}
+	if len(report.Results) != 1 || report.Results[0].Status != "success" || report.Results[0].TestName != "VerifyHostname" {
+		t.Errorf("results = %+v", report.Results)
+	}
+}
+
+func TestRunCommandFailureExitCode(t *testing.T) {
+	// spine1 reports its own hostname, so VerifyHostname fails there.
+	out, err := runSmoke(t, hostnameCatalog)
+	if !errors.Is(err, commands.ErrTestsFailed) {
+		t.Fatalf("err = %v, want ErrTestsFailed", err)
+	}
+	if !strings.Contains(out, `"status": "failure"`) {
+		t.Errorf("report should still be written on failure:\n%s", out)
+	}
+}
+
+func TestRunCommandCategoriesFilter(t *testing.T) {
+	_, err := runSmoke(t, hostnameCatalog, "--categories", "bgp")
+	if err == nil || !strings.Contains(err.Error(), "no matches for categories: [bgp]") {
+		t.Errorf("err = %v, want unmatched category error", err)
+	}
+}
+
+func TestRunCommandFailOnSeverity(t *testing.T) {
+	// VerifyHostname is minor by default: its failure on spine1 does not
+	// gate a run that only fails on major and above.
+	out, err := runSmoke(t, hostnameCatalog, "--fail-on", "major")
+	if err != nil {
+		t.Fatalf("run --fail-on major: %v", err)
+	}
+	if !strings.Contains(out, `"severity": "minor"`) {
+		t.Errorf("results should carry the test severity:\n%s", out)
+	}
+
+	// A catalog override raises it back over the threshold.
+	_, err = runSmoke(t, `
+tests:
+  - name: VerifyHostname
+    module: services
+    severity: critical
+    inputs:
+      hostname: leaf1
+`, "--fail-on", "critical")
+	if !errors.Is(err, commands.ErrTestsFailed) {
+		t.Fatalf("err = %v, want ErrTestsFailed for critical override", err)
+	}
+
+	if _, err := runSmoke(t, hostnameCatalog, "--fail-on", "blocker"); err == nil || !strings.Contains(err.Error(), "unknown severity") {
+		t.Errorf("err = %v, want unknown severity", err)
+	}
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func schemaTest(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	t.Cleanup(func() { rootCmd.SetOut(nil) })
+	rootCmd.SetArgs(append([]string{"schema"}, args...))
+	err := Execute()
+	return out.String(), err
+}
+
+func TestSchemaPrintsInputSchema(t *testing.T) {
+	out, err := schemaTest(t, "--test", "VerifyBGPPeers")
+	if err != nil {
+		t.Fatalf("schema: %v", err)
+	}
+	var doc struct {
+		Title      string   `json:"title"`
+		Required   []string `json:"required"`
+		Properties map[string]struct {
+			Items struct {
+				Properties map[string]struct {
+					Type string `json:"type"`
+				} `json:"properties"`
+			} `json:"items"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, out)
+	}
+	if doc.Title != "routing/VerifyBGPPeers" || len(doc.Required) != 1 || doc.Required[0] != "peers" {
+		t.Errorf("unexpected schema header: %+v", doc)
+	}
+	if got := doc.Properties["peers"].Items.Properties["peer"].Type; got != "string" {
+		t.Errorf("peers[].peer type = %q, want string", got)
+	}
+}
+
+func TestSchemaWithoutProvider(t *testing.T) {
+	_, err := schemaTest(t, "--test", "services/VerifyHostname")
+	if err == nil || !strings.Contains(err.Error(), "does not publish an input schema") {
+		t.Fatalf("err = %v, want no schema", err)
+	}
+}
+
+func TestValidateCatalogAppliesInputSchema(t *testing.T) {
+	out, err := validateCatalog(t, `
+tests:
+  - name: VerifyBGPPeerSession
+    module: routing
+    inputs:
+      bgp_peers:
+        - peer_address: 10.0.0.1
+          vfr: blue
+`)
+	if err == nil {
+		t.Fatal("expected the nested typo to be rejected")
+	}
+	if want := "tests[0] routing/VerifyBGPPeerSession: bgp_peers[0].vfr: unknown key"; !strings.Contains(out, want) {
+		t.Errorf("output missing %q:\n%s", want, out)
+	}
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func validateCatalog(t *testing.T, catalog string) (string, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cat.yml")
+	if err := os.WriteFile(path, []byte(catalog), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	t.Cleanup(func() { rootCmd.SetOut(nil) })
+	rootCmd.SetArgs([]string{"validate-catalog", "--catalog", path})
+	err := Execute()
+	return out.String(), err
+}
+
+func TestValidateCatalogReportsAllErrors(t *testing.T) {
+	out, err := validateCatalog(t, `
+tests:
+  - name: VerifyHostname
+    module: services
+    inputs:
+      hostname: leaf1
+  - name: VerifyBGPExchangedRoutes
+    module: routing
+    inputs:
+      bgp_peers:
+        - peer_address: ""
+          received_routes: ["10.0.0.0/8"]
+  - name: VerifyLoggingSourceIntf
+    module: logging
+`)
+	if err == nil || !strings.Contains(err.Error(), "2 of 3 catalog tests are invalid") {
+		t.Fatalf("err = %v, want 2 of 3 invalid", err)
+	}
+	for _, want := range []string{
+		`tests[1] routing/VerifyBGPExchangedRoutes: bgp_peers[0]:`,
+		`parameter "peer": value is empty`,
+		`tests[2] logging/VerifyLoggingSourceIntf: interface or sources must be specified`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "VerifyHostname") {
+		t.Errorf("valid entry reported:\n%s", out)
+	}
+}
+
+func TestValidateCatalogOK(t *testing.T) {
+	out, err := validateCatalog(t, `
+tests:
+  - name: VerifyHostname
+    module: services
+    inputs:
+      hostname: leaf1
+`)
+	if err != nil {
+		t.Fatalf("validate-catalog: %v\n%s", err, out)
+	}
+	if !strings.Contains(out, "Catalog OK: 1 tests valid") {
+		t.Errorf("output = %q", out)
+	}
+}
@@ -0,0 +1,89 @@
+// Package rib holds the prefix and address-family helpers shared by the
+// test modules that look routes up on a device, so routing, evpn and l3
+// agree on how a prefix is written and which table it lives in.
+package rib
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+// Family returns the keyword EOS route commands take for prefix, a CIDR
+// or bare address: "ipv6" for IPv6 (`show ipv6 route`, `show ipv6 bgp`),
+// "ip" otherwise.
+func Family(prefix string) string {
+	if IsIPv6(prefix) {
+		return "ipv6"
+	}
+	return "ip"
+}
+
+// IsIPv6 reports whether prefix, a CIDR or bare address, is IPv6.
+func IsIPv6(prefix string) bool {
+	ip, _, err := net.ParseCIDR(prefix)
+	if err != nil {
+		ip = net.ParseIP(prefix)
+	}
+	return ip != nil && ip.To4() == nil
+}
+
+// Canonical rewrites a CIDR string in the form EOS uses for route table
+// keys (network address, compressed lowercase IPv6). Strings that do not
+// parse are returned unchanged.
+func Canonical(prefix string) string {
+	if _, network, err := net.ParseCIDR(prefix); err == nil {
+		return network.String()
+	}
+	return prefix
+}
+
+// Same reports whether two CIDR strings name the same prefix, so IPv6
+// prefixes match regardless of how they are written.
+func Same(a, b string) bool {
+	return Canonical(a) == Canonical(b)
+}
+
+// vrfRouteCmd is the lookup InVRF sends. VRF names come from catalogs, so
+// it is rendered through a Template rather than formatted.
+var vrfRouteCmd = device.Template("show {family} route vrf {vrf} {prefix}")
+
+// VRFRouteCommand renders the `show <family> route vrf <vrf> <prefix>`
+// lookup InVRF sends; prefix may also be a bare address. ValidateInput
+// calls it to reject inputs that would not render to a safe command.
+func VRFRouteCommand(vrf, prefix string) (string, error) {
+	return vrfRouteCmd.Render(map[string]any{"family": Family(prefix), "vrf": vrf, "prefix": prefix})
+}
+
+// InVRF reports whether prefix is in the routing table of vrf.
+func InVRF(ctx context.Context, dev device.Device, vrf, prefix string) (bool, error) {
+	cmdStr, err := VRFRouteCommand(vrf, prefix)
+	if err != nil {
+		return false, err
+	}
+	cmd := device.Command{
+		Template: cmdStr,
+		Format:   "json",
+		UseCache: false,
+	}
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		return false, err
+	}
+
+	data, ok := cmdResult.Output.(map[string]any)
+	if !ok {
+		return false, fmt.Errorf("unexpected route output %T", cmdResult.Output)
+	}
+	vrfs, _ := data["vrfs"].(map[string]any)
+	vrfData, _ := vrfs[vrf].(map[string]any)
+	routes, _ := vrfData["routes"].(map[string]any)
+	for route := range routes {
+		if Same(route, prefix) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
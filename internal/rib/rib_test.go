@@ -0,0 +1,54 @@
+package rib
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+func TestFamily(t *testing.T) {
+	for prefix, want := range map[string]string{
+		"10.0.0.0/8":    "ip",
+		"2001:db8::/32": "ipv6",
+		"10.0.0.1":      "ip",
+		"fe80::1":       "ipv6",
+		"bogus":         "ip",
+	} {
+		if got := Family(prefix); got != want {
+			t.Errorf("Family(%q) = %q, want %q", prefix, got, want)
+		}
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	for prefix, want := range map[string]string{
+		"2001:0DB8:0::/32": "2001:db8::/32",
+		"10.1.2.3/8":       "10.0.0.0/8",
+		"bogus":            "bogus",
+	} {
+		if got := Canonical(prefix); got != want {
+			t.Errorf("Canonical(%q) = %q, want %q", prefix, got, want)
+		}
+	}
+}
+
+func TestInVRF(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show ipv6 route vrf PROD 2001:0db8:20::/64", `{"vrfs": {"PROD": {"routes": {"2001:db8:20::/64": {}}}}}`)
+	if ok, err := InVRF(context.Background(), dev, "PROD", "2001:0db8:20::/64"); err != nil || !ok {
+		t.Errorf("InVRF = %v, %v; want the route found", ok, err)
+	}
+}
+
+func TestInVRFRendersTemplate(t *testing.T) {
+	dev := device.NewFakeDevice()
+	if _, err := InVRF(context.Background(), dev, "PROD | json", "10.50.0.0/16"); err == nil ||
+		!strings.Contains(err.Error(), `parameter "vrf"`) {
+		t.Errorf("err = %v, want the template to reject the VRF name", err)
+	}
+	if calls := dev.Calls(); len(calls) != 0 {
+		t.Errorf("no command should be sent, got %v", calls)
+	}
+}
@@ -0,0 +1,49 @@
+package device
+
+import (
+	"context"
+	"fmt"
+)
+
+// RunningConfig returns the device's running configuration as raw text.
+// The command is issued with UseCache set, so tests in the same run share
+// a single fetch on transports that keep a command cache.
+func RunningConfig(ctx context.Context, d Device) (string, error) {
+	return fetchConfig(ctx, d, "show running-config")
+}
+
+// StartupConfig returns the device's startup configuration as raw text.
+// Like RunningConfig, the result is served from the command cache when
+// available.
+func StartupConfig(ctx context.Context, d Device) (string, error) {
+	return fetchConfig(ctx, d, "show startup-config")
+}
+
+func fetchConfig(ctx context.Context, d Device, template string) (string, error) {
+	result, err := d.Execute(ctx, Command{Template: template, Format: "text", UseCache: true})
+	if err != nil {
+		return "", err
+	}
+	if result.Error != nil {
+		return "", result.Error
+	}
+	text, ok := TextOutput(result.Output)
+	if !ok {
+		return "", fmt.Errorf("%s: unexpected output type %T", template, result.Output)
+	}
+	return text, nil
+}
+
+// TextOutput extracts the raw CLI text from a format=text command result.
+// eAPI wraps text as {"output": "..."} while gNMI ASCII responses are a
+// bare string; both shapes are accepted.
+func TextOutput(out interface{}) (string, bool) {
+	switch v := out.(type) {
+	case string:
+		return v, true
+	case map[string]interface{}:
+		s, ok := v["output"].(string)
+		return s, ok
+	}
+	return "", false
+}
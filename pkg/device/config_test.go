@@ -0,0 +1,60 @@
+package device
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const runningConfig = `! Command: show running-config
+hostname leaf1
+!
+interface Ethernet1
+   description uplink
+!
+end
+`
+
+func TestRunningConfig(t *testing.T) {
+	dev := NewFakeDevice().OnText("show running-config", runningConfig)
+
+	got, err := RunningConfig(context.Background(), dev)
+	if err != nil {
+		t.Fatalf("RunningConfig: %v", err)
+	}
+	if got != runningConfig {
+		t.Errorf("RunningConfig = %q, want %q", got, runningConfig)
+	}
+	calls := dev.Commands()
+	if len(calls) != 1 {
+		t.Fatalf("calls = %v, want one", calls)
+	}
+	if cmd := calls[0]; cmd.Format != "text" || !cmd.UseCache {
+		t.Errorf("command = %+v, want format=text with UseCache", cmd)
+	}
+}
+
+func TestStartupConfig(t *testing.T) {
+	// gNMI ASCII responses arrive as a bare string rather than eAPI's
+	// {"output": ...} wrapper.
+	dev := NewFakeDevice().On("show startup-config", "hostname leaf1\nend\n")
+
+	got, err := StartupConfig(context.Background(), dev)
+	if err != nil {
+		t.Fatalf("StartupConfig: %v", err)
+	}
+	if !strings.HasPrefix(got, "hostname leaf1") {
+		t.Errorf("StartupConfig = %q", got)
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	dev := NewFakeDevice().On("show running-config", map[string]interface{}{"cmds": []interface{}{}})
+
+	if _, err := RunningConfig(context.Background(), dev); err == nil || !strings.Contains(err.Error(), "unexpected output type") {
+		t.Errorf("RunningConfig err = %v, want unexpected output error", err)
+	}
+	if _, err := StartupConfig(context.Background(), dev); err == nil || !strings.Contains(err.Error(), "no output registered") {
+		t.Errorf("StartupConfig err = %v, want execute error", err)
+	}
+}
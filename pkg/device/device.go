@@ -3,6 +3,7 @@ package device
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -38,6 +39,46 @@ type Command struct {
 	Revision int                    `yaml:"revision,omitempty" json:"revision,omitempty"`
 	Format   string                 `yaml:"format,omitempty" json:"format,omitempty"`
 	UseCache bool                   `yaml:"use_cache,omitempty" json:"use_cache,omitempty"`
+
+	// Timeout bounds this command alone, independent of the run context,
+	// so slow commands (show tech-support, full route dumps) can be given
+	// a longer budget than the transport default. Zero uses the default.
+	Timeout time.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// ErrCommandTimeout is returned when a command exceeds its own timeout
+// while the caller's context is still live, so callers can tell a slow
+// command apart from a cancelled run or a device-side failure.
+var ErrCommandTimeout = errors.New("command timed out")
+
+// commandTimeoutError maps err to ErrCommandTimeout when it was caused by
+// cmdCtx's deadline rather than by parent being done. Other errors are
+// returned unchanged.
+func commandTimeoutError(parent, cmdCtx context.Context, budget time.Duration, what string, err error) error {
+	if err == nil || parent.Err() != nil || !errors.Is(cmdCtx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	return fmt.Errorf("%w: %s exceeded %v", ErrCommandTimeout, what, budget)
+}
+
+// batchTimeout returns the budget for a batch sent as a single request:
+// the longest effective timeout among cmds, where a command without a
+// Timeout counts as fallback. A zero result means no per-batch bound.
+func batchTimeout(cmds []Command, fallback time.Duration) time.Duration {
+	var longest time.Duration
+	for _, cmd := range cmds {
+		timeout := cmd.Timeout
+		if timeout == 0 {
+			if fallback == 0 {
+				return 0
+			}
+			timeout = fallback
+		}
+		if timeout > longest {
+			longest = timeout
+		}
+	}
+	return longest
 }
 
 type CommandResult struct {
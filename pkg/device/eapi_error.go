@@ -0,0 +1,88 @@
+package device
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EAPIError is the structured error eAPI returns when a runCmds request
+// fails. EOS stops at the first failing command; data carries one entry
+// per command it got to, with the failing entry listing its errors.
+//
+//	{"error": {"code": 1002,
+//	           "message": "CLI command 2 of 2 'show foo' failed: invalid command",
+//	           "data": [{...}, {"errors": ["Invalid input (at token 1: 'foo')"]}]}}
+//
+// Use errors.As to retrieve it from Execute or ExecuteBatch errors.
+type EAPIError struct {
+	// Code is the EOS error code, e.g. 1002 for an invalid command.
+	Code    int
+	Message string
+
+	// CommandIndex is the position of the failing command in the
+	// caller's command list, or -1 when EOS did not identify one (or the
+	// failure was in the enable step the device adds itself).
+	CommandIndex int
+	Command      string
+
+	// Errors are the per-command error strings EOS reported for the
+	// failing command.
+	Errors []string
+}
+
+func (e *EAPIError) Error() string {
+	msg := fmt.Sprintf("eAPI error %d: %s", e.Code, e.Message)
+	if len(e.Errors) > 0 {
+		msg += ": " + strings.Join(e.Errors, "; ")
+	}
+	return msg
+}
+
+// parseEAPIError decodes the "error" member of a runCmds response. sent
+// is the cmds list as sent, and offset the number of leading entries the
+// device added before the caller's commands (see withEnable).
+func parseEAPIError(raw interface{}, sent []interface{}, offset int) *EAPIError {
+	e := &EAPIError{CommandIndex: -1}
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		e.Message = fmt.Sprint(raw)
+		return e
+	}
+	if code, ok := m["code"].(float64); ok {
+		e.Code = int(code)
+	}
+	e.Message, _ = m["message"].(string)
+
+	data, _ := m["data"].([]interface{})
+	for i, entry := range data {
+		entryMap, _ := entry.(map[string]interface{})
+		errs, ok := entryMap["errors"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, msg := range errs {
+			e.Errors = append(e.Errors, fmt.Sprint(msg))
+		}
+		if i < len(sent) {
+			e.Command = sentCommand(sent[i])
+		}
+		if i >= offset {
+			e.CommandIndex = i - offset
+		}
+		break
+	}
+	return e
+}
+
+// sentCommand returns the CLI text of a runCmds entry, which is either a
+// bare string or an object with a "cmd" member.
+func sentCommand(entry interface{}) string {
+	switch v := entry.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		cmd, _ := v["cmd"].(string)
+		return cmd
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// A runCmds response as returned by EOS 4.3x for an unknown command in
+// the second position.
+const eapiErrorBody = `{
+  "jsonrpc": "2.0",
+  "id": "1",
+  "error": {
+    "code": 1002,
+    "message": "CLI command 2 of 2 'show bogus' failed: invalid command",
+    "data": [
+      {"modelName": "DCS-7280SR3-48YC8", "version": "4.34.4M"},
+      {"errors": ["Invalid input (at token 1: 'bogus')"]}
+    ]
+  }
+}`
+
+func TestParseEAPIError(t *testing.T) {
+	var resp map[string]interface{}
+	if err := json.Unmarshal([]byte(eapiErrorBody), &resp); err != nil {
+		t.Fatal(err)
+	}
+	sent := []interface{}{"show version", map[string]interface{}{"cmd": "show bogus", "revision": 1}}
+
+	got := parseEAPIError(resp["error"], sent, 0)
+	want := &EAPIError{
+		Code:         1002,
+		Message:      "CLI command 2 of 2 'show bogus' failed: invalid command",
+		CommandIndex: 1,
+		Command:      "show bogus",
+		Errors:       []string{"Invalid input (at token 1: 'bogus')"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseEAPIError = %+v, want %+v", got, want)
+	}
+	wantMsg := "eAPI error 1002: CLI command 2 of 2 'show bogus' failed: invalid command: Invalid input (at token 1: 'bogus')"
+	if got.Error() != wantMsg {
+		t.Errorf("Error() = %q, want %q", got.Error(), wantMsg)
+	}
+
+	// With an enable step in front, the failing command is still reported
+	// by its position in the caller's list.
+	if got := parseEAPIError(resp["error"], sent, 1); got.CommandIndex != 0 {
+		t.Errorf("CommandIndex with enable offset = %d, want 0", got.CommandIndex)
+	}
+}
+
+func TestParseEAPIErrorUnstructured(t *testing.T) {
+	got := parseEAPIError(map[string]interface{}{"code": float64(1005), "message": "Unauthorized"}, nil, 0)
+	if got.Code != 1005 || got.CommandIndex != -1 || got.Errors != nil {
+		t.Errorf("parseEAPIError = %+v", got)
+	}
+	if got := parseEAPIError("boom", nil, 0); got.Message != "boom" {
+		t.Errorf("parseEAPIError(string) = %+v", got)
+	}
+}
+
+func TestEOSExecuteReturnsEAPIError(t *testing.T) {
+	dev, api := newTestEOSDevice(t, "s3cret")
+	api.invalid = "show bogus"
+
+	_, err := dev.Execute(context.Background(), Command{Template: "show bogus", Format: "json"})
+	var eapiErr *EAPIError
+	if !errors.As(err, &eapiErr) {
+		t.Fatalf("err = %v (%T), want *EAPIError", err, err)
+	}
+	if eapiErr.Code != 1002 || eapiErr.CommandIndex != 0 || eapiErr.Command != "show bogus" {
+		t.Errorf("EAPIError = %+v", eapiErr)
+	}
+
+	_, err = dev.ExecuteBatch(context.Background(), []Command{
+		{Template: "show version", Format: "json"},
+		{Template: "show bogus", Format: "json"},
+	})
+	if !errors.As(err, &eapiErr) || eapiErr.CommandIndex != 1 {
+		t.Fatalf("batch err = %v, want *EAPIError for command 1", err)
+	}
+}
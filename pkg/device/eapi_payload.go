@@ -0,0 +1,69 @@
+package device
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// commandEntry is the runCmds "cmds" entry for a single command. Plain
+// commands go as bare strings, the simple form that works with curl; only
+// commands pinned to a revision need the object form.
+func commandEntry(cmd Command) interface{} {
+	cmdStr := expandCommand(cmd)
+	if cmd.Revision > 0 {
+		return map[string]interface{}{"cmd": cmdStr, "revision": cmd.Revision}
+	}
+	return cmdStr
+}
+
+// commandFormat is the runCmds output format for cmd: text when asked
+// for, JSON otherwise.
+func commandFormat(cmd Command) string {
+	if cmd.Format == "text" {
+		return "text"
+	}
+	return "json"
+}
+
+func runCmdsPayload(cmds []interface{}, format string, id interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  "runCmds",
+		"params": map[string]interface{}{
+			"version": 1,
+			"cmds":    cmds,
+			"format":  format,
+		},
+		"id": id,
+	}
+}
+
+// EAPIPayload returns the JSON-RPC body the eAPI transport sends for cmd,
+// without the enable step, so it can be replayed by hand against a device.
+func EAPIPayload(cmd Command) ([]byte, error) {
+	return json.Marshal(runCmdsPayload([]interface{}{commandEntry(cmd)}, commandFormat(cmd), "1"))
+}
+
+// EAPICurl returns a curl invocation that POSTs the EAPIPayload of cmd to
+// host's command-api endpoint. The password is left for curl to prompt
+// for so it never lands in shell history.
+func EAPICurl(host string, port int, username string, cmd Command) (string, error) {
+	payload, err := EAPIPayload(cmd)
+	if err != nil {
+		return "", err
+	}
+	if port == 0 {
+		port = 443
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	return fmt.Sprintf("curl -k -u %s -H 'Content-Type: application/json' -d %s https://%s:%d/command-api",
+		shellQuote(username), shellQuote(string(payload)), host, port), nil
+}
+
+// shellQuote wraps s in single quotes for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
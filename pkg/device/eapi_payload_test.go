@@ -0,0 +1,43 @@
+package device
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestEAPIPayload(t *testing.T) {
+	payload, err := EAPIPayload(Command{
+		Template: "show ip route vrf {vrf}",
+		Params:   map[string]interface{}{"vrf": "blue"},
+		Revision: 2,
+		Format:   "text",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(payload, &got); err != nil {
+		t.Fatal(err)
+	}
+	params := got["params"].(map[string]interface{})
+	if got["method"] != "runCmds" || params["format"] != "text" {
+		t.Errorf("payload = %s", payload)
+	}
+	entry := params["cmds"].([]interface{})[0].(map[string]interface{})
+	if entry["cmd"] != "show ip route vrf blue" || entry["revision"] != float64(2) {
+		t.Errorf("cmds entry = %v", entry)
+	}
+}
+
+func TestEAPICurlQuoting(t *testing.T) {
+	curl, err := EAPICurl("fc00::1", 0, "o'brien", Command{Template: "show version"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`-u 'o'\''brien'`, `https://[fc00::1]:443/command-api`, `"cmds":["show version"]`} {
+		if !strings.Contains(curl, want) {
+			t.Errorf("curl missing %q: %s", want, curl)
+		}
+	}
+}
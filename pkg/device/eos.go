@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -60,13 +61,14 @@ func NewEOSDevice(config DeviceConfig) *EOSDevice {
 		MaxIdleConnsPerHost:   16,
 		MaxConnsPerHost:       16,
 		IdleConnTimeout:       90 * time.Second,
-		ResponseHeaderTimeout: 10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 	}
 
+	// No client-wide or response-header timeout: each request carries its
+	// own deadline (see requestTimeout) so a command with a larger
+	// Command.Timeout is not cut short by the transport.
 	client := &http.Client{
 		Transport: tr,
-		Timeout:   config.Timeout,
 	}
 
 	device := &EOSDevice{
@@ -174,7 +176,10 @@ func (d *EOSDevice) ExecuteBatch(ctx context.Context, cmds []Command) ([]*Comman
 	d.mu.RUnlock()
 
 	results := make([]*CommandResult, len(cmds))
-	commands := make([]map[string]interface{}, 0, len(cmds))
+	commands := make([]interface{}, 0, len(cmds))
+	// sentIndex maps each entry of commands back to its index in cmds,
+	// since cached commands are not sent.
+	sentIndex := make([]int, 0, len(cmds))
 
 	for i, cmd := range cmds {
 		if cmd.UseCache && d.cache != nil {
@@ -186,11 +191,16 @@ func (d *EOSDevice) ExecuteBatch(ctx context.Context, cmds []Command) ([]*Comman
 		}
 
 		cmdStr := d.expandTemplate(cmd)
-		commands = append(commands, map[string]interface{}{
+		entry := map[string]interface{}{
 			"cmd":     cmdStr,
 			"version": cmd.Version,
 			"format":  cmd.Format,
-		})
+		}
+		if cmd.Revision > 0 {
+			entry["revision"] = cmd.Revision
+		}
+		commands = append(commands, entry)
+		sentIndex = append(sentIndex, i)
 	}
 
 	if len(commands) == 0 {
@@ -198,8 +208,12 @@ func (d *EOSDevice) ExecuteBatch(ctx context.Context, cmds []Command) ([]*Comman
 	}
 
 	batchStart := time.Now()
-	batchResult, err := d.executeBatchCommands(ctx, commands)
+	batchResult, err := d.executeBatchCommands(ctx, commands, batchTimeout(cmds, d.requestTimeout(0)))
 	if err != nil {
+		var eapiErr *EAPIError
+		if errors.As(err, &eapiErr) && eapiErr.CommandIndex >= 0 && eapiErr.CommandIndex < len(sentIndex) {
+			eapiErr.CommandIndex = sentIndex[eapiErr.CommandIndex]
+		}
 		return nil, err
 	}
 	// EOS doesn't report per-command latency for batches; spreading the
@@ -287,23 +301,20 @@ func (d *EOSDevice) executeCommand(ctx context.Context, cmd Command) (*CommandRe
 	logger.Debugf("Executing command on %s: %s", d.Config.Name, cmdStr)
 
 	logger.Debugf("Creating JSON-RPC payload for %s", d.Config.Name)
-	// Use the simple format that works with curl
-	payload := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"method":  "runCmds",
-		"params": map[string]interface{}{
-			"version": 1,
-			"cmds":    []string{cmdStr}, // Use simple string array instead of objects
-			"format":  "json",
-		},
-		"id": "1", // Use string ID instead of int
-	}
-	logger.Debugf("Payload created: %+v", payload)
+	sent := d.withEnable([]interface{}{commandEntry(cmd)})
+	payload := runCmdsPayload(sent, commandFormat(cmd), "1") // Use string ID instead of int
+	logged := runCmdsPayload(redactEnable(sent), commandFormat(cmd), "1")
+	logger.Debugf("Payload created: %+v", logged)
+
+	budget := d.requestTimeout(cmd.Timeout)
+	reqCtx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
 
 	start := time.Now()
 	logger.Debugf("About to call sendRequest for %s", d.Config.Name)
-	logger.Debugf("Payload to be sent: %+v", payload)
-	response, err := d.sendRequest(ctx, payload)
+	logger.Debugf("Payload to be sent: %+v", logged)
+	response, err := d.sendRequest(reqCtx, payload)
+	err = commandTimeoutError(ctx, reqCtx, budget, cmdStr, err)
 	duration := time.Since(start)
 	logger.Debugf("sendRequest completed for %s in %v", d.Config.Name, duration)
 
@@ -328,7 +339,7 @@ func (d *EOSDevice) executeCommand(ctx context.Context, cmd Command) (*CommandRe
 	}
 
 	if errorData, ok := result["error"]; ok {
-		err := fmt.Errorf("eAPI error: %v", errorData)
+		err := parseEAPIError(errorData, sent, d.enableOffset())
 		return &CommandResult{
 			Command:   cmd,
 			Error:     err,
@@ -337,10 +348,10 @@ func (d *EOSDevice) executeCommand(ctx context.Context, cmd Command) (*CommandRe
 		}, err
 	}
 
-	if resultData, ok := result["result"].([]interface{}); ok && len(resultData) > 0 {
+	if resultData, ok := result["result"].([]interface{}); ok && len(resultData) > d.enableOffset() {
 		return &CommandResult{
 			Command:   cmd,
-			Output:    resultData[0],
+			Output:    resultData[d.enableOffset()],
 			Duration:  duration,
 			Timestamp: time.Now(),
 		}, nil
@@ -354,21 +365,17 @@ func (d *EOSDevice) executeCommand(ctx context.Context, cmd Command) (*CommandRe
 	}, nil
 }
 
-func (d *EOSDevice) executeBatchCommands(ctx context.Context, commands []map[string]interface{}) ([]interface{}, error) {
-	payload := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"method":  "runCmds",
-		"params": map[string]interface{}{
-			"version": 1,
-			"cmds":    commands,
-			"format":  "json",
-		},
-		"id": d.getRequestID(),
-	}
+func (d *EOSDevice) executeBatchCommands(ctx context.Context, commands []interface{}, timeout time.Duration) ([]interface{}, error) {
+	sent := d.withEnable(commands)
+	payload := runCmdsPayload(sent, "json", d.getRequestID())
 
-	response, err := d.sendRequest(ctx, payload)
+	budget := d.requestTimeout(timeout)
+	reqCtx, cancel := context.WithTimeout(ctx, budget)
+	defer cancel()
+
+	response, err := d.sendRequest(reqCtx, payload)
 	if err != nil {
-		return nil, err
+		return nil, commandTimeoutError(ctx, reqCtx, budget, fmt.Sprintf("batch of %d commands", len(commands)), err)
 	}
 
 	var result map[string]interface{}
@@ -377,16 +384,53 @@ func (d *EOSDevice) executeBatchCommands(ctx context.Context, commands []map[str
 	}
 
 	if errorData, ok := result["error"]; ok {
-		return nil, fmt.Errorf("eAPI error: %v", errorData)
+		return nil, parseEAPIError(errorData, sent, d.enableOffset())
 	}
 
 	if resultData, ok := result["result"].([]interface{}); ok {
-		return resultData, nil
+		if len(resultData) < d.enableOffset() {
+			return nil, fmt.Errorf("unexpected response format")
+		}
+		return resultData[d.enableOffset():], nil
 	}
 
 	return nil, fmt.Errorf("unexpected response format")
 }
 
+// withEnable prepends the privileged-mode "enable" step, carrying the
+// enable secret as its input, when the device has an EnablePassword.
+// Commands that need enable mode fail without it on accounts that log
+// in unprivileged.
+func (d *EOSDevice) withEnable(cmds []interface{}) []interface{} {
+	if d.Config.EnablePassword == "" {
+		return cmds
+	}
+	enable := map[string]interface{}{"cmd": "enable", "input": d.Config.EnablePassword}
+	return append([]interface{}{enable}, cmds...)
+}
+
+// redactEnable returns cmds with the enable secret masked, for logging.
+// The enable step withEnable adds carries it in plaintext.
+func redactEnable(cmds []interface{}) []interface{} {
+	out := make([]interface{}, len(cmds))
+	for i, cmd := range cmds {
+		if m, ok := cmd.(map[string]interface{}); ok && m["cmd"] == "enable" {
+			cmd = map[string]interface{}{"cmd": "enable", "input": "***"}
+		}
+		out[i] = cmd
+	}
+	return out
+}
+
+// enableOffset is the number of leading runCmds results produced by
+// withEnable rather than by the caller's commands.
+func (d *EOSDevice) enableOffset() int {
+	if d.Config.EnablePassword == "" {
+		return 0
+	}
+	return 1
+}
+
 func (d *EOSDevice) sendRequest(ctx context.Context, payload interface{}) ([]byte, error) {
 	logger.Debugf("Starting sendRequest for %s", d.Config.Name)
 	jsonData, err := json.Marshal(payload)
@@ -408,11 +452,6 @@ func (d *EOSDevice) sendRequest(ctx context.Context, payload interface{}) ([]byt
 
 	logger.Debugf("Making HTTP request to %s with username: %s", url, d.Config.Username)
 
-	// Create a shorter timeout context for the HTTP request
-	httpCtx, cancel := context.WithTimeout(ctx, 15*time.Second)
-	defer cancel()
-	req = req.WithContext(httpCtx)
-
 	logger.Debugf("About to execute HTTP client.Do() for %s", d.Config.Name)
 	resp, err := d.client.Do(req)
 	logger.Debugf("HTTP client.Do() completed for %s", d.Config.Name)
@@ -436,7 +475,30 @@ func (d *EOSDevice) sendRequest(ctx context.Context, payload interface{}) ([]byt
 	return buf.Bytes(), nil
 }
 
+// defaultRequestTimeout bounds an eAPI request whose commands set no
+// Timeout of their own.
+const defaultRequestTimeout = 15 * time.Second
+
+// requestTimeout returns the deadline for one eAPI request: the command's
+// own timeout when set, otherwise the default capped by the device's
+// configured Timeout.
+func (d *EOSDevice) requestTimeout(cmdTimeout time.Duration) time.Duration {
+	if cmdTimeout > 0 {
+		return cmdTimeout
+	}
+	if d.Config.Timeout > 0 && d.Config.Timeout < defaultRequestTimeout {
+		return d.Config.Timeout
+	}
+	return defaultRequestTimeout
+}
+
 func (d *EOSDevice) expandTemplate(cmd Command) string {
+	return expandCommand(cmd)
+}
+
+// expandCommand substitutes cmd.Params into the {placeholder}s of
+// cmd.Template.
+func expandCommand(cmd Command) string {
 	cmdStr := cmd.Template
 	for key, value := range cmd.Params {
 		placeholder := fmt.Sprintf("{%s}", key)
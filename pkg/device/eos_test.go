@@ -0,0 +1,263 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fluidstackio/go-anta/internal/logger"
+)
+
+// eapiServer is a fake eAPI endpoint that records each runCmds params
+// object and answers with one result per command sent.
+type eapiServer struct {
+	mu       sync.Mutex
+	payloads []map[string]interface{}
+
+	// slow delays any request containing a show tech-support command.
+	slow time.Duration
+
+	// invalid makes the named command fail the way EOS rejects unknown
+	// commands.
+	invalid string
+}
+
+func (s *eapiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Params map[string]interface{} `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	s.payloads = append(s.payloads, req.Params)
+	s.mu.Unlock()
+
+	cmds, _ := req.Params["cmds"].([]interface{})
+	for _, cmd := range cmds {
+		if text, ok := cmd.(string); ok && strings.HasPrefix(text, "show tech-support") {
+			select {
+			case <-time.After(s.slow):
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}
+	results := make([]interface{}, len(cmds))
+	for i, cmd := range cmds {
+		if s.invalid != "" && sentCommand(cmd) == s.invalid {
+			data := append(results[:i:i], map[string]interface{}{
+				"errors": []string{"Invalid input (at token 1: '" + strings.Fields(s.invalid)[1] + "')"},
+			})
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": "1", "error": map[string]interface{}{
+				"code":    1002,
+				"message": "CLI command " + strconv.Itoa(i+1) + " of " + strconv.Itoa(len(cmds)) + " '" + s.invalid + "' failed: invalid command",
+				"data":    data,
+			}})
+			return
+		}
+		if m, ok := cmd.(map[string]interface{}); ok && m["cmd"] == "enable" {
+			results[i] = map[string]interface{}{}
+			continue
+		}
+		results[i] = map[string]interface{}{"modelName": "DCS-7280", "index": i}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": "1", "result": results})
+}
+
+func (s *eapiServer) last(t *testing.T) map[string]interface{} {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.payloads) == 0 {
+		t.Fatal("no request received")
+	}
+	return s.payloads[len(s.payloads)-1]
+}
+
+func newTestEOSDevice(t *testing.T, enable string) (*EOSDevice, *eapiServer) {
+	t.Helper()
+	api := &eapiServer{}
+	srv := httptest.NewTLSServer(api)
+	t.Cleanup(srv.Close)
+
+	host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	portNum, _ := strconv.Atoi(port)
+	dev := NewEOSDevice(DeviceConfig{
+		Name:           "leaf1",
+		Host:           host,
+		Port:           portNum,
+		Username:       "admin",
+		EnablePassword: enable,
+		Insecure:       true,
+		DisableCache:   true,
+	})
+	if err := dev.Connect(context.Background()); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	t.Cleanup(func() { _ = dev.Disconnect() })
+	return dev, api
+}
+
+func TestEOSExecutePayload(t *testing.T) {
+	dev, api := newTestEOSDevice(t, "")
+
+	if _, err := dev.Execute(context.Background(), Command{Template: "show version", Format: "json"}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	params := api.last(t)
+	if got, want := params["cmds"], []interface{}{"show version"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("cmds = %#v, want %#v (revision and enable omitted)", got, want)
+	}
+
+	if _, err := dev.Execute(context.Background(), Command{Template: "show running-config", Format: "text"}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if format := api.last(t)["format"]; format != "text" {
+		t.Errorf("format = %v, want text", format)
+	}
+}
+
+func TestEOSExecuteRevisionAndEnable(t *testing.T) {
+	dev, api := newTestEOSDevice(t, "s3cret")
+
+	res, err := dev.Execute(context.Background(), Command{Template: "show bgp evpn summary", Format: "json", Revision: 2})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	want := []interface{}{
+		map[string]interface{}{"cmd": "enable", "input": "s3cret"},
+		map[string]interface{}{"cmd": "show bgp evpn summary", "revision": float64(2)},
+	}
+	if got := api.last(t)["cmds"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("cmds = %#v, want %#v", got, want)
+	}
+	// The enable step's empty result must not be handed back as output.
+	if out, _ := res.Output.(map[string]interface{}); out["index"] != float64(1) {
+		t.Errorf("Output = %#v, want the command's result", res.Output)
+	}
+}
+
+func TestEOSExecuteDebugLogRedactsEnable(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "debug.log")
+	if err := logger.SetOutput(logFile); err != nil {
+		t.Fatal(err)
+	}
+	logger.SetLevel("debug")
+	t.Cleanup(func() {
+		logger.SetLevel("warn")
+		_ = logger.SetOutput("")
+	})
+
+	dev, _ := newTestEOSDevice(t, "s3cret")
+	if _, err := dev.Execute(context.Background(), Command{Template: "show version", Format: "json"}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+
+	logged, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(logged), "Payload created") {
+		t.Fatalf("expected the payload to be logged at debug level, got:\n%s", logged)
+	}
+	if strings.Contains(string(logged), "s3cret") {
+		t.Errorf("enable secret written to the debug log:\n%s", logged)
+	}
+}
+
+func TestEOSExecuteBatchRevisionAndEnable(t *testing.T) {
+	dev, api := newTestEOSDevice(t, "s3cret")
+
+	results, err := dev.ExecuteBatch(context.Background(), []Command{
+		{Template: "show version", Format: "json"},
+		{Template: "show bgp evpn summary", Format: "json", Revision: 3},
+	})
+	if err != nil {
+		t.Fatalf("ExecuteBatch: %v", err)
+	}
+	cmds, _ := api.last(t)["cmds"].([]interface{})
+	if len(cmds) != 3 {
+		t.Fatalf("cmds = %#v, want enable plus two commands", cmds)
+	}
+	if enable, _ := cmds[0].(map[string]interface{}); enable["cmd"] != "enable" || enable["input"] != "s3cret" {
+		t.Errorf("cmds[0] = %#v, want enable with secret", cmds[0])
+	}
+	if first, _ := cmds[1].(map[string]interface{}); first["revision"] != nil {
+		t.Errorf("cmds[1] = %#v, want no revision", cmds[1])
+	}
+	if second, _ := cmds[2].(map[string]interface{}); second["revision"] != float64(3) {
+		t.Errorf("cmds[2] = %#v, want revision 3", cmds[2])
+	}
+	for i, res := range results {
+		if out, _ := res.Output.(map[string]interface{}); out["index"] != float64(i+1) {
+			t.Errorf("results[%d].Output = %#v, want result %d", i, res.Output, i+1)
+		}
+	}
+}
+
+func TestEOSExecuteCommandTimeout(t *testing.T) {
+	dev, api := newTestEOSDevice(t, "")
+	api.slow = 2 * time.Second
+	ctx := context.Background()
+
+	_, err := dev.Execute(ctx, Command{Template: "show tech-support", Format: "text", Timeout: 50 * time.Millisecond})
+	if !errors.Is(err, ErrCommandTimeout) {
+		t.Fatalf("slow command err = %v, want ErrCommandTimeout", err)
+	}
+	if !strings.Contains(err.Error(), "show tech-support exceeded 50ms") {
+		t.Errorf("err = %q, want the command and its budget", err)
+	}
+
+	// Other commands on the same device are unaffected.
+	if _, err := dev.Execute(ctx, Command{Template: "show version", Format: "json", Timeout: 50 * time.Millisecond}); err != nil {
+		t.Errorf("fast command: %v", err)
+	}
+
+	// A longer per-command budget lets the slow command finish.
+	api.slow = 100 * time.Millisecond
+	if _, err := dev.Execute(ctx, Command{Template: "show tech-support", Format: "text", Timeout: time.Second}); err != nil {
+		t.Errorf("slow command within budget: %v", err)
+	}
+}
+
+func TestEOSExecuteCancelledRunIsNotCommandTimeout(t *testing.T) {
+	dev, api := newTestEOSDevice(t, "")
+	api.slow = 2 * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := dev.Execute(ctx, Command{Template: "show tech-support", Format: "text", Timeout: time.Second})
+	if err == nil || errors.Is(err, ErrCommandTimeout) {
+		t.Fatalf("err = %v, want run-context failure distinct from ErrCommandTimeout", err)
+	}
+}
+
+func TestBatchTimeout(t *testing.T) {
+	cmds := []Command{{Template: "a", Timeout: time.Second}, {Template: "b"}}
+	if got := batchTimeout(cmds, 15*time.Second); got != 15*time.Second {
+		t.Errorf("batchTimeout with fallback = %v, want 15s", got)
+	}
+	if got := batchTimeout(cmds, 0); got != 0 {
+		t.Errorf("batchTimeout without fallback = %v, want unbounded", got)
+	}
+	cmds[1].Timeout = time.Minute
+	if got := batchTimeout(cmds, 0); got != time.Minute {
+		t.Errorf("batchTimeout = %v, want 1m", got)
+	}
+}
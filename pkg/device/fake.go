@@ -0,0 +1,226 @@
+package device
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// TestingT is the subset of testing.TB the FakeDevice fixture helpers
+// use, so pkg/device does not have to import the testing package.
+type TestingT interface {
+	Helper()
+	Fatalf(format string, args ...interface{})
+}
+
+// FakeDevice is an in-memory Device for unit tests. Execute answers from
+// Outputs keyed by the command template, Errors injects per-command
+// failures, and every command received is recorded in order. Outputs are
+// stored already decoded (see DecodeJSON) so tests see the same
+// map[string]interface{} / float64 shapes the real transports hand out.
+//
+// Configure Outputs, Errors and the diag hooks before handing the device
+// to the code under test; only call recording is safe for concurrent use.
+type FakeDevice struct {
+	DeviceName string
+	Model      string
+	Outputs    map[string]interface{}
+	Errors     map[string]error
+
+	// PingFunc and TracerouteFunc serve the diag RPCs. When nil the
+	// device reports ErrDiagUnsupported, like the eAPI transport.
+	PingFunc       func(ctx context.Context, opts PingOpts) (*PingResult, error)
+	TracerouteFunc func(ctx context.Context, opts TracerouteOpts) (*TracerouteResult, error)
+
+	// BatchError makes ExecuteBatch fail as a whole, as eAPI does when
+	// EOS rejects one command of a runCmds request.
+	BatchError error
+
+	mu          sync.Mutex
+	commands    []Command
+	batches     [][]string
+	cancelAfter int
+	cancel      context.CancelFunc
+}
+
+// NewFakeDevice returns an empty FakeDevice named "fake".
+func NewFakeDevice() *FakeDevice {
+	return &FakeDevice{
+		DeviceName: "fake",
+		Model:      "fake",
+		Outputs:    map[string]interface{}{},
+		Errors:     map[string]error{},
+	}
+}
+
+// On registers output as the result of cmd.
+func (f *FakeDevice) On(cmd string, output interface{}) *FakeDevice {
+	f.Outputs[cmd] = output
+	return f
+}
+
+// OnJSON registers the decoded JSON body as the result of cmd.
+func (f *FakeDevice) OnJSON(t TestingT, cmd, body string) *FakeDevice {
+	t.Helper()
+	return f.On(cmd, DecodeJSON(t, body))
+}
+
+// OnText registers body as the format=text result of cmd, wrapped the way
+// eAPI returns text output.
+func (f *FakeDevice) OnText(cmd, body string) *FakeDevice {
+	return f.On(cmd, map[string]interface{}{"output": body})
+}
+
+// OnError makes Execute fail for cmd with err.
+func (f *FakeDevice) OnError(cmd string, err error) *FakeDevice {
+	f.Errors[cmd] = err
+	return f
+}
+
+// CancelAfter calls cancel once Execute has answered n commands, so tests
+// can cancel a run part-way through its command loop.
+func (f *FakeDevice) CancelAfter(n int, cancel context.CancelFunc) *FakeDevice {
+	f.cancelAfter, f.cancel = n, cancel
+	return f
+}
+
+// LoadFixture registers the contents of path as the result of cmd. Files
+// ending in .json are decoded; anything else is treated as text output.
+func (f *FakeDevice) LoadFixture(t TestingT, cmd, path string) *FakeDevice {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	if filepath.Ext(path) == ".json" {
+		return f.OnJSON(t, cmd, string(data))
+	}
+	return f.OnText(cmd, string(data))
+}
+
+// LoadFixtures registers every file in dir, deriving the command from the
+// file name with underscores read as spaces: testdata/show_version.json
+// answers "show version" and testdata/show_running-config.txt answers
+// "show running-config".
+func (f *FakeDevice) LoadFixtures(t TestingT, dir string) *FakeDevice {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read fixture dir: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		cmd := strings.ReplaceAll(strings.TrimSuffix(name, filepath.Ext(name)), "_", " ")
+		f.LoadFixture(t, cmd, filepath.Join(dir, name))
+	}
+	return f
+}
+
+// DecodeJSON decodes body the way the transports decode device output.
+func DecodeJSON(t TestingT, body string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		t.Fatalf("bad fixture JSON: %v", err)
+	}
+	return v
+}
+
+// Calls returns the templates of every command received, in order.
+func (f *FakeDevice) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	calls := make([]string, len(f.commands))
+	for i, cmd := range f.commands {
+		calls[i] = cmd.Template
+	}
+	return calls
+}
+
+// Commands returns every command received, in order.
+func (f *FakeDevice) Commands() []Command {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Command(nil), f.commands...)
+}
+
+// Batches returns the templates of every ExecuteBatch call, one slice
+// per call, so tests can assert how many round-trips were made. The
+// batched commands also appear in Calls.
+func (f *FakeDevice) Batches() [][]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([][]string(nil), f.batches...)
+}
+
+func (f *FakeDevice) Name() string                  { return f.DeviceName }
+func (f *FakeDevice) Host() string                  { return "127.0.0.1" }
+func (f *FakeDevice) Tags() []string                { return nil }
+func (f *FakeDevice) Connect(context.Context) error { return nil }
+func (f *FakeDevice) Disconnect() error             { return nil }
+func (f *FakeDevice) IsOnline() bool                { return true }
+func (f *FakeDevice) IsEstablished() bool           { return true }
+func (f *FakeDevice) HardwareModel() string         { return f.Model }
+func (f *FakeDevice) Refresh(context.Context) error { return nil }
+
+func (f *FakeDevice) Execute(_ context.Context, cmd Command) (*CommandResult, error) {
+	f.mu.Lock()
+	f.commands = append(f.commands, cmd)
+	if f.cancel != nil && len(f.commands) >= f.cancelAfter {
+		defer f.cancel()
+	}
+	f.mu.Unlock()
+
+	if err, ok := f.Errors[cmd.Template]; ok {
+		return nil, err
+	}
+	out, ok := f.Outputs[cmd.Template]
+	if !ok {
+		return nil, fmt.Errorf("fake: no output registered for %q", cmd.Template)
+	}
+	return &CommandResult{Command: cmd, Output: out}, nil
+}
+
+func (f *FakeDevice) ExecuteBatch(ctx context.Context, cmds []Command) ([]*CommandResult, error) {
+	batch := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		batch[i] = cmd.Template
+	}
+	f.mu.Lock()
+	f.batches = append(f.batches, batch)
+	f.mu.Unlock()
+	if f.BatchError != nil {
+		return nil, f.BatchError
+	}
+
+	results := make([]*CommandResult, len(cmds))
+	for i, cmd := range cmds {
+		res, err := f.Execute(ctx, cmd)
+		if err != nil {
+			res = &CommandResult{Command: cmd, Error: err}
+		}
+		results[i] = res
+	}
+	return results, nil
+}
+
+func (f *FakeDevice) Ping(ctx context.Context, opts PingOpts) (*PingResult, error) {
+	if f.PingFunc == nil {
+		return nil, ErrDiagUnsupported
+	}
+	return f.PingFunc(ctx, opts)
+}
+
+func (f *FakeDevice) Traceroute(ctx context.Context, opts TracerouteOpts) (*TracerouteResult, error) {
+	if f.TracerouteFunc == nil {
+		return nil, ErrDiagUnsupported
+	}
+	return f.TracerouteFunc(ctx, opts)
+}
@@ -0,0 +1,126 @@
+package device
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFakeDeviceExecute(t *testing.T) {
+	dev := NewFakeDevice().
+		OnJSON(t, "show version", `{"version": "4.34.4M", "uptime": 10}`).
+		OnText("show clock", "Wed Oct 14 10:00:00 2026\n")
+
+	res, err := dev.Execute(context.Background(), Command{Template: "show version", Format: "json"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	want := map[string]interface{}{"version": "4.34.4M", "uptime": float64(10)}
+	if !reflect.DeepEqual(res.Output, want) {
+		t.Errorf("Output = %#v, want %#v", res.Output, want)
+	}
+
+	res, err = dev.Execute(context.Background(), Command{Template: "show clock", Format: "text"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if text, _ := TextOutput(res.Output); text != "Wed Oct 14 10:00:00 2026\n" {
+		t.Errorf("text output = %q", text)
+	}
+
+	if _, err := dev.Execute(context.Background(), Command{Template: "show version detail"}); err == nil {
+		t.Error("expected error for unregistered command")
+	}
+}
+
+func TestFakeDeviceErrors(t *testing.T) {
+	boom := errors.New("boom")
+	dev := NewFakeDevice().
+		OnJSON(t, "show version", `{}`).
+		OnError("show version", boom)
+
+	if _, err := dev.Execute(context.Background(), Command{Template: "show version"}); !errors.Is(err, boom) {
+		t.Errorf("Execute err = %v, want injected error", err)
+	}
+
+	results, err := dev.ExecuteBatch(context.Background(), []Command{{Template: "show version"}})
+	if err != nil {
+		t.Fatalf("ExecuteBatch: %v", err)
+	}
+	if !errors.Is(results[0].Error, boom) {
+		t.Errorf("batch result error = %v, want injected error", results[0].Error)
+	}
+}
+
+func TestFakeDeviceRecordsCalls(t *testing.T) {
+	dev := NewFakeDevice().OnJSON(t, "show version", `{}`)
+	ctx := context.Background()
+	_, _ = dev.Execute(ctx, Command{Template: "show version", Format: "json"})
+	_, _ = dev.ExecuteBatch(ctx, []Command{{Template: "show hostname"}, {Template: "show version", UseCache: true}})
+
+	if got, want := dev.Calls(), []string{"show version", "show hostname", "show version"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Calls = %v, want %v", got, want)
+	}
+	if cmds := dev.Commands(); len(cmds) != 3 || cmds[0].Format != "json" || !cmds[2].UseCache {
+		t.Errorf("Commands = %+v", cmds)
+	}
+}
+
+func TestFakeDeviceCancelAfter(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dev := NewFakeDevice().OnJSON(t, "show version", `{}`).CancelAfter(2, cancel)
+
+	_, _ = dev.Execute(ctx, Command{Template: "show version"})
+	if ctx.Err() != nil {
+		t.Fatal("cancelled after the first command")
+	}
+	_, _ = dev.Execute(ctx, Command{Template: "show version"})
+	if ctx.Err() == nil {
+		t.Fatal("not cancelled after the second command")
+	}
+}
+
+func TestFakeDeviceDiag(t *testing.T) {
+	dev := NewFakeDevice()
+	if _, err := dev.Ping(context.Background(), PingOpts{Destination: "10.0.0.1"}); !errors.Is(err, ErrDiagUnsupported) {
+		t.Errorf("Ping err = %v, want ErrDiagUnsupported", err)
+	}
+	if _, err := dev.Traceroute(context.Background(), TracerouteOpts{Destination: "10.0.0.1"}); !errors.Is(err, ErrDiagUnsupported) {
+		t.Errorf("Traceroute err = %v, want ErrDiagUnsupported", err)
+	}
+
+	dev.PingFunc = func(_ context.Context, opts PingOpts) (*PingResult, error) {
+		return &PingResult{Destination: opts.Destination, Stats: PingStats{Sent: 1, Received: 1}}, nil
+	}
+	res, err := dev.Ping(context.Background(), PingOpts{Destination: "10.0.0.1"})
+	if err != nil || res.Stats.Received != 1 {
+		t.Errorf("Ping = %+v, %v", res, err)
+	}
+}
+
+func TestFakeDeviceFixtures(t *testing.T) {
+	dev := NewFakeDevice().LoadFixtures(t, "testdata/fake")
+
+	res, err := dev.Execute(context.Background(), Command{Template: "show version"})
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if out, _ := res.Output.(map[string]interface{}); out["modelName"] != "DCS-7280SR3-48YC8" {
+		t.Errorf("show version output = %v", res.Output)
+	}
+
+	config, err := RunningConfig(context.Background(), dev)
+	if err != nil {
+		t.Fatalf("RunningConfig: %v", err)
+	}
+	if config != "! Command: show running-config\nhostname leaf1\n!\nend\n" {
+		t.Errorf("RunningConfig = %q", config)
+	}
+
+	dev = NewFakeDevice().LoadFixture(t, "show hostname", "testdata/fake/show_version.json")
+	if _, err := dev.Execute(context.Background(), Command{Template: "show hostname"}); err != nil {
+		t.Errorf("LoadFixture: %v", err)
+	}
+}
@@ -155,9 +155,16 @@ func (d *GNMIDevice) Execute(ctx context.Context, cmd Command) (*CommandResult,
 		}
 	}
 
-	result, err := d.executeOnTarget(ctx, target, cmd)
+	cmdCtx := ctx
+	if cmd.Timeout > 0 {
+		var cancel context.CancelFunc
+		cmdCtx, cancel = context.WithTimeout(ctx, cmd.Timeout)
+		defer cancel()
+	}
+
+	result, err := d.executeOnTarget(cmdCtx, target, cmd)
 	if err != nil {
-		return nil, err
+		return nil, commandTimeoutError(ctx, cmdCtx, cmd.Timeout, d.expandTemplate(cmd), err)
 	}
 
 	if cmd.UseCache && d.cache != nil {
@@ -361,6 +368,14 @@ func (d *GNMIDevice) ExecuteBatch(ctx context.Context, cmds []Command) ([]*Comma
 	}
 	sort.Strings(encodings)
 
+	batchCtx := ctx
+	budget := batchTimeout(cmds, 0)
+	if budget > 0 {
+		var cancel context.CancelFunc
+		batchCtx, cancel = context.WithTimeout(ctx, budget)
+		defer cancel()
+	}
+
 	for _, encoding := range encodings {
 		group := byEncoding[encoding]
 		opts := []gnmiapi.GNMIOption{gnmiapi.Encoding(encoding)}
@@ -373,8 +388,9 @@ func (d *GNMIDevice) ExecuteBatch(ctx context.Context, cmds []Command) ([]*Comma
 		}
 
 		start := time.Now()
-		resp, err := target.Get(ctx, req)
+		resp, err := target.Get(batchCtx, req)
 		if err != nil {
+			err = commandTimeoutError(ctx, batchCtx, budget, fmt.Sprintf("batch of %d commands", len(group)), err)
 			return nil, fmt.Errorf("device %s: gNMI batch Get (%s): %w", d.Config.Name, encoding, err)
 		}
 		perCmd := time.Since(start) / time.Duration(len(group))
@@ -0,0 +1,117 @@
+package device
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Template is a CLI command with named {placeholders}, for example
+//
+//	show bgp neighbors {peer} vrf {vrf}
+//
+// Render substitutes the placeholders and rejects missing, unknown or
+// unsafe parameters, so a bad input surfaces as a clear error (ideally in
+// ValidateInput) rather than as an odd command sent to the device.
+type Template string
+
+// Placeholders returns the placeholder names in t, in order of first
+// appearance.
+func (t Template) Placeholders() ([]string, error) {
+	var names []string
+	seen := map[string]bool{}
+	_, err := t.walk(func(name string) (string, error) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+		return "", nil
+	})
+	return names, err
+}
+
+// Render returns t with every placeholder replaced by its value from
+// params. Every placeholder must be supplied, every parameter must be
+// used, and each value must render to a single CLI token: non-empty, with
+// no whitespace, control characters, braces, or the "|" and "!" that EOS
+// treats as output modifiers and comments.
+func (t Template) Render(params map[string]any) (string, error) {
+	used := map[string]bool{}
+	out, err := t.walk(func(name string) (string, error) {
+		value, ok := params[name]
+		if !ok {
+			return "", fmt.Errorf("template %q: missing parameter %q", string(t), name)
+		}
+		used[name] = true
+		s := fmt.Sprint(value)
+		if err := checkToken(s); err != nil {
+			return "", fmt.Errorf("template %q: parameter %q: %w", string(t), name, err)
+		}
+		return s, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	for name := range params {
+		if !used[name] {
+			return "", fmt.Errorf("template %q: unknown parameter %q", string(t), name)
+		}
+	}
+	return out, nil
+}
+
+// walk scans t, calling sub for each placeholder and building the result
+// from its return values.
+func (t Template) walk(sub func(name string) (string, error)) (string, error) {
+	var b strings.Builder
+	s := string(t)
+	for {
+		open := strings.IndexAny(s, "{}")
+		if open < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		if s[open] == '}' {
+			return "", fmt.Errorf("template %q: unmatched '}'", string(t))
+		}
+		end := strings.IndexAny(s[open+1:], "{}")
+		if end < 0 || s[open+1+end] != '}' {
+			return "", fmt.Errorf("template %q: unterminated placeholder", string(t))
+		}
+		name := s[open+1 : open+1+end]
+		if !validPlaceholder(name) {
+			return "", fmt.Errorf("template %q: invalid placeholder name %q", string(t), name)
+		}
+		value, err := sub(name)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(s[:open])
+		b.WriteString(value)
+		s = s[open+end+2:]
+	}
+}
+
+func validPlaceholder(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+func checkToken(s string) error {
+	if s == "" {
+		return fmt.Errorf("value is empty")
+	}
+	for _, r := range s {
+		if unicode.IsSpace(r) || unicode.IsControl(r) || strings.ContainsRune("{}|!", r) {
+			return fmt.Errorf("value %q is not a single CLI token", s)
+		}
+	}
+	return nil
+}
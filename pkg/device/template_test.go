@@ -0,0 +1,68 @@
+package device
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTemplateRender(t *testing.T) {
+	tmpl := Template("show bgp neighbors {peer} {direction}-routes vrf {vrf}")
+
+	got, err := tmpl.Render(map[string]any{"peer": "10.0.0.1", "direction": "received", "vrf": "PROD"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if want := "show bgp neighbors 10.0.0.1 received-routes vrf PROD"; got != want {
+		t.Errorf("Render = %q, want %q", got, want)
+	}
+
+	got, err = Template("show bgp evpn route-type ip-prefix {prefix} vni {vni}").
+		Render(map[string]any{"prefix": "10.1.0.0/24", "vni": 10100})
+	if err != nil || got != "show bgp evpn route-type ip-prefix 10.1.0.0/24 vni 10100" {
+		t.Errorf("Render = %q, %v", got, err)
+	}
+
+	if got, err := Template("show version").Render(nil); err != nil || got != "show version" {
+		t.Errorf("Render without placeholders = %q, %v", got, err)
+	}
+}
+
+func TestTemplateRenderErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		tmpl   Template
+		params map[string]any
+		want   string
+	}{
+		{"missing", "show bgp neighbors {peer} vrf {vrf}", map[string]any{"peer": "10.0.0.1"},
+			`missing parameter "vrf"`},
+		{"unknown", "show ip route vrf {vrf}", map[string]any{"vrf": "PROD", "prefix": "10.0.0.0/8"},
+			`unknown parameter "prefix"`},
+		{"empty", "show ip route vrf {vrf}", map[string]any{"vrf": ""}, `parameter "vrf": value is empty`},
+		{"space", "show ip route vrf {vrf}", map[string]any{"vrf": "PROD detail"}, "not a single CLI token"},
+		{"pipe", "show ip route vrf {vrf}", map[string]any{"vrf": "PROD | json"}, "not a single CLI token"},
+		{"newline", "show ip route vrf {vrf}", map[string]any{"vrf": "PROD\nreload"}, "not a single CLI token"},
+		{"unterminated", "show ip route vrf {vrf", map[string]any{"vrf": "PROD"}, "unterminated placeholder"},
+		{"unmatched", "show ip route vrf vrf}", nil, "unmatched '}'"},
+		{"bad name", "show ip route vrf {1vrf}", map[string]any{"1vrf": "PROD"}, "invalid placeholder name"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.tmpl.Render(tc.params)
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Render err = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestTemplatePlaceholders(t *testing.T) {
+	names, err := Template("show {family} route vrf {vrf} {prefix} vrf {vrf}").Placeholders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"family", "vrf", "prefix"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("Placeholders = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,90 @@
+package reporter
+
+import (
+	"sort"
+
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// Counts tallies devices by the status they reported.
+type Counts struct {
+	Total   int `json:"total"`
+	Success int `json:"success"`
+	Failure int `json:"failure"`
+	Error   int `json:"error"`
+	Skipped int `json:"skipped"`
+}
+
+func (c *Counts) add(s test.TestStatus) {
+	c.Total++
+	switch s {
+	case test.TestSuccess:
+		c.Success++
+	case test.TestFailure:
+		c.Failure++
+	case test.TestError:
+		c.Error++
+	case test.TestSkipped:
+		c.Skipped++
+	}
+}
+
+// TestSummary is the fleet-wide outcome of one test. FailingDevices lists,
+// sorted, every device that reported a failure or an error for it, and
+// Severity is the most severe one its results carried.
+type TestSummary struct {
+	TestName       string        `json:"test_name"`
+	Severity       test.Severity `json:"severity,omitempty"`
+	Devices        Counts        `json:"devices"`
+	FailingDevices []string      `json:"failing_devices,omitempty"`
+}
+
+// Summary answers "which tests are failing fleet-wide". Tests are ordered
+// worst first: most failing devices, then by name.
+type Summary struct {
+	Tests  []TestSummary `json:"tests"`
+	Totals Counts        `json:"totals"`
+}
+
+// Aggregate groups results by test name and counts devices per status.
+// A device that reported a test more than once (the catalog lists it
+// with several input sets) is counted once, at its most severe status.
+func Aggregate(results []test.TestResult) Summary {
+	worst := map[string]map[string]test.TestStatus{}
+	severity := map[string]test.Severity{}
+	for _, res := range results {
+		if cur := severity[res.TestName]; res.Severity != "" && (cur == "" || !cur.AtLeast(res.Severity)) {
+			severity[res.TestName] = res.Severity
+		}
+		byDevice, ok := worst[res.TestName]
+		if !ok {
+			byDevice = map[string]test.TestStatus{}
+			worst[res.TestName] = byDevice
+		}
+		if prev, seen := byDevice[res.DeviceName]; !seen || statusRank(res.Status) < statusRank(prev) {
+			byDevice[res.DeviceName] = res.Status
+		}
+	}
+
+	var summary Summary
+	for name, byDevice := range worst {
+		ts := TestSummary{TestName: name, Severity: severity[name]}
+		for dev, status := range byDevice {
+			ts.Devices.add(status)
+			summary.Totals.add(status)
+			if status == test.TestFailure || status == test.TestError {
+				ts.FailingDevices = append(ts.FailingDevices, dev)
+			}
+		}
+		sort.Strings(ts.FailingDevices)
+		summary.Tests = append(summary.Tests, ts)
+	}
+	sort.Slice(summary.Tests, func(i, j int) bool {
+		a, b := summary.Tests[i], summary.Tests[j]
+		if len(a.FailingDevices) != len(b.FailingDevices) {
+			return len(a.FailingDevices) > len(b.FailingDevices)
+		}
+		return a.TestName < b.TestName
+	})
+	return summary
+}
@@ -55,6 +55,29 @@ func Render(w io.Writer, r *Report) error {
 	return reportTemplate.ExecuteTemplate(w, "report", view)
 }
 
+// RenderJSON writes r as indented JSON, for tooling that post-processes
+// results. Statuses are encoded by name.
+func RenderJSON(w io.Writer, r *Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// Formats lists the names accepted by ForFormat.
+var Formats = []string{"html", "json"}
+
+// ForFormat returns the render function for a reporter name.
+func ForFormat(name string) (func(io.Writer, *Report) error, error) {
+	switch name {
+	case "html":
+		return Render, nil
+	case "json":
+		return RenderJSON, nil
+	default:
+		return nil, fmt.Errorf("unknown reporter %q (supported: %s)", name, strings.Join(Formats, ", "))
+	}
+}
+
 // ----------------------------------------------------------------------
 // Internal view model: server-side grouping of results by device, plus
 // JSON-pretty-printed Details. Keeps the template logic-free.
@@ -83,6 +106,7 @@ type testView struct {
 	StatusText string // "SUCCESS" | "FAILURE" | ...
 	Message    string
 	Categories []string
+	Severity   string // "critical" | "major" | "minor"; empty when unset
 	Duration   string
 	Blocks     []detailBlock // structured detail sections; rendered as tables/dls
 	Details    string        // JSON fallback when Details isn't a recognised shape
@@ -307,6 +331,7 @@ func buildTestView(res test.TestResult) testView {
 		StatusText: strings.ToUpper(statusSlug(res.Status)),
 		Message:    res.Message,
 		Categories: res.Categories,
+		Severity:   string(res.Severity),
 		Duration:   res.Duration.Truncate(time.Millisecond).String(),
 	}
 	tv.Blocks, tv.Details = renderDetails(res.Details)
@@ -318,37 +343,31 @@ func buildTestView(res test.TestResult) testView {
 // issue lists) into structured blocks. Unknown content falls through
 // to a pretty-printed JSON block so nothing is hidden.
 //
-// Recognised top-level keys when Details is a map:
+// Recognised top-level keys:
 //
 //	fans            → []fanRow  → "fans" block, rendered as a table
 //	power_supplies  → []psuRow  → "psus" block, rendered as a table
 //	issues          → []string  → "issues" block, rendered as a list
 //	any other scalar → kv pair → "summary" block, rendered as a dl
 //
-// Anything we can't make sense of (Details isn't a map, or contains
-// nested structures we don't know) becomes a JSON block at the end.
-func renderDetails(d any) (blocks []detailBlock, jsonFallback string) {
-	if d == nil {
+// Anything we can't make sense of (nested structures we don't know)
+// becomes a JSON block at the end.
+func renderDetails(d test.Details) (blocks []detailBlock, jsonFallback string) {
+	if len(d) == 0 {
 		return nil, ""
 	}
 
 	// Normalise via a JSON round-trip so we get map[string]any /
-	// []any regardless of whether the test populated Details with a
-	// typed struct or an ad-hoc map.
+	// []any regardless of whether the test populated Details values with
+	// typed structs or ad-hoc maps.
 	raw, err := json.Marshal(d)
 	if err != nil {
 		return nil, fmt.Sprintf("%+v", d)
 	}
-	var top any
-	if err := json.Unmarshal(raw, &top); err != nil {
+	var m map[string]any
+	if err := json.Unmarshal(raw, &m); err != nil {
 		return nil, string(raw)
 	}
-	m, ok := top.(map[string]any)
-	if !ok {
-		// Non-object Details (a bare list or scalar) — JSON-dump it.
-		b, _ := json.MarshalIndent(d, "", "  ")
-		return nil, string(b)
-	}
 
 	consumed := map[string]bool{}
 
@@ -1,6 +1,8 @@
 package reporter
 
 import (
+	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 	"time"
@@ -609,3 +611,86 @@ func TestRender_StatusOrdering(t *testing.T) {
 		t.Errorf("failures should sort before successes; failure @%d, success @%d", failIdx, succIdx)
 	}
 }
+
+func TestRenderJSON(t *testing.T) {
+	render, err := ForFormat("json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := render(&buf, sampleReport()); err != nil {
+		t.Fatalf("RenderJSON: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(decoded.Results) != 3 || decoded.Results[2].Status != test.TestFailure {
+		t.Errorf("results = %+v", decoded.Results)
+	}
+	if !strings.Contains(buf.String(), `"status": "failure"`) {
+		t.Errorf("statuses should be encoded by name:\n%s", buf.String())
+	}
+
+	if _, err := ForFormat("xml"); err == nil || !strings.Contains(err.Error(), "html, json") {
+		t.Errorf("ForFormat(xml) = %v, want supported list", err)
+	}
+}
+
+func TestAggregate_GroupsByTestAcrossDevices(t *testing.T) {
+	results := []test.TestResult{
+		{TestName: "VerifyHostname", DeviceName: "leaf1", Status: test.TestSuccess},
+		{TestName: "VerifyHostname", DeviceName: "leaf2", Status: test.TestFailure},
+		{TestName: "VerifyHostname", DeviceName: "spine1", Status: test.TestSuccess},
+		{TestName: "VerifyBGPPeers", DeviceName: "spine1", Status: test.TestError, Severity: test.SeverityCritical},
+		{TestName: "VerifyBGPPeers", DeviceName: "leaf2", Status: test.TestFailure},
+		{TestName: "VerifyBGPPeers", DeviceName: "leaf1", Status: test.TestSuccess},
+		// Same test twice on one device: counted once at its worst status.
+		{TestName: "VerifyBGPPeers", DeviceName: "leaf1", Status: test.TestFailure},
+		{TestName: "VerifyNTP", DeviceName: "leaf1", Status: test.TestSkipped},
+		{TestName: "VerifyNTP", DeviceName: "leaf2", Status: test.TestSuccess},
+	}
+
+	s := Aggregate(results)
+	if len(s.Tests) != 3 {
+		t.Fatalf("got %d tests, want 3: %+v", len(s.Tests), s.Tests)
+	}
+
+	bgp := s.Tests[0]
+	if bgp.TestName != "VerifyBGPPeers" {
+		t.Fatalf("worst test = %s, want VerifyBGPPeers", bgp.TestName)
+	}
+	if bgp.Severity != test.SeverityCritical {
+		t.Errorf("VerifyBGPPeers severity = %q, want critical", bgp.Severity)
+	}
+	if want := (Counts{Total: 3, Failure: 2, Error: 1}); bgp.Devices != want {
+		t.Errorf("VerifyBGPPeers counts = %+v, want %+v", bgp.Devices, want)
+	}
+	if got := strings.Join(bgp.FailingDevices, ","); got != "leaf1,leaf2,spine1" {
+		t.Errorf("VerifyBGPPeers failing devices = %s", got)
+	}
+
+	host := s.Tests[1]
+	if host.TestName != "VerifyHostname" || host.Devices != (Counts{Total: 3, Success: 2, Failure: 1}) {
+		t.Errorf("VerifyHostname summary = %+v", host)
+	}
+	if got := strings.Join(host.FailingDevices, ","); got != "leaf2" {
+		t.Errorf("VerifyHostname failing devices = %s", got)
+	}
+
+	ntp := s.Tests[2]
+	if ntp.TestName != "VerifyNTP" || len(ntp.FailingDevices) != 0 || ntp.Devices != (Counts{Total: 2, Success: 1, Skipped: 1}) {
+		t.Errorf("VerifyNTP summary = %+v", ntp)
+	}
+
+	if want := (Counts{Total: 8, Success: 3, Failure: 3, Error: 1, Skipped: 1}); s.Totals != want {
+		t.Errorf("totals = %+v, want %+v", s.Totals, want)
+	}
+}
+
+func TestAggregate_Empty(t *testing.T) {
+	s := Aggregate(nil)
+	if len(s.Tests) != 0 || s.Totals != (Counts{}) {
+		t.Errorf("Aggregate(nil) = %+v", s)
+	}
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// BaselineProvider supplies command output recorded on a device earlier,
+// so counter tests can judge what changed since then instead of absolute
+// values that may still carry errors from long-fixed incidents.
+type BaselineProvider interface {
+	// Baseline returns the recorded JSON output of command on the named
+	// device, or false when none was recorded.
+	Baseline(deviceName, command string) (interface{}, bool)
+}
+
+// BaselineConsumer is implemented by counter tests that can compare
+// against a baseline. The runner hands SetBaseline the recorded output
+// of every command BaselineCommands lists that the provider has, keyed
+// by command; tests without a baseline keep checking absolute values.
+type BaselineConsumer interface {
+	BaselineCommands() []string
+	SetBaseline(outputs map[string]interface{})
+}
+
+// Baselines is a BaselineProvider backed by recorded output, keyed by
+// device name and then command.
+type Baselines map[string]map[string]interface{}
+
+func (b Baselines) Baseline(deviceName, command string) (interface{}, bool) {
+	output, ok := b[deviceName][command]
+	return output, ok
+}
+
+// LoadBaselines reads a baseline file: a JSON object of device names to
+// objects of commands to their JSON output, e.g. the body of
+// `show interfaces counters errors | json` captured after a maintenance
+// window.
+func LoadBaselines(path string) (Baselines, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline file: %w", err)
+	}
+	var b Baselines
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline file: %w", err)
+	}
+	return b, nil
+}
+
+// CounterDelta returns how far current has moved past baseline. A counter
+// below its baseline was cleared since the baseline was taken, so
+// everything it now holds is new.
+func CounterDelta(current, baseline int) int {
+	if current < baseline {
+		return current
+	}
+	return current - baseline
+}
+
+// applyBaseline gives a BaselineConsumer the recorded output the
+// provider has for its commands on deviceName.
+func applyBaseline(p BaselineProvider, t Test, deviceName string) {
+	consumer, ok := t.(BaselineConsumer)
+	if !ok || p == nil {
+		return
+	}
+	outputs := map[string]interface{}{}
+	for _, cmd := range consumer.BaselineCommands() {
+		if output, ok := p.Baseline(deviceName, cmd); ok {
+			outputs[cmd] = output
+		}
+	}
+	if len(outputs) > 0 {
+		consumer.SetBaseline(outputs)
+	}
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+// baselineTest reports the counter it reads from the device, minus the
+// baseline's when the runner supplied one.
+type baselineTest struct {
+	BaseTest
+	baseline map[string]interface{}
+}
+
+func (t *baselineTest) BaselineCommands() []string { return []string{"show counters"} }
+
+func (t *baselineTest) SetBaseline(outputs map[string]interface{}) { t.baseline = outputs }
+
+func (t *baselineTest) Execute(ctx context.Context, dev device.Device) (*TestResult, error) {
+	out, err := dev.Execute(ctx, device.Command{Template: "show counters", Format: "json"})
+	if err != nil {
+		return nil, err
+	}
+	drops := int(out.Output.(map[string]interface{})["drops"].(float64))
+	if base, ok := t.baseline["show counters"].(map[string]interface{}); ok {
+		drops = CounterDelta(drops, int(base["drops"].(float64)))
+	}
+	if drops > 0 {
+		return &TestResult{TestName: t.Name(), DeviceName: dev.Name(), Status: TestFailure}, nil
+	}
+	return &TestResult{TestName: t.Name(), DeviceName: dev.Name(), Status: TestSuccess}, nil
+}
+
+func (t *baselineTest) ValidateInput(_ any) error { return nil }
+
+func TestRunner_BaselineProvider(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("interfaces", "VerifyDrops", func(map[string]any) (Test, error) {
+		return &baselineTest{BaseTest: BaseTest{TestName: "VerifyDrops"}}, nil
+	})
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, []byte(`{"leaf1": {"show counters": {"drops": 900}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	baselines, err := LoadBaselines(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaf1 := device.NewFakeDevice().OnJSON(t, "show counters", `{"drops": 900}`)
+	leaf1.DeviceName = "leaf1"
+	leaf2 := device.NewFakeDevice().OnJSON(t, "show counters", `{"drops": 900}`)
+	leaf2.DeviceName = "leaf2"
+
+	runner := &Runner{maxConcurrency: 1, registry: r}
+	runner.SetBaselineProvider(baselines)
+	results, err := runner.Run(context.Background(), []TestDefinition{{Name: "VerifyDrops", Module: "interfaces"}},
+		[]device.Device{leaf1, leaf2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, res := range results {
+		want := TestSuccess
+		if res.DeviceName == "leaf2" {
+			want = TestFailure // no baseline: absolute counters apply
+		}
+		if res.Status != want {
+			t.Errorf("%s: status = %v, want %v", res.DeviceName, res.Status, want)
+		}
+	}
+}
+
+func TestCounterDelta(t *testing.T) {
+	if got := CounterDelta(15, 10); got != 5 {
+		t.Errorf("CounterDelta(15, 10) = %d, want 5", got)
+	}
+	// Counters cleared after the baseline count from zero again.
+	if got := CounterDelta(3, 10); got != 3 {
+		t.Errorf("CounterDelta(3, 10) = %d, want 3", got)
+	}
+}
@@ -0,0 +1,36 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Cancelled reports whether ctx is done and, if so, marks result as a
+// partial run: skipped when nothing was wrong so far, failed when issues
+// were already found, so --fail-on still sees them. Tests that issue a
+// command per peer, route or host call it at the top of each iteration so
+// a cancelled run stops sending commands straight away instead of working
+// through the rest of the list:
+//
+//	for i, peer := range t.Peers {
+//		if test.Cancelled(ctx, result, i, len(t.Peers), "peers", issues) {
+//			return result, nil
+//		}
+//		...
+//	}
+//
+// done is how many items were fully checked; issues found up to that point
+// are kept in the message so the partial work is not lost.
+func Cancelled(ctx context.Context, result *TestResult, done, total int, items string, issues []string) bool {
+	if ctx.Err() == nil {
+		return false
+	}
+	result.Status = TestSkipped
+	result.Message = fmt.Sprintf("Cancelled after checking %d of %d %s: %v", done, total, items, ctx.Err())
+	if len(issues) > 0 {
+		result.Status = TestFailure
+		result.Message += "; issues so far: " + strings.Join(issues, "; ")
+	}
+	return true
+}
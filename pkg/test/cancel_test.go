@@ -0,0 +1,70 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+func TestCancelled(t *testing.T) {
+	result := &TestResult{Status: TestSuccess}
+	if Cancelled(context.Background(), result, 0, 3, "peers", nil) {
+		t.Fatal("live context reported as cancelled")
+	}
+	if result.Status != TestSuccess {
+		t.Fatalf("result modified: %+v", result)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if !Cancelled(ctx, result, 2, 5, "peers", nil) {
+		t.Fatal("cancelled context not reported")
+	}
+	want := "Cancelled after checking 2 of 5 peers: context canceled"
+	if result.Status != TestSkipped || result.Message != want {
+		t.Errorf("result = %v %q, want skipped %q", result.Status, result.Message, want)
+	}
+}
+
+// TestCancelledKeepsFailures checks that a run cancelled after finding
+// issues still fails, so --fail-on does not let it exit clean.
+func TestCancelledKeepsFailures(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := &TestResult{Status: TestSuccess, Severity: SeverityMajor}
+	if !Cancelled(ctx, result, 2, 5, "peers", []string{"Peer 10.0.0.1 down"}) {
+		t.Fatal("cancelled context not reported")
+	}
+	want := "Cancelled after checking 2 of 5 peers: context canceled; issues so far: Peer 10.0.0.1 down"
+	if result.Status != TestFailure || result.Message != want {
+		t.Errorf("result = %v %q, want failure %q", result.Status, result.Message, want)
+	}
+	if !FailsAt([]TestResult{*result}, SeverityMinor) {
+		t.Error("a cancelled run with issues should trip --fail-on failure")
+	}
+}
+
+func TestRunner_CancelledRunReportsEveryJob(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("system", "VerifyA", newFakeRegTest)
+	runner := &Runner{maxConcurrency: 1, registry: r}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	defs := []TestDefinition{{Name: "VerifyA", Module: "system"}, {Name: "VerifyA", Module: "system"}, {Name: "VerifyA", Module: "system"}}
+	devs := []device.Device{device.NewFakeDevice(), device.NewFakeDevice()}
+	results, err := runner.Run(ctx, defs, devs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != len(defs)*len(devs) {
+		t.Fatalf("got %d results, want %d", len(results), len(defs)*len(devs))
+	}
+	for _, res := range results {
+		if res.Status != TestSkipped || !strings.Contains(res.Message, "cancelled") {
+			t.Errorf("result = %v %q, want skipped as cancelled", res.Status, res.Message)
+		}
+	}
+}
@@ -50,6 +50,16 @@ func (c *Catalog) Validate() error {
 		if test.Module == "" {
 			return fmt.Errorf("test '%s' has no module specified", test.Name)
 		}
+		if test.Severity != "" {
+			sev, err := ParseSeverity(string(test.Severity))
+			if err != nil {
+				return fmt.Errorf("test '%s': %w", test.Name, err)
+			}
+			c.Tests[i].Severity = sev
+		}
+		if test.Timeout < 0 {
+			return fmt.Errorf("test '%s': timeout must not be negative", test.Name)
+		}
 		if testNames[test.Name] {
 			return fmt.Errorf("duplicate test name: %s", test.Name)
 		}
@@ -89,6 +99,48 @@ func (c *Catalog) ValidateAgainst(reg *Registry) error {
 	return fmt.Errorf("catalog references unknown test(s): %v", unknown)
 }
 
+// ValidateInputs constructs every test in the catalog from reg and runs
+// its ValidateInput, returning one error per invalid entry rather than
+// stopping at the first. Unknown tests and constructor errors are
+// reported too, so a nil result means the whole catalog would start.
+func (c *Catalog) ValidateInputs(reg *Registry) []error {
+	var errs []error
+	for i, def := range c.Tests {
+		if err := validateDefinition(reg, def); err != nil {
+			errs = append(errs, fmt.Errorf("tests[%d] %s/%s: %w", i, def.Module, def.Name, err))
+		}
+	}
+	return errs
+}
+
+func validateDefinition(reg *Registry, def TestDefinition) (err error) {
+	// Constructors and validators are written against well-formed
+	// inputs; a panic on a malformed one is still just an invalid entry.
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	// The schema sees inside list entries, so its errors name the exact
+	// key; check it first, before a constructor quietly drops the typo.
+	if schema, ok := reg.InputSchema(def.Module, def.Name); ok {
+		inputs := def.Inputs
+		if probe, ok := reg.probe(def.Module, def.Name); ok {
+			if inputs, err = NormalizeInputs(inputs, probe); err != nil {
+				return err
+			}
+		}
+		if err := schema.Validate(inputs); err != nil {
+			return err
+		}
+	}
+	t, err := reg.GetTestWithInputs(def.Module, def.Name, def.Inputs)
+	if err != nil {
+		return err
+	}
+	return t.ValidateInput(def.Inputs)
+}
+
 // FilterByName keeps tests whose name appears in `names`. The returned
 // error names any requested names that didn't match anything — the
 // caller can log/exit/ignore as desired. The filtered catalog is still
@@ -160,6 +212,41 @@ func (c *Catalog) FilterByTags(tags []string) (*Catalog, error) {
 	return filtered, missingErr("tag(s)", wanted)
 }
 
+// FilterByCategories keeps tests in any of the given categories. A
+// definition without catalog categories is matched on the categories its
+// registered test declares, looked up in reg when non-nil.
+func (c *Catalog) FilterByCategories(categories []string, reg *Registry) (*Catalog, error) {
+	if len(categories) == 0 {
+		return c, nil
+	}
+
+	wanted := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		wanted[category] = false
+	}
+
+	filtered := &Catalog{Tests: make([]TestDefinition, 0)}
+	for _, test := range c.Tests {
+		testCategories := test.Categories
+		if len(testCategories) == 0 && reg != nil {
+			if t, err := reg.GetTestWithInputs(test.Module, test.Name, test.Inputs); err == nil {
+				testCategories = t.Categories()
+			}
+		}
+		appended := false
+		for _, category := range testCategories {
+			if _, ok := wanted[category]; ok {
+				wanted[category] = true
+				if !appended {
+					filtered.Tests = append(filtered.Tests, test)
+					appended = true
+				}
+			}
+		}
+	}
+	return filtered, missingErr("categories", wanted)
+}
+
 // missingErr returns nil when every key was matched, or a sorted error
 // listing the keys that found no rows. Shared by all three Catalog
 // filters and (separately defined) by the Inventory filters.
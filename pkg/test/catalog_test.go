@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
 )
@@ -99,3 +100,81 @@ func TestCatalog_FilterByName_EmptyInputPassesThrough(t *testing.T) {
 		t.Errorf("empty filter should return self with no error; got %v, %v", got, err)
 	}
 }
+
+func TestCatalog_FilterByCategories_FallsBackToRegisteredCategories(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("routing", "VerifyBGPPeers", func(map[string]any) (Test, error) {
+		return &fakeCatTest{BaseTest{TestCategories: []string{"routing", "bgp"}}}, nil
+	})
+	c := &Catalog{Tests: []TestDefinition{
+		{Name: "VerifyBGPPeers", Module: "routing"},
+		{Name: "VerifyTemperature", Module: "hardware", Categories: []string{"hardware"}},
+	}}
+
+	got, err := c.FilterByCategories([]string{"bgp"}, r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got.Tests) != 1 || got.Tests[0].Name != "VerifyBGPPeers" {
+		t.Errorf("expected registered categories to match, got %+v", got.Tests)
+	}
+
+	got, err = c.FilterByCategories([]string{"hardware", "security"}, r)
+	if err == nil || !strings.Contains(err.Error(), "security") {
+		t.Errorf("error should name 'security', got: %v", err)
+	}
+	if len(got.Tests) != 1 || got.Tests[0].Name != "VerifyTemperature" {
+		t.Errorf("expected catalog categories to match, got %+v", got.Tests)
+	}
+}
+
+func TestTestStatus_TextRoundTrip(t *testing.T) {
+	for _, s := range []TestStatus{TestUnset, TestSuccess, TestFailure, TestError, TestSkipped} {
+		text, _ := s.MarshalText()
+		var got TestStatus
+		if err := got.UnmarshalText(text); err != nil || got != s {
+			t.Errorf("round trip %v = %v, %v", s, got, err)
+		}
+	}
+	var s TestStatus
+	if err := s.UnmarshalText([]byte("flaky")); err == nil {
+		t.Error("expected error for unknown status")
+	}
+}
+
+func TestCatalog_ValidateInputs_ReportsEveryInvalidEntry(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("routing", "VerifyBGPPeers", newFakeRegTest)
+	_ = r.Register("system", "VerifyPanics", func(map[string]any) (Test, error) { panic("boom") })
+	c := &Catalog{Tests: []TestDefinition{
+		{Name: "VerifyBGPPeers", Module: "routing", Inputs: map[string]any{"hosts": []any{"a"}}},
+		{Name: "VerifyBGPPeers", Module: "routing", Inputs: map[string]any{"hostz": []any{"a"}}},
+		{Name: "VerifyPanics", Module: "system"},
+		{Name: "VerifyMissing", Module: "system"},
+	}}
+
+	errs := c.ValidateInputs(r)
+	if len(errs) != 3 {
+		t.Fatalf("got %d errors, want 3: %v", len(errs), errs)
+	}
+	for i, want := range []string{"tests[1] routing/VerifyBGPPeers", "tests[2] system/VerifyPanics: panic: boom", "tests[3] system/VerifyMissing"} {
+		if !strings.Contains(errs[i].Error(), want) {
+			t.Errorf("errs[%d] = %v, want %q", i, errs[i], want)
+		}
+	}
+}
+
+func TestParseCatalog_Timeout(t *testing.T) {
+	c, err := ParseCatalog(strings.NewReader("tests:\n  - name: VerifyBGPPeers\n    module: routing\n    timeout: 90s\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Tests[0].Timeout != 90*time.Second {
+		t.Errorf("timeout = %s, want 90s", c.Tests[0].Timeout)
+	}
+
+	_, err = ParseCatalog(strings.NewReader("tests:\n  - name: VerifyBGPPeers\n    module: routing\n    timeout: -1s\n"))
+	if err == nil || !strings.Contains(err.Error(), "timeout must not be negative") {
+		t.Errorf("expected negative timeout to be rejected, got %v", err)
+	}
+}
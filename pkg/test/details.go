@@ -0,0 +1,80 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Details is the structured payload a test attaches to its TestResult,
+// such as the values it compared or the per-item rows the HTML reporter
+// renders as tables. Values may be scalars, slices, maps or JSON-tagged
+// structs.
+//
+// Details used to be an untyped interface{} and some tests stored a
+// map[string]string in it. Existing assignments of map[string]any
+// literals still compile; other shapes go through DetailsFrom, and type
+// assertions such as res.Details.(map[string]any) become plain indexing.
+type Details map[string]interface{}
+
+// DetailsFrom converts v to Details. Maps with string keys and structs
+// are converted key by key through their JSON encoding; any other
+// non-nil value (a string, a bare slice) is stored under "value".
+func DetailsFrom(v interface{}) Details {
+	switch d := v.(type) {
+	case nil:
+		return nil
+	case Details:
+		return d
+	case map[string]interface{}:
+		return Details(d)
+	case map[string]string:
+		out := make(Details, len(d))
+		for k, s := range d {
+			out[k] = s
+		}
+		return out
+	}
+	if raw, err := json.Marshal(v); err == nil {
+		var m map[string]interface{}
+		if err := decodeNumbers(raw, &m); err == nil && m != nil {
+			return Details(m)
+		}
+	}
+	return Details{"value": v}
+}
+
+// MarshalJSON encodes d with object keys sorted at every level, including
+// inside struct values, which encoding/json would otherwise emit in field
+// order. A result therefore marshals to the same bytes before and after a
+// round trip through UnmarshalJSON, which golden-file tests rely on.
+func (d Details) MarshalJSON() ([]byte, error) {
+	if d == nil {
+		return []byte("null"), nil
+	}
+	raw, err := json.Marshal(map[string]interface{}(d))
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	if err := decodeNumbers(raw, &normalized); err != nil {
+		return nil, err
+	}
+	return json.Marshal(normalized)
+}
+
+// UnmarshalJSON decodes numbers as json.Number rather than float64, so
+// large counters keep their exact value across a round trip.
+func (d *Details) UnmarshalJSON(data []byte) error {
+	var m map[string]interface{}
+	if err := decodeNumbers(data, &m); err != nil {
+		return err
+	}
+	*d = Details(m)
+	return nil
+}
+
+func decodeNumbers(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
@@ -0,0 +1,86 @@
+package test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTestResultJSONRoundTrip(t *testing.T) {
+	type row struct {
+		Name     string `json:"name"`
+		Status   string `json:"status"`
+		InOctets uint64 `json:"in_octets"`
+	}
+	res := TestResult{
+		TestName:   "VerifyInterfaceErrors",
+		DeviceName: "leaf1",
+		Status:     TestFailure,
+		Message:    "Ethernet1: 3 input errors",
+		Duration:   1500 * time.Millisecond,
+		Timestamp:  time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC),
+		Categories: []string{"interfaces"},
+		Severity:   SeverityMajor,
+		Details: map[string]any{
+			"rows":     []row{{Name: "Ethernet1", Status: "errors", InOctets: 18446744073709551000}},
+			"checked":  48,
+			"ratio":    0.25,
+			"ignored":  []string{"Management1"},
+			"by_state": map[string]int{"up": 47, "down": 1},
+		},
+	}
+
+	first, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded TestResult
+	if err := json.Unmarshal(first, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	second, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(first) != string(second) {
+		t.Fatalf("round trip changed the encoding:\n first: %s\nsecond: %s", first, second)
+	}
+
+	// Struct fields are sorted like map keys, and large counters keep
+	// their exact value.
+	want := `"rows":[{"in_octets":18446744073709551000,"name":"Ethernet1","status":"errors"}]`
+	if !json.Valid(first) || !strings.Contains(string(first), want) {
+		t.Errorf("encoding %s should contain %s", first, want)
+	}
+	if decoded.Status != TestFailure || decoded.Duration != res.Duration || !decoded.Timestamp.Equal(res.Timestamp) {
+		t.Errorf("decoded result %+v does not match original", decoded)
+	}
+}
+
+func TestDetailsFrom(t *testing.T) {
+	cases := []struct {
+		name string
+		in   any
+		want string
+	}{
+		{"nil", nil, `null`},
+		{"string map", map[string]string{"current_version": "4.32.1F"}, `{"current_version":"4.32.1F"}`},
+		{"struct", struct {
+			Peer  string `json:"peer"`
+			State string `json:"state"`
+		}{"10.0.0.1", "Established"}, `{"peer":"10.0.0.1","state":"Established"}`},
+		{"bare string", "goroutine 1 [running]", `{"value":"goroutine 1 [running]"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := json.Marshal(DetailsFrom(tc.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("DetailsFrom(%v) = %s, want %s", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+// CollectCommands runs t against a recording device that answers every
+// command with empty output and returns the commands t issued, after any
+// static Commands() it declares. Tests that branch on device output only
+// reveal the commands reachable with empty answers, which for most tests
+// is all of them.
+func CollectCommands(ctx context.Context, t Test) (cmds []device.Command, err error) {
+	rec := &recordingDevice{FakeDevice: device.NewFakeDevice()}
+	rec.DeviceName = "dry-run"
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s panicked during dry run: %v", t.Name(), r)
+		}
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		cmds = append(append([]device.Command(nil), t.Commands()...), rec.commands...)
+	}()
+	_, err = t.Execute(ctx, rec)
+	return cmds, err
+}
+
+// recordingDevice records every command and answers each with an empty
+// object, or empty text for format=text. The embedded FakeDevice supplies
+// the rest of the Device interface.
+type recordingDevice struct {
+	*device.FakeDevice
+
+	mu       sync.Mutex
+	commands []device.Command
+}
+
+func (r *recordingDevice) Execute(_ context.Context, cmd device.Command) (*device.CommandResult, error) {
+	r.mu.Lock()
+	r.commands = append(r.commands, cmd)
+	r.mu.Unlock()
+
+	var out interface{} = map[string]interface{}{}
+	if cmd.Format == "text" {
+		out = map[string]interface{}{"output": ""}
+	}
+	return &device.CommandResult{Command: cmd, Output: out}, nil
+}
+
+func (r *recordingDevice) ExecuteBatch(ctx context.Context, cmds []device.Command) ([]*device.CommandResult, error) {
+	results := make([]*device.CommandResult, len(cmds))
+	for i, cmd := range cmds {
+		results[i], _ = r.Execute(ctx, cmd)
+	}
+	return results, nil
+}
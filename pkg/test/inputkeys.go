@@ -24,6 +24,9 @@ type CustomInputKeys interface {
 // drop it silently and the test would either pass vacuously or fail
 // with a misleading "no peers found" error.
 //
+// Registry.GetTestWithInputs runs it after NormalizeInputs, so a
+// camelCase spelling of a declared key is not reported as unknown.
+//
 // This is best-effort: it only checks the top-level inputs map. Typos
 // nested inside list/map entries (e.g. inside `bgp_peers[i]`) are not
 // caught here. Per-element validation belongs in the constructor or
@@ -153,6 +156,25 @@ func GetInt(inputs map[string]any, key string, dst *int) error {
 	return nil
 }
 
+// GetFloat reads inputs[key] into *dst. Same semantics as GetInt.
+func GetFloat(inputs map[string]any, key string, dst *float64) error {
+	raw, ok := inputs[key]
+	if !ok {
+		return nil
+	}
+	switch v := raw.(type) {
+	case float64:
+		*dst = v
+	case int:
+		*dst = float64(v)
+	case int64:
+		*dst = float64(v)
+	default:
+		return fmt.Errorf("%s: expected number, got %T", key, raw)
+	}
+	return nil
+}
+
 // GetString reads inputs[key] into *dst. Same semantics as GetInt.
 func GetString(inputs map[string]any, key string, dst *string) error {
 	raw, ok := inputs[key]
@@ -115,6 +115,19 @@ func TestGetInt(t *testing.T) {
 	}
 }
 
+func TestGetFloat(t *testing.T) {
+	var got float64
+	if err := GetFloat(map[string]any{"n": 0.5}, "n", &got); err != nil || got != 0.5 {
+		t.Errorf("float64 0.5 → got %v err %v", got, err)
+	}
+	if err := GetFloat(map[string]any{"n": 2}, "n", &got); err != nil || got != 2 {
+		t.Errorf("int 2 → got %v err %v", got, err)
+	}
+	if err := GetFloat(map[string]any{"n": "half"}, "n", &got); err == nil {
+		t.Error("string should reject")
+	}
+}
+
 func TestGetString(t *testing.T) {
 	var got string
 	if err := GetString(map[string]any{"s": "x"}, "s", &got); err != nil || got != "x" {
@@ -0,0 +1,122 @@
+package test
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// NormalizeInputs returns inputs with camelCase keys rewritten to the
+// snake_case names the test's yaml tags declare, so `peerAddress:` reads
+// as `peer_address:` and `remoteASN:` as `remote_asn:`. The rewrite
+// follows the test struct down through nested lists and structs; keys of
+// free-form maps such as drop_stats are user data and left alone, as are
+// keys a test accepts through CustomInputKeys.
+//
+// Canonical snake_case keys always win: supplying both spellings of the
+// same key is an error rather than a silent choice. inputs itself is
+// never modified, since one catalog entry is shared by every device.
+func NormalizeInputs(inputs map[string]any, t Test) (map[string]any, error) {
+	if len(inputs) == 0 || t == nil {
+		return inputs, nil
+	}
+	schema := SchemaOf(t)
+	if len(schema.Properties) == 0 {
+		return inputs, nil
+	}
+	keep := map[string]bool{}
+	if c, ok := t.(CustomInputKeys); ok {
+		for _, k := range c.InputKeys() {
+			keep[k] = true
+		}
+	}
+	out, err := normalizeObject("", schema, inputs, keep)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func normalizeValue(path string, s *Schema, v any) (any, error) {
+	if s == nil {
+		return v, nil
+	}
+	switch val := v.(type) {
+	case map[string]any:
+		if len(s.Properties) > 0 {
+			return normalizeObject(path, s, val, nil)
+		}
+		extra, ok := s.AdditionalProperties.(*Schema)
+		if !ok {
+			return val, nil
+		}
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			norm, err := normalizeValue(joinPath(path, k), extra, item)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = norm
+		}
+		return out, nil
+	case []any:
+		if s.Items == nil {
+			return val, nil
+		}
+		out := make([]any, len(val))
+		for i, item := range val {
+			norm, err := normalizeValue(fmt.Sprintf("%s[%d]", path, i), s.Items, item)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = norm
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+func normalizeObject(path string, s *Schema, m map[string]any, keep map[string]bool) (map[string]any, error) {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		name := k
+		if _, known := s.Properties[k]; !known && !keep[k] {
+			if snake := snakeCase(k); snake != k {
+				if _, ok := s.Properties[snake]; ok {
+					if _, dup := m[snake]; dup {
+						return nil, fmt.Errorf("%s and %s are the same input; set only %s", joinPath(path, k), snake, snake)
+					}
+					name = snake
+				}
+			}
+		}
+		norm, err := normalizeValue(joinPath(path, name), s.Properties[name], v)
+		if err != nil {
+			return nil, err
+		}
+		out[name] = norm
+	}
+	return out, nil
+}
+
+// snakeCase converts camelCase or PascalCase to snake_case, keeping
+// acronyms together: "peerAddress" -> "peer_address", "remoteASN" ->
+// "remote_asn", "BGPPeers" -> "bgp_peers", "ipv4Unicast" ->
+// "ipv4_unicast".
+func snakeCase(s string) string {
+	runes := []rune(s)
+	out := make([]rune, 0, len(runes)+4)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+				nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+					out = append(out, '_')
+				}
+			}
+			r = unicode.ToLower(r)
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
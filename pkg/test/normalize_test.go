@@ -0,0 +1,102 @@
+package test
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+// fakeAliased reads "udpPort" itself, as announced through InputKeys.
+type fakeAliased struct {
+	BaseTest
+	UDPPort int `yaml:"udp_port"`
+}
+
+func (f *fakeAliased) Execute(_ context.Context, _ device.Device) (*TestResult, error) {
+	return nil, nil
+}
+func (f *fakeAliased) ValidateInput(_ any) error { return nil }
+func (f *fakeAliased) InputKeys() []string       { return []string{"udpPort"} }
+
+func TestSnakeCase(t *testing.T) {
+	for in, want := range map[string]string{
+		"peerAddress":   "peer_address",
+		"remoteASN":     "remote_asn",
+		"BGPPeers":      "bgp_peers",
+		"ipv4Unicast":   "ipv4_unicast",
+		"keepAliveTime": "keep_alive_time",
+		"peer_address":  "peer_address",
+		"vrf":           "vrf",
+	} {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNormalizeInputs_RewritesCamelCaseKeys(t *testing.T) {
+	inputs := map[string]any{
+		"Peers": []any{map[string]any{
+			"address": "10.0.0.1",
+			"ASN":     65001,
+			"limits":  map[string]any{"maxRoutes": 10},
+		}},
+		"extra": map[string]any{"someKey": true},
+	}
+	got, err := NormalizeInputs(inputs, &fakeSchemaTest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"peers": []any{map[string]any{
+			"address": "10.0.0.1",
+			"asn":     65001,
+			// drop_stats-style maps are keyed by user data.
+			"limits": map[string]any{"maxRoutes": 10},
+		}},
+		"extra": map[string]any{"someKey": true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeInputs = %#v, want %#v", got, want)
+	}
+	if _, ok := inputs["Peers"]; !ok {
+		t.Error("NormalizeInputs must not modify its argument")
+	}
+}
+
+func TestNormalizeInputs_BothSpellings(t *testing.T) {
+	_, err := NormalizeInputs(map[string]any{"peers": []any{}, "Peers": []any{}}, &fakeSchemaTest{})
+	if err == nil || !strings.Contains(err.Error(), "set only peers") {
+		t.Fatalf("err = %v, want duplicate spelling error", err)
+	}
+}
+
+func TestNormalizeInputs_KeepsCustomInputKeys(t *testing.T) {
+	inputs := map[string]any{"udpPort": 4789}
+	got, err := NormalizeInputs(inputs, &fakeAliased{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := got["udpPort"]; !ok {
+		t.Errorf("custom key rewritten: %#v", got)
+	}
+}
+
+func TestRegistry_GetTestWithInputs_NormalizesKeys(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("system", "VerifyRepeat", func(inputs map[string]any) (Test, error) {
+		tst := &fakeWithFields{}
+		_ = GetInt(inputs, "repeat", &tst.Repeat)
+		return tst, nil
+	})
+	tst, err := r.GetTestWithInputs("system", "VerifyRepeat", map[string]any{"Repeat": 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tst.(*fakeWithFields).Repeat; got != 3 {
+		t.Errorf("Repeat = %d, want 3", got)
+	}
+}
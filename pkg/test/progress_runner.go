@@ -129,8 +129,7 @@ func (pr *ProgressRunner) runWithProgress(
 		go func() {
 			defer wg.Done()
 			for job := range jobs {
-				select {
-				case <-ctx.Done():
+				if !acquire(ctx, semaphore) {
 					// Handle cancellation
 					result := TestResult{
 						TestName:   job.test.Name,
@@ -144,14 +143,13 @@ func (pr *ProgressRunner) runWithProgress(
 					if tracker, exists := deviceTrackers[job.device.Name()]; exists {
 						tracker.Increment(1)
 					}
-					return
-				case semaphore <- struct{}{}:
-					// Run test and update progress
-					result := pr.runTestWithProgress(ctx, job.test, job.device, deviceTrackers)
-					results <- result
-					overallTracker.Increment(1)
-					<-semaphore
+					continue
 				}
+				// Run test and update progress
+				result := pr.runTestWithProgress(ctx, job.test, job.device, deviceTrackers)
+				results <- result
+				overallTracker.Increment(1)
+				<-semaphore
 			}
 		}()
 	}
@@ -2,6 +2,8 @@ package test
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 )
 
@@ -19,13 +21,17 @@ var (
 
 func GetRegistry() *Registry {
 	once.Do(func() {
-		globalRegistry = &Registry{
-			tests: make(map[string]map[string]TestFactory),
-		}
+		globalRegistry = NewRegistry()
 	})
 	return globalRegistry
 }
 
+// NewRegistry returns an empty registry, independent of the global one
+// returned by GetRegistry.
+func NewRegistry() *Registry {
+	return &Registry{tests: make(map[string]map[string]TestFactory)}
+}
+
 func (r *Registry) Register(module, name string, factory TestFactory) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -60,6 +66,11 @@ func (r *Registry) GetTestWithInputs(module, name string, inputs map[string]inte
 		return nil, fmt.Errorf("test %s not found in module %s", name, module)
 	}
 
+	if probe, err := factory(nil); err == nil && probe != nil {
+		if inputs, err = NormalizeInputs(inputs, probe); err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", module, name, err)
+		}
+	}
 	t, err := factory(inputs)
 	if err != nil {
 		return nil, err
@@ -73,3 +84,90 @@ func (r *Registry) GetTestWithInputs(module, name string, inputs map[string]inte
 	}
 	return t, nil
 }
+
+// InputSchema returns the JSON schema of a test's inputs, titled with its
+// module/name, or false when the test is unknown or has no schema (see
+// InputSchemaProvider).
+func (r *Registry) InputSchema(module, name string) (*Schema, bool) {
+	t, ok := r.probe(module, name)
+	if !ok {
+		return nil, false
+	}
+	provider, ok := t.(InputSchemaProvider)
+	if !ok {
+		return nil, false
+	}
+	s := provider.InputSchema()
+	if s == nil {
+		return nil, false
+	}
+	s.Schema = SchemaDialect
+	s.Title = module + "/" + name
+	if s.Description == "" {
+		s.Description = t.Description()
+	}
+	return s, true
+}
+
+// TestInfo describes a registered test for listings such as
+// `go-anta list-tests`.
+type TestInfo struct {
+	Module      string   `json:"module"`
+	Name        string   `json:"name"`
+	Categories  []string `json:"categories"`
+	Description string   `json:"description"`
+	Severity    Severity `json:"severity"`
+}
+
+// List returns every registered test, sorted by module then name. Details
+// come from constructing each test with no inputs; a test whose factory
+// rejects that is listed by name only.
+func (r *Registry) List() []TestInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var infos []TestInfo
+	for module, tests := range r.tests {
+		for name, factory := range tests {
+			info := TestInfo{Module: module, Name: name}
+			if t, err := factory(nil); err == nil && t != nil {
+				info.Categories = t.Categories()
+				info.Description, _, _ = strings.Cut(t.Description(), "\n")
+				info.Severity = t.Severity()
+			}
+			infos = append(infos, info)
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		if infos[i].Module != infos[j].Module {
+			return infos[i].Module < infos[j].Module
+		}
+		return infos[i].Name < infos[j].Name
+	})
+	return infos
+}
+
+// defaultSeverity is the severity a test declares when constructed with
+// no inputs, for results produced before the test itself could be built.
+func (r *Registry) defaultSeverity(module, name string) Severity {
+	if t, ok := r.probe(module, name); ok {
+		return t.Severity()
+	}
+	return SeverityMajor
+}
+
+// probe constructs a test with no inputs, for reading what it declares
+// about itself rather than running it.
+func (r *Registry) probe(module, name string) (Test, bool) {
+	r.mu.RLock()
+	factory, ok := r.tests[module][name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	t, err := factory(nil)
+	if err != nil || t == nil {
+		return nil, false
+	}
+	return t, true
+}
@@ -87,3 +87,72 @@ func TestRegistry_GetTestWithInputs_FactoryErrorPreserved(t *testing.T) {
 		t.Errorf("expected factory error to be preserved, got: %v", err)
 	}
 }
+
+func TestRegistry_List_SortedWithDetails(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("system", "VerifyUptime", func(map[string]any) (Test, error) {
+		return &fakeRegTest{BaseTest: BaseTest{
+			TestCategories:  []string{"system"},
+			TestDescription: "Verify device uptime\nwith more detail",
+		}}, nil
+	})
+	_ = r.Register("routing", "VerifyBGPPeers", newFakeRegTest)
+	_ = r.Register("routing", "VerifyBFDPeers", func(map[string]any) (Test, error) {
+		return nil, errors.New("inputs required")
+	})
+
+	got := r.List()
+	if len(got) != 3 {
+		t.Fatalf("List returned %d tests, want 3", len(got))
+	}
+	order := []string{"routing/VerifyBFDPeers", "routing/VerifyBGPPeers", "system/VerifyUptime"}
+	for i, want := range order {
+		if got[i].Module+"/"+got[i].Name != want {
+			t.Errorf("List[%d] = %s/%s, want %s", i, got[i].Module, got[i].Name, want)
+		}
+	}
+	if got[2].Description != "Verify device uptime" || len(got[2].Categories) != 1 {
+		t.Errorf("List[2] = %+v, want first description line and categories", got[2])
+	}
+	if got[0].Description != "" {
+		t.Errorf("failing factory should be listed by name only, got %+v", got[0])
+	}
+}
+
+func TestRegistry_SelfCheck(t *testing.T) {
+	named := func(name string, categories ...string) TestFactory {
+		return func(map[string]any) (Test, error) {
+			return &fakeRegTest{BaseTest: BaseTest{TestName: name, TestCategories: categories}}, nil
+		}
+	}
+
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("system", "VerifyUptime", named("VerifyUptime", "system"))
+	_ = r.Register("routing", "VerifyBGPPeers", named("VerifyBGPPeers", "routing", "bgp"))
+	if err := r.SelfCheck(); err != nil {
+		t.Fatalf("consistent registry rejected: %v", err)
+	}
+
+	// A constructor copied from VerifyUptime without renaming it.
+	_ = r.Register("system", "VerifyReloadCause", named("VerifyUptime", "system"))
+	_ = r.Register("routing", "VerifyBFDPeers", named("VerifyBFDPeers", "bfd", "BFD"))
+	_ = r.Register("routing", "VerifyOSPF", named("VerifyOSPF"))
+	err := r.SelfCheck()
+	if err == nil {
+		t.Fatal("expected self-check to fail")
+	}
+	for _, want := range []string{
+		`system/VerifyReloadCause: reports name "VerifyUptime"`,
+		`test name "VerifyUptime" is used by system/VerifyReloadCause, system/VerifyUptime`,
+		`routing/VerifyBFDPeers: first category is "bfd", expected module "routing"`,
+		`routing/VerifyBFDPeers: category "BFD" is not a lowercase tag`,
+		`routing/VerifyOSPF: no categories`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error should contain %q, got:\n%v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "VerifyBGPPeers") {
+		t.Errorf("consistent test should not be listed:\n%v", err)
+	}
+}
@@ -0,0 +1,22 @@
+package test
+
+import (
+	"context"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+// RunWithFake validates tst's inputs and executes it against dev, failing
+// t if either step returns an error. It is the shared driver for the
+// per-module unit tests built on device.FakeDevice.
+func RunWithFake(t device.TestingT, tst Test, dev *device.FakeDevice) *TestResult {
+	t.Helper()
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+	res, err := tst.Execute(context.Background(), dev)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	return res
+}
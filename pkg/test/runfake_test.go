@@ -0,0 +1,34 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+// recordingT captures Fatalf so RunWithFake's failure path can be
+// observed without failing the enclosing test.
+type recordingT struct{ fatal string }
+
+func (r *recordingT) Helper() {}
+func (r *recordingT) Fatalf(format string, args ...interface{}) {
+	r.fatal = fmt.Sprintf(format, args...)
+}
+
+type invalidTest struct{ fakeRegTest }
+
+func (t *invalidTest) ValidateInput(_ any) error { return fmt.Errorf("hosts must be specified") }
+
+func TestRunWithFake(t *testing.T) {
+	res := RunWithFake(t, &fakeRegTest{}, device.NewFakeDevice())
+	if res.Status != TestSuccess {
+		t.Errorf("status = %v, want success", res.Status)
+	}
+
+	rec := &recordingT{}
+	RunWithFake(rec, &invalidTest{}, device.NewFakeDevice())
+	if rec.fatal != "ValidateInput: hosts must be specified" {
+		t.Errorf("Fatalf = %q, want the ValidateInput error", rec.fatal)
+	}
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime/debug"
 	"sync"
@@ -14,6 +15,8 @@ import (
 type Runner struct {
 	maxConcurrency int
 	registry       *Registry
+	timeout        time.Duration
+	baselines      BaselineProvider
 }
 
 func NewRunner(maxConcurrency int) *Runner {
@@ -27,6 +30,18 @@ func NewRunner(maxConcurrency int) *Runner {
 	}
 }
 
+// SetTimeout bounds how long a single test may run on one device. A
+// catalog entry's own timeout takes precedence; zero means no limit.
+func (r *Runner) SetTimeout(d time.Duration) {
+	r.timeout = d
+}
+
+// SetBaselineProvider makes tests that implement BaselineConsumer
+// compare counters against p's recorded output rather than absolute values.
+func (r *Runner) SetBaselineProvider(p BaselineProvider) {
+	r.baselines = p
+}
+
 func (r *Runner) Run(ctx context.Context, tests []TestDefinition, devices []device.Device) ([]TestResult, error) {
 	totalTests := len(tests) * len(devices)
 	if totalTests == 0 {
@@ -59,8 +74,9 @@ func (r *Runner) Run(ctx context.Context, tests []TestDefinition, devices []devi
 		go func() {
 			defer wg.Done()
 			for job := range jobs {
-				select {
-				case <-ctx.Done():
+				// Keep draining after cancellation so every queued job
+				// is reported, not just the one this worker held.
+				if !acquire(ctx, semaphore) {
 					logger.Warnf("Test %s cancelled for device %s", job.test.Name, job.device.Name())
 					results <- TestResult{
 						TestName:   job.test.Name,
@@ -69,12 +85,11 @@ func (r *Runner) Run(ctx context.Context, tests []TestDefinition, devices []devi
 						Message:    "Test cancelled",
 						Timestamp:  time.Now(),
 					}
-					return
-				case semaphore <- struct{}{}:
-					result := r.runTest(ctx, job.test, job.device)
-					results <- result
-					<-semaphore
+					continue
 				}
+				result := r.runTest(ctx, job.test, job.device)
+				results <- result
+				<-semaphore
 			}
 		}()
 	}
@@ -92,28 +107,46 @@ func (r *Runner) Run(ctx context.Context, tests []TestDefinition, devices []devi
 	return allResults, nil
 }
 
+// acquire takes a concurrency slot, or reports false once ctx is done.
+// ctx is checked first because a select with both cases ready picks one
+// at random, which would let a cancelled run start more tests.
+func acquire(ctx context.Context, semaphore chan struct{}) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case semaphore <- struct{}{}:
+		return true
+	}
+}
+
 func (r *Runner) runTest(ctx context.Context, testDef TestDefinition, dev device.Device) (result TestResult) {
 	start := time.Now()
 	logger.Debugf("Running test %s on device %s", testDef.Name, dev.Name())
 
+	// Every result carries the severity the exit-code policy judges it
+	// by: the catalog's override, else the test's own default. Deferred
+	// first so it also covers the panic result below.
+	var testImpl Test
+	defer func() {
+		result.Severity = testDef.Severity
+		if result.Severity == "" && testImpl != nil {
+			result.Severity = testImpl.Severity()
+		}
+		if result.Severity == "" {
+			result.Severity = r.registry.defaultSeverity(testDef.Module, testDef.Name)
+		}
+	}()
+
 	// Catch panics from test implementations (e.g. unchecked type assertions
 	// on unexpected device output). Without this, a single panicking test
 	// would crash its worker, leak the semaphore slot, and silently truncate
 	// the run.
 	defer func() {
 		if p := recover(); p != nil {
-			stack := debug.Stack()
-			logger.Errorf("Test %s panicked on device %s: %v\n%s", testDef.Name, dev.Name(), p, stack)
-			result = TestResult{
-				TestName:   testDef.Name,
-				DeviceName: dev.Name(),
-				Status:     TestError,
-				Message:    fmt.Sprintf("panic: %v", p),
-				Duration:   time.Since(start),
-				Timestamp:  time.Now(),
-				Categories: testDef.Categories,
-				Details:    string(stack),
-			}
+			result = panicResult(testDef, dev, start, p, debug.Stack())
 		}
 	}()
 
@@ -130,7 +163,8 @@ func (r *Runner) runTest(ctx context.Context, testDef TestDefinition, dev device
 		}
 	}
 
-	testImpl, err := r.registry.GetTestWithInputs(testDef.Module, testDef.Name, testDef.Inputs)
+	var err error
+	testImpl, err = r.registry.GetTestWithInputs(testDef.Module, testDef.Name, testDef.Inputs)
 	if err != nil {
 		logger.Errorf("Failed to construct test %s: %v", testDef.Name, err)
 		return TestResult{
@@ -144,6 +178,8 @@ func (r *Runner) runTest(ctx context.Context, testDef TestDefinition, dev device
 		}
 	}
 
+	applyBaseline(r.baselines, testImpl, dev.Name())
+
 	if err := testImpl.ValidateInput(testDef.Inputs); err != nil {
 		return TestResult{
 			TestName:   testDef.Name,
@@ -157,7 +193,10 @@ func (r *Runner) runTest(ctx context.Context, testDef TestDefinition, dev device
 	}
 
 	logger.Debugf("Executing test %s on device %s", testDef.Name, dev.Name())
-	execResult, err := testImpl.Execute(ctx, dev)
+	execResult, err := r.execute(ctx, testImpl, testDef, dev, start)
+	if execResult != nil && errors.Is(err, errAborted) {
+		return *execResult
+	}
 	if err != nil {
 		logger.Errorf("Test %s failed on device %s: %v", testDef.Name, dev.Name(), err)
 		return TestResult{
@@ -186,3 +225,90 @@ func (r *Runner) runTest(ctx context.Context, testDef TestDefinition, dev device
 
 	return *execResult
 }
+
+// errAborted marks a result execute built itself, for a test that timed
+// out or panicked in its goroutine, so runTest returns it as is.
+var errAborted = errors.New("test aborted")
+
+// execute runs testImpl under the test's timeout. Tests are expected to
+// honour ctx, but one that blocks on a stalled device regardless would
+// hold its worker forever, so Execute runs in its own goroutine and is
+// abandoned once the deadline passes. The goroutine reports into a
+// buffered channel, so its eventual result is dropped rather than leaked
+// into a later test.
+func (r *Runner) execute(ctx context.Context, testImpl Test, testDef TestDefinition, dev device.Device, start time.Time) (*TestResult, error) {
+	timeout := r.timeout
+	if testDef.Timeout > 0 {
+		timeout = testDef.Timeout
+	}
+	if timeout <= 0 {
+		return testImpl.Execute(ctx, dev)
+	}
+
+	execCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type outcome struct {
+		result *TestResult
+		err    error
+		panic  *TestResult
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		// A panic here is outside runTest's recover, so convert it to
+		// the same result before it can take the process down.
+		defer func() {
+			if p := recover(); p != nil {
+				res := panicResult(testDef, dev, start, p, debug.Stack())
+				done <- outcome{panic: &res}
+			}
+		}()
+		res, err := testImpl.Execute(execCtx, dev)
+		done <- outcome{result: res, err: err}
+	}()
+
+	select {
+	case out := <-done:
+		if out.panic != nil {
+			return out.panic, errAborted
+		}
+		// A test that honours ctx returns its own error once the
+		// deadline passes; report that as the timeout it really is.
+		if ctx.Err() == nil && errors.Is(execCtx.Err(), context.DeadlineExceeded) {
+			return timeoutResult(testDef, dev, start, timeout), errAborted
+		}
+		return out.result, out.err
+	case <-execCtx.Done():
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return timeoutResult(testDef, dev, start, timeout), errAborted
+	}
+}
+
+func timeoutResult(testDef TestDefinition, dev device.Device, start time.Time, timeout time.Duration) *TestResult {
+	logger.Errorf("Test %s timed out on device %s after %s", testDef.Name, dev.Name(), timeout)
+	return &TestResult{
+		TestName:   testDef.Name,
+		DeviceName: dev.Name(),
+		Status:     TestError,
+		Message:    fmt.Sprintf("test timed out after %s", timeout),
+		Duration:   time.Since(start),
+		Timestamp:  time.Now(),
+		Categories: testDef.Categories,
+	}
+}
+
+func panicResult(testDef TestDefinition, dev device.Device, start time.Time, p interface{}, stack []byte) TestResult {
+	logger.Errorf("Test %s panicked on device %s: %v\n%s", testDef.Name, dev.Name(), p, stack)
+	return TestResult{
+		TestName:   testDef.Name,
+		DeviceName: dev.Name(),
+		Status:     TestError,
+		Message:    fmt.Sprintf("panic: %v", p),
+		Duration:   time.Since(start),
+		Timestamp:  time.Now(),
+		Categories: testDef.Categories,
+		Details:    Details{"stack": string(stack)},
+	}
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+// slowTest sleeps for delay before succeeding. With ignoreCtx set it
+// keeps sleeping after ctx is done, like a test stuck on a stalled
+// device that never checks its context.
+type slowTest struct {
+	BaseTest
+	delay     time.Duration
+	ignoreCtx bool
+}
+
+func (t *slowTest) Execute(ctx context.Context, _ device.Device) (*TestResult, error) {
+	if t.ignoreCtx {
+		time.Sleep(t.delay)
+	} else {
+		select {
+		case <-time.After(t.delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return &TestResult{TestName: t.Name(), Status: TestSuccess, Message: "done"}, nil
+}
+
+func (t *slowTest) ValidateInput(_ any) error { return nil }
+
+func slowRunner(delay time.Duration, ignoreCtx bool, timeout time.Duration) *Runner {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("system", "VerifySlow", func(map[string]any) (Test, error) {
+		return &slowTest{BaseTest: BaseTest{TestName: "VerifySlow"}, delay: delay, ignoreCtx: ignoreCtx}, nil
+	})
+	runner := &Runner{maxConcurrency: 2, registry: r}
+	runner.SetTimeout(timeout)
+	return runner
+}
+
+func TestRunner_Timeout(t *testing.T) {
+	cases := []struct {
+		name      string
+		delay     time.Duration
+		ignoreCtx bool
+		timeout   time.Duration
+		override  time.Duration
+		status    TestStatus
+		want      string
+	}{
+		{"within timeout", 0, false, time.Second, 0, TestSuccess, "done"},
+		{"no timeout", 20 * time.Millisecond, true, 0, 0, TestSuccess, "done"},
+		{"ignores ctx", time.Second, true, 20 * time.Millisecond, 0, TestError, "test timed out after 20ms"},
+		{"honours ctx", time.Second, false, 20 * time.Millisecond, 0, TestError, "test timed out after 20ms"},
+		{"override shortens", time.Second, true, time.Minute, 20 * time.Millisecond, TestError, "test timed out after 20ms"},
+		{"override lengthens", 50 * time.Millisecond, true, 10 * time.Millisecond, time.Second, TestSuccess, "done"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := slowRunner(tc.delay, tc.ignoreCtx, tc.timeout)
+			defs := []TestDefinition{{Name: "VerifySlow", Module: "system", Timeout: tc.override}}
+			devs := []device.Device{device.NewFakeDevice(), device.NewFakeDevice()}
+
+			start := time.Now()
+			results, err := runner.Run(context.Background(), defs, devs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if elapsed := time.Since(start); tc.status == TestError && elapsed > 500*time.Millisecond {
+				t.Errorf("run took %s; the timed-out test should have been abandoned", elapsed)
+			}
+			if len(results) != len(devs) {
+				t.Fatalf("got %d results, want %d", len(results), len(devs))
+			}
+			for _, res := range results {
+				if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+					t.Errorf("result = %v %q, want %v %q", res.Status, res.Message, tc.status, tc.want)
+				}
+				if res.Severity != SeverityMajor {
+					t.Errorf("severity = %q, want the default to still be applied", res.Severity)
+				}
+			}
+		})
+	}
+}
+
+func TestRunner_TimeoutRecoversPanic(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("system", "VerifyPanic", func(map[string]any) (Test, error) {
+		return &panicTest{BaseTest: BaseTest{TestName: "VerifyPanic"}}, nil
+	})
+	runner := &Runner{maxConcurrency: 1, registry: r}
+	runner.SetTimeout(time.Second)
+
+	results, err := runner.Run(context.Background(), []TestDefinition{{Name: "VerifyPanic", Module: "system"}},
+		[]device.Device{device.NewFakeDevice()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Status != TestError || results[0].Message != "panic: boom" {
+		t.Fatalf("results = %+v, want one panic error", results)
+	}
+	if _, ok := results[0].Details["stack"]; !ok {
+		t.Error("panic result should carry the stack")
+	}
+}
+
+type panicTest struct{ BaseTest }
+
+func (t *panicTest) Execute(context.Context, device.Device) (*TestResult, error) { panic("boom") }
+func (t *panicTest) ValidateInput(_ any) error                                   { return nil }
@@ -0,0 +1,262 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// InputSchemaProvider is implemented by tests that describe their inputs
+// with a JSON schema. It is optional: tests without one are validated by
+// ValidateInputKeys and their own ValidateInput only.
+type InputSchemaProvider interface {
+	InputSchema() *Schema
+}
+
+// Schema is the subset of JSON Schema used to describe test inputs. It
+// marshals to a draft 2020-12 document and can validate decoded YAML.
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	// AdditionalProperties is false to forbid unknown keys, or a *Schema
+	// every other value must match. Nil allows anything.
+	AdditionalProperties any       `json:"additionalProperties,omitempty"`
+	Items                *Schema   `json:"items,omitempty"`
+	MinItems             *int      `json:"minItems,omitempty"`
+	Enum                 []string  `json:"enum,omitempty"`
+	Minimum              *float64  `json:"minimum,omitempty"`
+	Maximum              *float64  `json:"maximum,omitempty"`
+	AnyOf                []*Schema `json:"anyOf,omitempty"`
+}
+
+// SchemaDialect is the $schema URI set on exported schemas.
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// SchemaOf derives an object schema from a test or input struct's
+// yaml-tagged fields, the same fields ValidateInputKeys accepts. Nested
+// structs forbid unknown keys, which catches the typos inside list
+// entries that ValidateInputKeys cannot see. Nothing is marked required;
+// callers add that, since constructors often default missing keys.
+func SchemaOf(v any) *Schema {
+	return schemaForType(reflect.TypeOf(v))
+}
+
+func schemaForType(rt reflect.Type) *Schema {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	switch rt.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: schemaForType(rt.Elem())}
+	case reflect.Map:
+		s := &Schema{Type: "object"}
+		if rt.Elem().Kind() != reflect.Interface {
+			s.AdditionalProperties = schemaForType(rt.Elem())
+		}
+		return s
+	case reflect.Struct:
+		s := &Schema{Type: "object", Properties: map[string]*Schema{}, AdditionalProperties: false}
+		addStructFields(s, rt)
+		return s
+	default:
+		return &Schema{}
+	}
+}
+
+func addStructFields(s *Schema, rt reflect.Type) {
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if f.Anonymous {
+			// BaseTest metadata is not an input; see collectFromValue.
+			if f.Type.Name() == "BaseTest" {
+				continue
+			}
+			ft := f.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addStructFields(s, ft)
+			}
+			continue
+		}
+		tag := f.Tag.Get("yaml")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name := strings.SplitN(tag, ",", 2)[0]
+		if name == "" {
+			continue
+		}
+		s.Properties[name] = schemaForType(f.Type)
+	}
+}
+
+// Validate checks a decoded YAML/JSON value against s, returning the
+// first violation with its path, e.g. "bgp_peers[1].vrf: expected
+// string, got int".
+func (s *Schema) Validate(v any) error {
+	return s.validate("", v)
+}
+
+func (s *Schema) validate(path string, v any) error {
+	if s == nil {
+		return nil
+	}
+	switch s.Type {
+	case "":
+		// Untyped schemas, such as anyOf alternatives, still constrain
+		// objects they are applied to.
+		if m, ok := v.(map[string]any); ok {
+			if err := s.validateObject(path, m); err != nil {
+				return err
+			}
+		}
+	case "object":
+		m, ok := v.(map[string]any)
+		if !ok {
+			if v == nil {
+				return nil
+			}
+			return schemaError(path, "expected object, got %T", v)
+		}
+		if err := s.validateObject(path, m); err != nil {
+			return err
+		}
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			return schemaError(path, "expected array, got %T", v)
+		}
+		if s.MinItems != nil && len(items) < *s.MinItems {
+			return schemaError(path, "expected at least %d item(s), got %d", *s.MinItems, len(items))
+		}
+		for i, item := range items {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return schemaError(path, "expected string, got %T", v)
+		}
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, str) {
+			return schemaError(path, "%q is not one of %s", str, strings.Join(s.Enum, ", "))
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return schemaError(path, "expected boolean, got %T", v)
+		}
+	case "integer", "number":
+		n, ok := schemaNumber(v)
+		if !ok || (s.Type == "integer" && n != math.Trunc(n)) {
+			return schemaError(path, "expected %s, got %T", s.Type, v)
+		}
+		if s.Minimum != nil && n < *s.Minimum {
+			return schemaError(path, "%v is below the minimum %v", n, *s.Minimum)
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			return schemaError(path, "%v is above the maximum %v", n, *s.Maximum)
+		}
+	}
+	// anyOf runs after the type's own checks so an entry with a typo is
+	// reported as the unknown key rather than as every unmet alternative.
+	if len(s.AnyOf) > 0 {
+		var errs []string
+		matched := false
+		for _, alt := range s.AnyOf {
+			err := alt.validate(path, v)
+			if err == nil {
+				matched = true
+				break
+			}
+			errs = append(errs, err.Error())
+		}
+		if !matched {
+			return fmt.Errorf("%s", strings.Join(errs, " or "))
+		}
+	}
+	return nil
+}
+
+func (s *Schema) validateObject(path string, m map[string]any) error {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if prop, ok := s.Properties[key]; ok {
+			if err := prop.validate(joinPath(path, key), m[key]); err != nil {
+				return err
+			}
+			continue
+		}
+		switch extra := s.AdditionalProperties.(type) {
+		case bool:
+			if !extra {
+				return schemaError(joinPath(path, key), "unknown key")
+			}
+		case *Schema:
+			if err := extra.validate(joinPath(path, key), m[key]); err != nil {
+				return err
+			}
+		}
+	}
+	// Missing keys last: a misspelt required key is better reported as
+	// the typo than as the omission.
+	for _, key := range s.Required {
+		if _, ok := m[key]; !ok {
+			return schemaError(joinPath(path, key), "required")
+		}
+	}
+	return nil
+}
+
+func schemaNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+func schemaError(path, format string, args ...any) error {
+	if path == "" {
+		path = "inputs"
+	}
+	return fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...))
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+)
+
+type fakeSchemaPeer struct {
+	Address string         `yaml:"address"`
+	ASN     int            `yaml:"asn,omitempty"`
+	Enabled bool           `yaml:"enabled,omitempty"`
+	Limits  map[string]int `yaml:"limits,omitempty"`
+}
+
+type fakeSchemaTest struct {
+	BaseTest
+	Peers []fakeSchemaPeer `yaml:"peers"`
+	Extra map[string]any   `yaml:"extra,omitempty"`
+}
+
+func (f *fakeSchemaTest) Execute(_ context.Context, _ device.Device) (*TestResult, error) {
+	return nil, nil
+}
+func (f *fakeSchemaTest) ValidateInput(_ any) error { return nil }
+
+func (f *fakeSchemaTest) InputSchema() *Schema {
+	s := SchemaOf(f)
+	s.Properties["peers"].Items.Required = []string{"address"}
+	return s
+}
+
+func TestSchemaOf_FollowsYAMLTags(t *testing.T) {
+	s := SchemaOf(&fakeSchemaTest{})
+	if _, ok := s.Properties["severity"]; ok {
+		t.Error("BaseTest fields must not become inputs")
+	}
+	peer := s.Properties["peers"].Items
+	if peer.Type != "object" || peer.AdditionalProperties != false {
+		t.Fatalf("peer item schema = %+v", peer)
+	}
+	for key, want := range map[string]string{"address": "string", "asn": "integer", "enabled": "boolean", "limits": "object"} {
+		if got := peer.Properties[key].Type; got != want {
+			t.Errorf("%s type = %q, want %q", key, got, want)
+		}
+	}
+	if extra := s.Properties["extra"]; extra.AdditionalProperties != nil {
+		t.Errorf("map[string]any should be unconstrained, got %+v", extra.AdditionalProperties)
+	}
+}
+
+func TestSchema_Validate(t *testing.T) {
+	s := (&fakeSchemaTest{}).InputSchema()
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		want   string
+	}{
+		{"valid", map[string]any{
+			"peers": []any{map[string]any{"address": "10.0.0.1", "asn": 65001, "limits": map[string]any{"routes": float64(10)}}},
+			"extra": map[string]any{"anything": []any{1, "two"}},
+		}, ""},
+		{"nested typo", map[string]any{"peers": []any{map[string]any{"address": "10.0.0.1", "adress": "x"}}}, "peers[0].adress: unknown key"},
+		{"missing required", map[string]any{"peers": []any{map[string]any{"asn": 1}}}, "peers[0].address: required"},
+		{"wrong type", map[string]any{"peers": []any{map[string]any{"address": "a", "asn": "65001"}}}, "peers[0].asn: expected integer, got string"},
+		{"fractional integer", map[string]any{"peers": []any{map[string]any{"address": "a", "asn": 1.5}}}, "peers[0].asn: expected integer"},
+		{"map value", map[string]any{"peers": []any{map[string]any{"address": "a", "limits": map[string]any{"routes": true}}}}, "peers[0].limits.routes: expected integer"},
+		{"not a list", map[string]any{"peers": "10.0.0.1"}, "peers: expected array, got string"},
+		{"top-level typo", map[string]any{"peer": []any{}}, "peer: unknown key"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := s.Validate(tc.inputs)
+			if tc.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("err = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_InputSchema(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("routing", "VerifyPeers", func(map[string]any) (Test, error) {
+		return &fakeSchemaTest{BaseTest: BaseTest{TestDescription: "Verify peers"}}, nil
+	})
+	_ = r.Register("system", "VerifyPlain", newFakeRegTest)
+
+	s, ok := r.InputSchema("routing", "VerifyPeers")
+	if !ok || s.Title != "routing/VerifyPeers" || s.Description != "Verify peers" || s.Schema != SchemaDialect {
+		t.Fatalf("InputSchema = %+v, %v", s, ok)
+	}
+	if _, ok := r.InputSchema("system", "VerifyPlain"); ok {
+		t.Error("test without InputSchema should report no schema")
+	}
+	if _, ok := r.InputSchema("system", "VerifyMissing"); ok {
+		t.Error("unknown test should report no schema")
+	}
+}
+
+func TestCatalog_ValidateInputs_AppliesSchema(t *testing.T) {
+	r := &Registry{tests: map[string]map[string]TestFactory{}}
+	_ = r.Register("routing", "VerifyPeers", func(map[string]any) (Test, error) { return &fakeSchemaTest{}, nil })
+	c := &Catalog{Tests: []TestDefinition{
+		{Name: "VerifyPeers", Module: "routing", Inputs: map[string]any{"peers": []any{map[string]any{"address": "10.0.0.1"}}}},
+		{Name: "VerifyPeers", Module: "routing", Inputs: map[string]any{"peers": []any{map[string]any{"addres": "10.0.0.1"}}}},
+	}}
+
+	errs := c.ValidateInputs(r)
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "tests[1] routing/VerifyPeers: peers[0].addres: unknown key") {
+		t.Fatalf("errs = %v", errs)
+	}
+}
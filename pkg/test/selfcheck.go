@@ -0,0 +1,86 @@
+package test
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SelfCheck reports registrations that would confuse catalogs and
+// reporters. Every registered test must:
+//   - construct with no inputs, so its name and categories can be read;
+//   - report the name it is registered under, which also makes TestName
+//     unique across the registry (results are keyed by TestName alone);
+//   - declare at least one category, the first being its module, which is
+//     the root of the category taxonomy;
+//   - use lowercase category tags made of letters, digits, '-' and '_',
+//     without repeats.
+//
+// The returned error lists every offender, one per line.
+func (r *Registry) SelfCheck() error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var problems []string
+	owners := map[string][]string{}
+	for module, tests := range r.tests {
+		for name, factory := range tests {
+			id := module + "/" + name
+			t, err := factory(nil)
+			if err != nil || t == nil {
+				problems = append(problems, fmt.Sprintf("%s: cannot be constructed without inputs: %v", id, err))
+				continue
+			}
+			if t.Name() != name {
+				problems = append(problems, fmt.Sprintf("%s: reports name %q", id, t.Name()))
+			}
+			owners[t.Name()] = append(owners[t.Name()], id)
+			problems = append(problems, checkCategories(id, module, t.Categories())...)
+		}
+	}
+	for name, ids := range owners {
+		if len(ids) > 1 {
+			sort.Strings(ids)
+			problems = append(problems, fmt.Sprintf("test name %q is used by %s", name, strings.Join(ids, ", ")))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("registry self-check found %d problem(s):\n  - %s", len(problems), strings.Join(problems, "\n  - "))
+}
+
+func checkCategories(id, module string, categories []string) []string {
+	if len(categories) == 0 {
+		return []string{fmt.Sprintf("%s: no categories", id)}
+	}
+	var problems []string
+	if categories[0] != module {
+		problems = append(problems, fmt.Sprintf("%s: first category is %q, expected module %q", id, categories[0], module))
+	}
+	seen := map[string]bool{}
+	for _, c := range categories {
+		switch {
+		case !validCategory(c):
+			problems = append(problems, fmt.Sprintf("%s: category %q is not a lowercase tag", id, c))
+		case seen[c]:
+			problems = append(problems, fmt.Sprintf("%s: category %q is repeated", id, c))
+		}
+		seen[c] = true
+	}
+	return problems
+}
+
+func validCategory(c string) bool {
+	if c == "" {
+		return false
+	}
+	for _, r := range c {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return false
+		}
+	}
+	return true
+}
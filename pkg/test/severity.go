@@ -0,0 +1,64 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity ranks how much a test's failure matters, so a run can gate on
+// critical checks while still reporting minor ones. Tests that don't set
+// one are SeverityMajor.
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityMajor    Severity = "major"
+	SeverityMinor    Severity = "minor"
+)
+
+// Severities lists the accepted severities, most severe first.
+var Severities = []Severity{SeverityCritical, SeverityMajor, SeverityMinor}
+
+// ParseSeverity accepts a severity name, case-insensitively.
+func ParseSeverity(s string) (Severity, error) {
+	for _, sev := range Severities {
+		if strings.EqualFold(s, string(sev)) {
+			return sev, nil
+		}
+	}
+	names := make([]string, len(Severities))
+	for i, sev := range Severities {
+		names[i] = string(sev)
+	}
+	return "", fmt.Errorf("unknown severity %q (supported: %s)", s, strings.Join(names, ", "))
+}
+
+// AtLeast reports whether s is as severe as, or more severe than, min.
+// An unset severity counts as major.
+func (s Severity) AtLeast(min Severity) bool {
+	return s.rank() <= min.rank()
+}
+
+func (s Severity) rank() int {
+	switch s {
+	case SeverityCritical:
+		return 0
+	case SeverityMinor:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// FailsAt is the exit-code policy: it reports whether any result failed
+// or errored with a severity of at least threshold. FailsAt(results,
+// SeverityMinor) fails on any failure; FailsAt(results, SeverityCritical)
+// only on critical ones.
+func FailsAt(results []TestResult, threshold Severity) bool {
+	for _, res := range results {
+		if (res.Status == TestFailure || res.Status == TestError) && res.Severity.AtLeast(threshold) {
+			return true
+		}
+	}
+	return false
+}
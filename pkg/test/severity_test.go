@@ -0,0 +1,56 @@
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFailsAt_MixedSeverities(t *testing.T) {
+	results := []TestResult{
+		{TestName: "VerifyBGPPeers", Status: TestSuccess, Severity: SeverityCritical},
+		{TestName: "VerifyNTP", Status: TestFailure, Severity: SeverityMajor},
+		{TestName: "VerifyHostname", Status: TestError, Severity: SeverityMinor},
+		{TestName: "VerifyUptime", Status: TestSkipped, Severity: SeverityCritical},
+	}
+	cases := []struct {
+		threshold Severity
+		want      bool
+	}{
+		{SeverityMinor, true},
+		{SeverityMajor, true},
+		{SeverityCritical, false},
+	}
+	for _, tc := range cases {
+		if got := FailsAt(results, tc.threshold); got != tc.want {
+			t.Errorf("FailsAt(%s) = %v, want %v", tc.threshold, got, tc.want)
+		}
+	}
+
+	// An unset severity is judged as major.
+	unset := []TestResult{{TestName: "VerifyX", Status: TestFailure}}
+	if !FailsAt(unset, SeverityMajor) || FailsAt(unset, SeverityCritical) {
+		t.Errorf("unset severity should count as major")
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	if sev, err := ParseSeverity("Critical"); err != nil || sev != SeverityCritical {
+		t.Errorf("ParseSeverity(Critical) = %q, %v", sev, err)
+	}
+	if _, err := ParseSeverity("blocker"); err == nil {
+		t.Errorf("ParseSeverity(blocker) should fail")
+	}
+}
+
+func TestParseCatalog_NormalizesSeverity(t *testing.T) {
+	c, err := ParseCatalog(strings.NewReader("tests:\n  - name: VerifyX\n    module: m\n    severity: MINOR\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Tests[0].Severity != SeverityMinor {
+		t.Errorf("severity = %q, want minor", c.Tests[0].Severity)
+	}
+	if _, err := ParseCatalog(strings.NewReader("tests:\n  - name: VerifyX\n    module: m\n    severity: urgent\n")); err == nil {
+		t.Errorf("unknown catalog severity should be rejected")
+	}
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
@@ -14,6 +15,7 @@ type Test interface {
 	Commands() []device.Command
 	Execute(ctx context.Context, dev device.Device) (*TestResult, error)
 	ValidateInput(input interface{}) error
+	Severity() Severity
 }
 
 type TestStatus int
@@ -41,6 +43,22 @@ func (s TestStatus) String() string {
 	}
 }
 
+// MarshalText encodes a status by name so JSON reports read "failure"
+// rather than 2.
+func (s TestStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *TestStatus) UnmarshalText(text []byte) error {
+	for _, status := range []TestStatus{TestUnset, TestSuccess, TestFailure, TestError, TestSkipped} {
+		if status.String() == string(text) {
+			*s = status
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown test status %q", text)
+}
+
 type TestResult struct {
 	TestName    string        `json:"test_name"`
 	DeviceName  string        `json:"device_name"`
@@ -49,8 +67,9 @@ type TestResult struct {
 	Duration    time.Duration `json:"duration"`
 	Timestamp   time.Time     `json:"timestamp"`
 	Categories  []string      `json:"categories"`
+	Severity    Severity      `json:"severity,omitempty"`
 	CustomField string        `json:"custom_field,omitempty"`
-	Details     interface{}   `json:"details,omitempty"`
+	Details     Details       `json:"details,omitempty"`
 }
 
 type BaseTest struct {
@@ -58,6 +77,7 @@ type BaseTest struct {
 	TestDescription string           `yaml:"description" json:"description"`
 	TestCategories  []string         `yaml:"categories" json:"categories"`
 	TestCommands    []device.Command `yaml:"commands" json:"commands"`
+	TestSeverity    Severity         `yaml:"severity" json:"severity"`
 }
 
 func (t *BaseTest) Name() string {
@@ -76,10 +96,21 @@ func (t *BaseTest) Commands() []device.Command {
 	return t.TestCommands
 }
 
+// Severity returns the test's default severity, SeverityMajor unless the
+// constructor chose otherwise. A catalog entry can override it.
+func (t *BaseTest) Severity() Severity {
+	if t.TestSeverity == "" {
+		return SeverityMajor
+	}
+	return t.TestSeverity
+}
+
 type TestDefinition struct {
 	Name       string                 `yaml:"name" json:"name"`
 	Module     string                 `yaml:"module" json:"module"`
 	Inputs     map[string]interface{} `yaml:"inputs,omitempty" json:"inputs,omitempty"`
 	Categories []string               `yaml:"categories,omitempty" json:"categories,omitempty"`
 	Tags       []string               `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Severity   Severity               `yaml:"severity,omitempty" json:"severity,omitempty"`
+	Timeout    time.Duration          `yaml:"timeout,omitempty" json:"timeout,omitempty"`
 }
@@ -0,0 +1,202 @@
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyCDPNeighbors verifies the CDP (Cisco Discovery Protocol) neighbors seen on the specified ports.
+//
+// EOS does not send CDP but can receive it, which is how links to Cisco
+// gear that does not run LLDP are checked. Device and port names are
+// compared the same way VerifyLLDPNeighbors compares them: case-insensitively,
+// with an FQDN accepted for the bare hostname. The serial number Cisco
+// appends to some device IDs, as in "core1(FOC1234X0AB)", is ignored.
+//
+// Expected Results:
+//   - Success: The test will pass if every port has the expected CDP neighbor.
+//   - Failure: The test will fail if a port has no CDP neighbor or the neighbor device/port does not match.
+//   - Error: The test will report an error if CDP neighbor information cannot be retrieved.
+//
+// Examples:
+//
+//   - name: VerifyCDPNeighbors uplinks to a Cisco core
+//     VerifyCDPNeighbors:
+//     neighbors:
+//
+//   - port: "Ethernet49/1"
+//     neighbor_device: "core1"
+//     neighbor_port: "TenGigabitEthernet1/0/1"
+//
+//   - port: "Ethernet50/1"
+//     neighbor_device: "core2.example.com"
+type VerifyCDPNeighbors struct {
+	test.BaseTest
+	Neighbors []CDPNeighbor `yaml:"neighbors" json:"neighbors"`
+}
+
+type CDPNeighbor struct {
+	Port           string `yaml:"port" json:"port"`
+	NeighborDevice string `yaml:"neighbor_device" json:"neighbor_device"`
+	NeighborPort   string `yaml:"neighbor_port,omitempty" json:"neighbor_port,omitempty"`
+}
+
+func NewVerifyCDPNeighbors(inputs map[string]any) (test.Test, error) {
+	t := &VerifyCDPNeighbors{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyCDPNeighbors",
+			TestDescription: "Verify CDP neighbors on specified ports",
+			TestCategories:  []string{"connectivity", "cdp"},
+		},
+	}
+
+	if inputs != nil {
+		if neighbors, ok := inputs["neighbors"].([]any); ok {
+			for i, entry := range neighbors {
+				nMap, ok := entry.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("neighbors[%d]: expected a mapping, got %T", i, entry)
+				}
+				n := CDPNeighbor{}
+				if port, ok := nMap["port"].(string); ok {
+					n.Port = port
+				}
+				if dev, ok := nMap["neighbor_device"].(string); ok {
+					n.NeighborDevice = dev
+				}
+				if port, ok := nMap["neighbor_port"].(string); ok {
+					n.NeighborPort = port
+				}
+				t.Neighbors = append(t.Neighbors, n)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyCDPNeighbors) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show cdp neighbors detail",
+		Format:   "json",
+		UseCache: true,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get CDP neighbors: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected CDP neighbor output: %v", err)
+		return result, nil
+	}
+
+	// Same layout as LLDP: neighbors keyed by local port, each with a
+	// list of the devices heard on it.
+	cdpNeighbors := map[string]map[string]any{}
+	if ports, ok := data["cdpNeighbors"].(map[string]any); ok {
+		for port, portData := range ports {
+			if info, ok := portData.(map[string]any); ok {
+				if list, ok := info["cdpNeighborInfo"].([]any); ok && len(list) > 0 {
+					if neighbor, ok := list[0].(map[string]any); ok {
+						cdpNeighbors[strings.ToLower(port)] = neighbor
+					}
+				}
+			}
+		}
+	}
+
+	rows := make([]map[string]any, 0, len(t.Neighbors))
+	issues := []string{}
+
+	for _, expected := range t.Neighbors {
+		row := map[string]any{
+			"port":            expected.Port,
+			"expected_device": expected.NeighborDevice,
+			"expected_port":   expected.NeighborPort,
+		}
+
+		neighbor, found := cdpNeighbors[strings.ToLower(expected.Port)]
+		if !found {
+			row["status"] = "missing"
+			rows = append(rows, row)
+			issues = append(issues, fmt.Sprintf("%s: no CDP neighbor", expected.Port))
+			continue
+		}
+
+		deviceID, _ := neighbor["deviceId"].(string)
+		portID, _ := neighbor["portId"].(string)
+		portID = strings.Trim(portID, "\"")
+		row["actual_device"] = deviceID
+		row["actual_port"] = portID
+
+		var mismatches []string
+		if !lldpHostMatches(cdpDeviceName(deviceID), expected.NeighborDevice) {
+			mismatches = append(mismatches, fmt.Sprintf("device %s (expected %s)", deviceID, expected.NeighborDevice))
+		}
+		if expected.NeighborPort != "" && !strings.EqualFold(portID, expected.NeighborPort) {
+			mismatches = append(mismatches, fmt.Sprintf("port %s (expected %s)", portID, expected.NeighborPort))
+		}
+		if len(mismatches) > 0 {
+			row["status"] = "mismatch"
+			issues = append(issues, fmt.Sprintf("%s: %s", expected.Port, strings.Join(mismatches, ", ")))
+		} else {
+			row["status"] = "ok"
+		}
+		rows = append(rows, row)
+	}
+
+	result.Details = map[string]any{"cdp_neighbors": rows}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("%d of %d CDP neighbors not as expected: %s",
+			len(issues), len(t.Neighbors), strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d CDP neighbors as expected", len(t.Neighbors))
+	}
+
+	return result, nil
+}
+
+// cdpDeviceName drops the "(serial)" suffix Cisco NX-OS and some IOS
+// releases append to the CDP device ID.
+func cdpDeviceName(deviceID string) string {
+	if i := strings.IndexByte(deviceID, '('); i > 0 && strings.HasSuffix(deviceID, ")") {
+		return deviceID[:i]
+	}
+	return deviceID
+}
+
+func (t *VerifyCDPNeighbors) ValidateInput(input any) error {
+	if len(t.Neighbors) == 0 {
+		return fmt.Errorf("at least one neighbor must be specified")
+	}
+
+	for i, n := range t.Neighbors {
+		if n.Port == "" {
+			return fmt.Errorf("neighbors[%d]: port is required", i)
+		}
+		if n.NeighborDevice == "" {
+			return fmt.Errorf("neighbors[%d]: neighbor_device is required", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package connectivity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const cdpNeighborsDetail = `{"cdpNeighbors": {
+  "Ethernet49/1": {"cdpNeighborInfo": [{"deviceId": "core1.example.com(FOC1234X0AB)",
+    "portId": "TenGigabitEthernet1/0/1", "platform": "cisco WS-C3850-24XS"}]},
+  "Ethernet50/1": {"cdpNeighborInfo": [{"deviceId": "core2", "portId": "TenGigabitEthernet1/0/1"}]},
+  "Ethernet51/1": {"cdpNeighborInfo": []}
+}}`
+
+func TestVerifyCDPNeighbors(t *testing.T) {
+	cases := []struct {
+		name     string
+		neighbor map[string]any
+		status   test.TestStatus
+		want     string
+	}{
+		{"fqdn and serial tolerated", map[string]any{"port": "ethernet49/1", "neighbor_device": "core1",
+			"neighbor_port": "tengigabitethernet1/0/1"}, test.TestSuccess, "All 1 CDP neighbors as expected"},
+		{"wrong port", map[string]any{"port": "Ethernet50/1", "neighbor_device": "core2",
+			"neighbor_port": "TenGigabitEthernet1/0/2"}, test.TestFailure,
+			"Ethernet50/1: port TenGigabitEthernet1/0/1 (expected TenGigabitEthernet1/0/2)"},
+		{"wrong device", map[string]any{"port": "Ethernet50/1", "neighbor_device": "core1"},
+			test.TestFailure, "Ethernet50/1: device core2 (expected core1)"},
+		{"missing neighbor", map[string]any{"port": "Ethernet51/1", "neighbor_device": "core3"},
+			test.TestFailure, "1 of 1 CDP neighbors not as expected: Ethernet51/1: no CDP neighbor"},
+		{"port not in output", map[string]any{"port": "Ethernet52/1", "neighbor_device": "core3"},
+			test.TestFailure, "Ethernet52/1: no CDP neighbor"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyCDPNeighbors(map[string]any{"neighbors": []any{tc.neighbor}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show cdp neighbors detail", cdpNeighborsDetail)
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package connectivity
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
@@ -229,3 +230,245 @@ func lldpHostMatches(systemName, expected string) bool {
 	}
 	return strings.EqualFold(leftLabel(systemName), leftLabel(expected))
 }
+
+// lldpCapabilities are the LLDP system capabilities (IEEE 802.1AB) as EOS
+// names them, keyed by their lowercase form for case-insensitive input.
+var lldpCapabilities = map[string]string{
+	"other":             "other",
+	"repeater":          "repeater",
+	"bridge":            "bridge",
+	"wlanaccesspoint":   "wlanAccessPoint",
+	"router":            "router",
+	"telephone":         "telephone",
+	"docsiscabledevice": "docsisCableDevice",
+	"stationonly":       "stationOnly",
+}
+
+// VerifyLLDPSystemCapabilities verifies the LLDP system capabilities advertised by the device and its neighbors.
+//
+// Each expected set is compared exactly against the enabled capabilities,
+// so a server advertising itself as a router is caught as well as a
+// switch that stopped advertising bridge.
+//  1. If `local_capabilities` is set, the capabilities the device itself
+//     advertises (`show lldp local-info`) must match it.
+//  2. For each entry in `interfaces`, the neighbor on that interface
+//     (`show lldp neighbors detail`) must advertise exactly `capabilities`.
+//
+// Expected Results:
+//   - Success: The test will pass if every advertised capability set matches the expected one.
+//   - Failure: The test will fail if a capability is missing or unexpected, or a neighbor is not found.
+//   - Error: The test will report an error if LLDP information cannot be retrieved.
+//
+// Examples:
+//
+//   - name: VerifyLLDPSystemCapabilities leaf facing a spine and a server
+//     VerifyLLDPSystemCapabilities:
+//     local_capabilities: ["bridge", "router"]
+//     interfaces:
+//
+//   - interface: "Ethernet49/1"
+//     capabilities: ["bridge", "router"]
+//
+//   - interface: "Ethernet1"
+//     capabilities: ["stationOnly"]
+type VerifyLLDPSystemCapabilities struct {
+	test.BaseTest
+	LocalCapabilities []string                  `yaml:"local_capabilities,omitempty" json:"local_capabilities,omitempty"`
+	Interfaces        []LLDPInterfaceCapability `yaml:"interfaces,omitempty" json:"interfaces,omitempty"`
+}
+
+type LLDPInterfaceCapability struct {
+	Interface    string   `yaml:"interface" json:"interface"`
+	Capabilities []string `yaml:"capabilities" json:"capabilities"`
+}
+
+func NewVerifyLLDPSystemCapabilities(inputs map[string]any) (test.Test, error) {
+	t := &VerifyLLDPSystemCapabilities{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyLLDPSystemCapabilities",
+			TestDescription: "Verify LLDP system capabilities of the device and its neighbors",
+			TestCategories:  []string{"connectivity", "lldp"},
+		},
+	}
+
+	if inputs != nil {
+		if err := test.GetStringSlice(inputs, "local_capabilities", &t.LocalCapabilities); err != nil {
+			return nil, err
+		}
+
+		if interfaces, ok := inputs["interfaces"].([]any); ok {
+			for i, entry := range interfaces {
+				intfMap, ok := entry.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected a mapping, got %T", i, entry)
+				}
+				intf := LLDPInterfaceCapability{}
+				if name, ok := intfMap["interface"].(string); ok {
+					intf.Interface = name
+				}
+				if err := test.GetStringSlice(intfMap, "capabilities", &intf.Capabilities); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyLLDPSystemCapabilities) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	issues := []string{}
+
+	if len(t.LocalCapabilities) > 0 {
+		cmdResult, err := dev.Execute(ctx, device.Command{
+			Template: "show lldp local-info",
+			Format:   "json",
+			UseCache: true,
+		})
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get LLDP local info: %v", err)
+			return result, nil
+		}
+		localInfo, err := test.AsMap(cmdResult.Output)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Unexpected LLDP local info output: %v", err)
+			return result, nil
+		}
+		if diff := lldpCapabilityDiff(localInfo["enabledCapabilities"], t.LocalCapabilities); diff != "" {
+			issues = append(issues, "Local device: "+diff)
+		}
+	}
+
+	if len(t.Interfaces) > 0 {
+		cmdResult, err := dev.Execute(ctx, device.Command{
+			Template: "show lldp neighbors detail",
+			Format:   "json",
+			UseCache: true,
+		})
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get LLDP neighbors: %v", err)
+			return result, nil
+		}
+		data, err := test.AsMap(cmdResult.Output)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Unexpected LLDP neighbor output: %v", err)
+			return result, nil
+		}
+		neighbors, _ := data["lldpNeighbors"].(map[string]any)
+
+		for _, intf := range t.Interfaces {
+			var neighbor map[string]any
+			if info, ok := neighbors[intf.Interface].(map[string]any); ok {
+				if list, ok := info["lldpNeighborInfo"].([]any); ok && len(list) > 0 {
+					neighbor, _ = list[0].(map[string]any)
+				}
+			}
+			if neighbor == nil {
+				issues = append(issues, fmt.Sprintf("%s: no LLDP neighbor", intf.Interface))
+				continue
+			}
+			if diff := lldpCapabilityDiff(neighbor["enabledCapabilities"], intf.Capabilities); diff != "" {
+				name, _ := neighbor["systemName"].(string)
+				issues = append(issues, fmt.Sprintf("%s neighbor %s: %s", intf.Interface, name, diff))
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("LLDP capability mismatches: %s", strings.Join(issues, "; "))
+	} else {
+		switch {
+		case len(t.LocalCapabilities) == 0:
+			result.Message = fmt.Sprintf("LLDP capabilities match for %d neighbors", len(t.Interfaces))
+		case len(t.Interfaces) == 0:
+			result.Message = "LLDP capabilities match for the local device"
+		default:
+			result.Message = fmt.Sprintf("LLDP capabilities match for the local device and %d neighbors", len(t.Interfaces))
+		}
+	}
+
+	return result, nil
+}
+
+// lldpCapabilityDiff compares the enabled capabilities EOS reports, a map
+// of capability name to bool, against the expected set. It returns an
+// empty string when they match.
+func lldpCapabilityDiff(enabled any, expected []string) string {
+	have := map[string]bool{}
+	if caps, ok := enabled.(map[string]any); ok {
+		for name, on := range caps {
+			if on == true {
+				have[strings.ToLower(name)] = true
+			}
+		}
+	}
+	want := map[string]bool{}
+	for _, c := range expected {
+		want[strings.ToLower(c)] = true
+	}
+
+	var missing, unexpected []string
+	for c := range want {
+		if !have[c] {
+			missing = append(missing, lldpCapabilities[c])
+		}
+	}
+	for c := range have {
+		if !want[c] {
+			name := lldpCapabilities[c]
+			if name == "" {
+				name = c
+			}
+			unexpected = append(unexpected, name)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+
+	var parts []string
+	if len(unexpected) > 0 {
+		parts = append(parts, "unexpected "+strings.Join(unexpected, ", "))
+	}
+	if len(missing) > 0 {
+		parts = append(parts, "missing "+strings.Join(missing, ", "))
+	}
+	return strings.Join(parts, "; ")
+}
+
+func (t *VerifyLLDPSystemCapabilities) ValidateInput(input any) error {
+	if len(t.LocalCapabilities) == 0 && len(t.Interfaces) == 0 {
+		return fmt.Errorf("either local_capabilities or interfaces must be specified")
+	}
+	for _, c := range t.LocalCapabilities {
+		if _, ok := lldpCapabilities[strings.ToLower(c)]; !ok {
+			return fmt.Errorf("local_capabilities: unknown LLDP capability %q", c)
+		}
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Interface == "" {
+			return fmt.Errorf("interfaces[%d]: interface is required", i)
+		}
+		if len(intf.Capabilities) == 0 {
+			return fmt.Errorf("interfaces[%d]: capabilities must not be empty", i)
+		}
+		for _, c := range intf.Capabilities {
+			if _, ok := lldpCapabilities[strings.ToLower(c)]; !ok {
+				return fmt.Errorf("interfaces[%d]: unknown LLDP capability %q", i, c)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package connectivity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const lldpLocalInfo = `{"systemName": "leaf1",
+  "systemCapabilities": {"bridge": true, "router": true},
+  "enabledCapabilities": {"bridge": true, "router": true}}`
+
+const lldpNeighborsDetail = `{"lldpNeighbors": {
+  "Ethernet49/1": {"lldpNeighborInfo": [{"systemName": "spine1",
+    "enabledCapabilities": {"bridge": true, "router": true}}]},
+  "Ethernet1": {"lldpNeighborInfo": [{"systemName": "host1",
+    "enabledCapabilities": {"router": true, "stationOnly": false}}]},
+  "Ethernet2": {"lldpNeighborInfo": []}
+}}`
+
+func TestVerifyLLDPSystemCapabilities(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"local and spine match", map[string]any{
+			"local_capabilities": []any{"bridge", "Router"},
+			"interfaces":         []any{map[string]any{"interface": "Ethernet49/1", "capabilities": []any{"bridge", "router"}}},
+		}, test.TestSuccess, "LLDP capabilities match for the local device and 1 neighbors"},
+		{"host advertising as a router", map[string]any{
+			"interfaces": []any{map[string]any{"interface": "Ethernet1", "capabilities": []any{"stationOnly"}}},
+		}, test.TestFailure, "Ethernet1 neighbor host1: unexpected router; missing stationOnly"},
+		{"local capability missing", map[string]any{
+			"local_capabilities": []any{"bridge", "router", "telephone"},
+		}, test.TestFailure, "Local device: missing telephone"},
+		{"no neighbor", map[string]any{
+			"interfaces": []any{map[string]any{"interface": "Ethernet2", "capabilities": []any{"bridge"}}},
+		}, test.TestFailure, "Ethernet2: no LLDP neighbor"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyLLDPSystemCapabilities(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show lldp local-info", lldpLocalInfo).
+				OnJSON(t, "show lldp neighbors detail", lldpNeighborsDetail)
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyLLDPSystemCapabilities_ValidateInput(t *testing.T) {
+	for _, inputs := range []map[string]any{
+		nil,
+		{"local_capabilities": []any{"switch"}},
+		{"interfaces": []any{map[string]any{"interface": "Ethernet1"}}},
+	} {
+		tst, err := NewVerifyLLDPSystemCapabilities(inputs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tst.ValidateInput(nil); err == nil {
+			t.Errorf("inputs %v: expected a validation error", inputs)
+		}
+	}
+}
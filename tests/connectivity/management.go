@@ -0,0 +1,209 @@
+package connectivity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyMgmtInterface verifies out-of-band management connectivity.
+//
+// Inputs:
+//
+//	interface: "Management1"   # default Management1
+//	vrf: "MGMT"                # optional, checked against the interface VRF; default "default"
+//	expected_ip: "10.0.0.5/24" # optional, address or address/prefix
+//	gateway: "10.0.0.1"        # optional, pinged from vrf via gNOI System.Ping
+//
+// The interface must be up/connected, be in the expected VRF, and carry
+// the expected address (primary or secondary). When gateway is set the
+// device pings it in that VRF; like VerifyReachability this needs
+// `transport: gnmi` and returns TestError on eAPI once every other
+// sub-check has passed. The failure message names each failed sub-check.
+type VerifyMgmtInterface struct {
+	test.BaseTest
+	Interface  string `yaml:"interface,omitempty" json:"interface,omitempty"`
+	VRF        string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+	ExpectedIP string `yaml:"expected_ip,omitempty" json:"expected_ip,omitempty"`
+	Gateway    string `yaml:"gateway,omitempty" json:"gateway,omitempty"`
+}
+
+func NewVerifyMgmtInterface(inputs map[string]any) (test.Test, error) {
+	t := &VerifyMgmtInterface{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyMgmtInterface",
+			TestDescription: "Verify management interface state, addressing and gateway reachability",
+			TestCategories:  []string{"connectivity", "management"},
+		},
+		Interface: "Management1",
+		VRF:       "default",
+	}
+
+	if err := test.GetString(inputs, "interface", &t.Interface); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "vrf", &t.VRF); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "expected_ip", &t.ExpectedIP); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "gateway", &t.Gateway); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyMgmtInterface) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: fmt.Sprintf("show ip interface %s", t.Interface),
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get %s status: %v", t.Interface, err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected interface output: %v", err)
+		return result, nil
+	}
+
+	interfaces, _ := data["interfaces"].(map[string]any)
+	info, ok := interfaces[t.Interface].(map[string]any)
+	if !ok {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Management interface %s not found", t.Interface)
+		return result, nil
+	}
+
+	issues := []string{}
+
+	lineProtocol, _ := info["lineProtocolStatus"].(string)
+	intfStatus, _ := info["interfaceStatus"].(string)
+	if lineProtocol != "up" || intfStatus != "connected" {
+		issues = append(issues, fmt.Sprintf("state: %s/%s (expected up/connected)", intfStatus, lineProtocol))
+	}
+
+	vrf, _ := info["vrf"].(string)
+	if vrf == "" {
+		vrf = "default"
+	}
+	if vrf != t.VRF {
+		issues = append(issues, fmt.Sprintf("vrf: %s (expected %s)", vrf, t.VRF))
+	}
+
+	addresses := mgmtInterfaceAddresses(info)
+	if t.ExpectedIP != "" && !hasMgmtAddress(addresses, t.ExpectedIP) {
+		configured := "none"
+		if len(addresses) > 0 {
+			configured = strings.Join(addresses, ", ")
+		}
+		issues = append(issues, fmt.Sprintf("address: %s not configured (configured: %s)", t.ExpectedIP, configured))
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Management interface %s failed: %s", t.Interface, strings.Join(issues, "; "))
+		return result, nil
+	}
+
+	if t.Gateway != "" {
+		res, err := dev.Ping(ctx, device.PingOpts{Destination: t.Gateway, VRF: t.VRF, Count: 2})
+		switch {
+		case errors.Is(err, device.ErrDiagUnsupported):
+			result.Status = test.TestError
+			result.Message = "VerifyMgmtInterface gateway check requires transport: gnmi (eAPI cannot serve gNOI Ping)"
+			return result, nil
+		case err != nil:
+			result.Status = test.TestFailure
+			result.Message = fmt.Sprintf("Management interface %s failed: gateway: ping %s error - %v", t.Interface, t.Gateway, err)
+			return result, nil
+		case res.Stats.Received == 0:
+			result.Status = test.TestFailure
+			result.Message = fmt.Sprintf("Management interface %s failed: gateway: %s unreachable in VRF %s (0/%d replies)",
+				t.Interface, t.Gateway, t.VRF, res.Stats.Sent)
+			return result, nil
+		}
+	}
+
+	result.Message = fmt.Sprintf("Management interface %s up in VRF %s", t.Interface, t.VRF)
+	if t.Gateway != "" {
+		result.Message += fmt.Sprintf(", gateway %s reachable", t.Gateway)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyMgmtInterface) ValidateInput(input any) error {
+	if t.Interface == "" {
+		return fmt.Errorf("interface must not be empty")
+	}
+	if t.ExpectedIP != "" && net.ParseIP(t.ExpectedIP) == nil {
+		if _, _, err := net.ParseCIDR(t.ExpectedIP); err != nil {
+			return fmt.Errorf("expected_ip %q is not a valid address or prefix", t.ExpectedIP)
+		}
+	}
+	if t.Gateway != "" && net.ParseIP(t.Gateway) == nil {
+		return fmt.Errorf("gateway %q is not a valid IP address", t.Gateway)
+	}
+	return nil
+}
+
+// mgmtInterfaceAddresses returns the primary and secondary addresses of a
+// `show ip interface` entry in address/maskLen form.
+func mgmtInterfaceAddresses(info map[string]any) []string {
+	addrInfo, _ := info["interfaceAddress"].(map[string]any)
+	var addresses []string
+	add := func(raw any) {
+		ip, ok := raw.(map[string]any)
+		if !ok {
+			return
+		}
+		addr, _ := ip["address"].(string)
+		maskLen, _ := ip["maskLen"].(float64)
+		if addr == "" || addr == "0.0.0.0" {
+			return
+		}
+		addresses = append(addresses, fmt.Sprintf("%s/%d", addr, int(maskLen)))
+	}
+	add(addrInfo["primaryIp"])
+	secondaries, _ := addrInfo["secondaryIpsOrderedList"].([]any)
+	for _, s := range secondaries {
+		add(s)
+	}
+	return addresses
+}
+
+// hasMgmtAddress matches expected against address/maskLen entries; a bare
+// address ignores the prefix length.
+func hasMgmtAddress(addresses []string, expected string) bool {
+	for _, a := range addresses {
+		if a == expected {
+			return true
+		}
+		if addr, _, ok := strings.Cut(a, "/"); ok && addr == expected {
+			return true
+		}
+	}
+	return false
+}
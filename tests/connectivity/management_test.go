@@ -0,0 +1,81 @@
+package connectivity
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const mgmtUp = `{"interfaces": {"Management1": {
+  "lineProtocolStatus": "up", "interfaceStatus": "connected", "vrf": "MGMT",
+  "interfaceAddress": {"primaryIp": {"address": "10.0.0.5", "maskLen": 24}, "secondaryIpsOrderedList": []}
+}}}`
+
+const mgmtNoIP = `{"interfaces": {"Management1": {
+  "lineProtocolStatus": "up", "interfaceStatus": "connected", "vrf": "MGMT",
+  "interfaceAddress": {"primaryIp": {"address": "0.0.0.0", "maskLen": 0}, "secondaryIpsOrderedList": []}
+}}}`
+
+func TestVerifyMgmtInterface(t *testing.T) {
+	inputs := map[string]any{"vrf": "MGMT", "expected_ip": "10.0.0.5/24"}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		output string
+		ping   *device.PingResult
+		status test.TestStatus
+		want   string
+	}{
+		{"healthy", inputs, mgmtUp, nil, test.TestSuccess, "Management1 up in VRF MGMT"},
+		{"bare address", map[string]any{"vrf": "MGMT", "expected_ip": "10.0.0.5"}, mgmtUp, nil, test.TestSuccess, "up"},
+		{"missing IP", inputs, mgmtNoIP, nil, test.TestFailure,
+			"address: 10.0.0.5/24 not configured (configured: none)"},
+		{"wrong vrf", map[string]any{"vrf": "default"}, mgmtUp, nil, test.TestFailure, "vrf: MGMT (expected default)"},
+		{"gateway reachable", map[string]any{"vrf": "MGMT", "gateway": "10.0.0.1"}, mgmtUp,
+			&device.PingResult{Stats: device.PingStats{Sent: 2, Received: 2}}, test.TestSuccess, "gateway 10.0.0.1 reachable"},
+		{"gateway unreachable", map[string]any{"vrf": "MGMT", "gateway": "10.0.0.1"}, mgmtUp,
+			&device.PingResult{Stats: device.PingStats{Sent: 2}}, test.TestFailure, "gateway: 10.0.0.1 unreachable in VRF MGMT"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyMgmtInterface(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show ip interface Management1", tc.output)
+			if tc.ping != nil {
+				dev.PingFunc = func(context.Context, device.PingOpts) (*device.PingResult, error) {
+					return tc.ping, nil
+				}
+			}
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyMgmtInterface_GatewayNeedsGNMI(t *testing.T) {
+	tst, _ := NewVerifyMgmtInterface(map[string]any{"vrf": "MGMT", "gateway": "10.0.0.1"})
+	res, err := tst.Execute(context.Background(), device.NewFakeDevice().OnJSON(t, "show ip interface Management1", mgmtUp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != test.TestError || !strings.Contains(res.Message, "requires transport: gnmi") {
+		t.Errorf("got %v %q", res.Status, res.Message)
+	}
+}
@@ -111,7 +111,10 @@ func (t *VerifyReachability) Execute(ctx context.Context, dev device.Device) (*t
 	}
 
 	failures := []string{}
-	for _, host := range t.Hosts {
+	for i, host := range t.Hosts {
+		if test.Cancelled(ctx, result, i, len(t.Hosts), "hosts", failures) {
+			return result, nil
+		}
 		opts := device.PingOpts{
 			Destination: host.Destination,
 			Source:      host.Source,
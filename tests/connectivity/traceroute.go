@@ -135,7 +135,10 @@ func (t *VerifyTraceroute) Execute(ctx context.Context, dev device.Device) (*tes
 	}
 
 	failures := []string{}
-	for _, dest := range t.Destinations {
+	for i, dest := range t.Destinations {
+		if test.Cancelled(ctx, result, i, len(t.Destinations), "destinations", failures) {
+			return result, nil
+		}
 		opts := device.TracerouteOpts{
 			Destination:  dest.Destination,
 			Source:       dest.Source,
@@ -0,0 +1,145 @@
+package cvx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// cvxRegistrationComplete is the registrationState EOS reports for a
+// connected CVX peer.
+const cvxRegistrationComplete = "Registration complete"
+
+// VerifyCVXStatus verifies the CloudVision eXchange (CVX) connection and registered services.
+//
+// This test performs the following checks:
+//  1. Verifies that CVX is enabled.
+//  2. If `peer` is set, confirms the peer is present and has completed registration.
+//  3. For each entry in `services`, confirms the service is registered and enabled.
+//
+// Expected Results:
+//   - Success: CVX is enabled, the peer is connected, and all services are registered.
+//   - Failure: CVX is disabled, the peer is missing or not registered, or a service is missing or disabled.
+//   - Error: Unable to retrieve CVX information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyCVXStatus"
+//     module: "cvx"
+//     inputs:
+//     peer: "cvx-1"
+//     services: ["Vxlan", "OpenStack"]
+type VerifyCVXStatus struct {
+	test.BaseTest
+	Peer     string   `yaml:"peer,omitempty" json:"peer,omitempty"`
+	Services []string `yaml:"services,omitempty" json:"services,omitempty"`
+}
+
+func NewVerifyCVXStatus(inputs map[string]any) (test.Test, error) {
+	t := &VerifyCVXStatus{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyCVXStatus",
+			TestDescription: "Verify CVX connection state and registered services",
+			TestCategories:  []string{"cvx"},
+		},
+	}
+
+	if err := test.GetString(inputs, "peer", &t.Peer); err != nil {
+		return nil, err
+	}
+	if err := test.GetStringSlice(inputs, "services", &t.Services); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyCVXStatus) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show cvx",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get CVX status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected CVX output: %v", err)
+		return result, nil
+	}
+
+	if enabled, _ := data["enabled"].(bool); !enabled {
+		result.Status = test.TestFailure
+		result.Message = "CVX is disabled"
+		return result, nil
+	}
+
+	issues := []string{}
+
+	if t.Peer != "" {
+		clusterStatus, _ := data["clusterStatus"].(map[string]any)
+		peers, _ := clusterStatus["peerStatus"].(map[string]any)
+		peer, ok := peers[t.Peer].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("CVX peer %s not found", t.Peer))
+		} else if state, _ := peer["registrationState"].(string); state != cvxRegistrationComplete {
+			if state == "" {
+				state = "unknown"
+			}
+			issues = append(issues, fmt.Sprintf("CVX peer %s is disconnected (registration state: %s)", t.Peer, state))
+		}
+	}
+
+	services, _ := data["services"].(map[string]any)
+	for _, name := range t.Services {
+		service, ok := services[name].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("CVX service %s not registered", name))
+			continue
+		}
+		if enabled, ok := service["enabled"].(bool); ok && !enabled {
+			issues = append(issues, fmt.Sprintf("CVX service %s is disabled", name))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("CVX issues: %s", strings.Join(issues, "; "))
+	} else {
+		registered := make([]string, 0, len(services))
+		for name := range services {
+			registered = append(registered, name)
+		}
+		sort.Strings(registered)
+		result.Details = map[string]any{"services": registered}
+		result.Message = fmt.Sprintf("CVX enabled with %d expected services registered", len(t.Services))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyCVXStatus) ValidateInput(input any) error {
+	for i, service := range t.Services {
+		if service == "" {
+			return fmt.Errorf("services[%d]: name must not be empty", i)
+		}
+	}
+	return nil
+}
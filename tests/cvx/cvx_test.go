@@ -0,0 +1,68 @@
+package cvx
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showCvx = `{
+  "enabled": true,
+  "clusterMode": true,
+  "clusterStatus": {
+    "role": "Master",
+    "peerStatus": {
+      "cvx-1": {"peerName": "cvx-1", "registrationState": "Registration complete"},
+      "cvx-2": {"peerName": "cvx-2", "registrationState": "Registration in progress"}
+    }
+  },
+  "services": {
+    "Vxlan": {"enabled": true},
+    "OpenStack": {"enabled": false}
+  }
+}`
+
+func TestVerifyCVXStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{"connected", map[string]any{"peer": "cvx-1", "services": []any{"Vxlan"}}, showCvx, test.TestSuccess,
+			"1 expected services registered"},
+		{"disconnected peer", map[string]any{"peer": "cvx-2"}, showCvx, test.TestFailure,
+			"CVX peer cvx-2 is disconnected (registration state: Registration in progress)"},
+		{"unknown peer", map[string]any{"peer": "cvx-3"}, showCvx, test.TestFailure, "CVX peer cvx-3 not found"},
+		{"missing service", map[string]any{"services": []any{"Vxlan", "Mcs"}}, showCvx, test.TestFailure,
+			"CVX service Mcs not registered"},
+		{"disabled service", map[string]any{"services": []any{"OpenStack"}}, showCvx, test.TestFailure,
+			"CVX service OpenStack is disabled"},
+		{"cvx disabled", map[string]any{}, `{"enabled": false}`, test.TestFailure, "CVX is disabled"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyCVXStatus(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			res, err := tst.Execute(context.Background(), device.NewFakeDevice().OnJSON(t, "show cvx", tc.output))
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
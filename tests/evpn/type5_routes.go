@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/fluidstackio/go-anta/internal/rib"
 	"github.com/fluidstackio/go-anta/pkg/device"
 	"github.com/fluidstackio/go-anta/pkg/test"
 )
@@ -24,6 +25,10 @@ import (
 //     matching paths is 'active' and 'valid'. The route targets check ensures all specified RTs
 //     are present in the path's extended communities (subset check).
 //
+// IPv4 and IPv6 prefixes are both supported; the IPv6 Type-5 table is only
+// read when an IPv6 prefix is requested. A prefix entry with `vrf` set is
+// also checked for installation in that VRF's routing table.
+//
 // Expected Results:
 //   - Success:
 //       * If only prefix/VNI is provided: The prefix/VNI exists in the EVPN table
@@ -34,10 +39,11 @@ import (
 //         at least one matching path exists and is active and valid.
 //   - Failure:
 //       * No EVPN Type-5 routes are found for the given prefix/VNI.
+//       * A prefix with `vrf` set is not installed in that VRF's routing table.
 //       * A specified route (RD/Domain) is not found.
 //       * No active and valid path is found when required (either globally for the prefix, per specified route, or per specified path criteria).
 //       * A specified path criteria (nexthop/RTs) does not match any received paths for the route.
-//   - Error: Unable to retrieve EVPN routing information from the device.
+//   - Error: Unable to retrieve EVPN or VRF routing information from the device.
 //
 // Example YAML configuration:
 //   - name: "VerifyEVPNType5Routes"
@@ -84,6 +90,7 @@ type VerifyEVPNType5Routes struct {
 type EVPNPrefix struct {
 	Prefix string `yaml:"prefix" json:"prefix"`
 	VNI    int    `yaml:"vni" json:"vni"`
+	VRF    string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
 }
 
 type EVPNRoute struct {
@@ -123,6 +130,9 @@ func NewVerifyEVPNType5Routes(inputs map[string]any) (test.Test, error) {
 					} else if vni, ok := prefixMap["vni"].(int); ok {
 						prefix.VNI = vni
 					}
+					if vrf, ok := prefixMap["vrf"].(string); ok {
+						prefix.VRF = vrf
+					}
 					if prefix.Prefix != "" && prefix.VNI > 0 {
 						t.Prefixes = append(t.Prefixes, prefix)
 					}
@@ -198,72 +208,76 @@ func (t *VerifyEVPNType5Routes) Execute(ctx context.Context, dev device.Device)
 		Categories: t.Categories(),
 	}
 
-	cmd := device.Command{
-		Template: "show bgp evpn route-type ip-prefix ipv4",
-		Format:   "json",
-		UseCache: false,
-	}
-
-	cmdResult, err := dev.Execute(ctx, cmd)
-	if err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to get EVPN Type-5 routes: %v", err)
-		return result, nil
-	}
-
 	issues := []string{}
 	deviceRoutes := make(map[string][]DeviceEVPNRoute)
 
-	evpnData, ok := cmdResult.Output.(map[string]any)
-	if !ok {
-		result.Status = test.TestError
-		result.Message = "Failed to parse EVPN output"
-		return result, nil
-	}
+	for _, family := range t.families() {
+		cmd := device.Command{
+			Template: "show bgp evpn route-type ip-prefix " + family,
+			Format:   "json",
+			UseCache: false,
+		}
 
-	vrfRoutes, ok := evpnData["vrf"].(map[string]any)
-	if !ok {
-		result.Status = test.TestError
-		result.Message = "No VRF data found in EVPN output"
-		return result, nil
-	}
+		cmdResult, err := dev.Execute(ctx, cmd)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get EVPN Type-5 routes: %v", err)
+			return result, nil
+		}
 
-	for vrfName, vrfData := range vrfRoutes {
-		vrf, ok := vrfData.(map[string]any)
+		evpnData, ok := cmdResult.Output.(map[string]any)
 		if !ok {
-			continue
+			result.Status = test.TestError
+			result.Message = "Failed to parse EVPN output"
+			return result, nil
 		}
 
-		bgpRouteEntries, ok := vrf["bgpRouteEntries"].(map[string]any)
+		vrfRoutes, ok := evpnData["vrf"].(map[string]any)
 		if !ok {
-			continue
+			result.Status = test.TestError
+			result.Message = "No VRF data found in EVPN output"
+			return result, nil
 		}
 
-		for prefix, routeData := range bgpRouteEntries {
-			route, ok := routeData.(map[string]any)
+		for vrfName, vrfData := range vrfRoutes {
+			vrf, ok := vrfData.(map[string]any)
 			if !ok {
 				continue
 			}
 
-			deviceRoute := DeviceEVPNRoute{
-				Prefix: prefix,
-				VRF:    vrfName,
+			bgpRouteEntries, ok := vrf["bgpRouteEntries"].(map[string]any)
+			if !ok {
+				continue
 			}
 
-			// Parse VNI
-			if vni, ok := route["vni"].(float64); ok {
-				deviceRoute.VNI = int(vni)
-			}
+			for prefix, routeData := range bgpRouteEntries {
+				route, ok := routeData.(map[string]any)
+				if !ok {
+					continue
+				}
 
-			// Parse Route Distinguisher
-			if rd, ok := route["routeDistinguisher"].(string); ok {
-				deviceRoute.RD = rd
-			}
+				deviceRoute := DeviceEVPNRoute{
+					Prefix: prefix,
+					VRF:    vrfName,
+				}
+
+				// Parse VNI
+				if vni, ok := route["vni"].(float64); ok {
+					deviceRoute.VNI = int(vni)
+				}
+
+				// Parse Route Distinguisher
+				if rd, ok := route["routeDistinguisher"].(string); ok {
+					deviceRoute.RD = rd
+				}
 
-			// Parse paths
-			deviceRoute.Paths = parseBGPRoutePaths(route)
+				// Parse paths
+				deviceRoute.Paths = parseBGPRoutePaths(route)
 
-			deviceRoutes[prefix] = append(deviceRoutes[prefix], deviceRoute)
+				// Keyed canonically so IPv6 inputs match however they are written.
+				key := rib.Canonical(prefix)
+				deviceRoutes[key] = append(deviceRoutes[key], deviceRoute)
+			}
 		}
 	}
 
@@ -272,7 +286,7 @@ func (t *VerifyEVPNType5Routes) Execute(ctx context.Context, dev device.Device)
 		found := false
 		hasActiveValidPath := false
 
-		if routes, exists := deviceRoutes[expectedPrefix.Prefix]; exists {
+		if routes, exists := deviceRoutes[rib.Canonical(expectedPrefix.Prefix)]; exists {
 			for _, route := range routes {
 				if route.VNI == expectedPrefix.VNI {
 					found = true
@@ -293,6 +307,17 @@ func (t *VerifyEVPNType5Routes) Execute(ctx context.Context, dev device.Device)
 		} else if !hasActiveValidPath {
 			issues = append(issues, fmt.Sprintf("EVPN Type-5 route for prefix %s VNI %d has no active and valid paths",
 				expectedPrefix.Prefix, expectedPrefix.VNI))
+		} else if expectedPrefix.VRF != "" {
+			installed, err := rib.InVRF(ctx, dev, expectedPrefix.VRF, expectedPrefix.Prefix)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Failed to get routes for VRF %s: %v", expectedPrefix.VRF, err)
+				return result, nil
+			}
+			if !installed {
+				issues = append(issues, fmt.Sprintf("EVPN Type-5 route for prefix %s VNI %d not installed in VRF %s",
+					expectedPrefix.Prefix, expectedPrefix.VNI, expectedPrefix.VRF))
+			}
 		}
 	}
 
@@ -300,7 +325,7 @@ func (t *VerifyEVPNType5Routes) Execute(ctx context.Context, dev device.Device)
 	for _, expectedRoute := range t.Routes {
 		found := false
 
-		if routes, exists := deviceRoutes[expectedRoute.Prefix]; exists {
+		if routes, exists := deviceRoutes[rib.Canonical(expectedRoute.Prefix)]; exists {
 			for _, route := range routes {
 				if route.RD == expectedRoute.RD && route.VNI == expectedRoute.VNI {
 					if expectedRoute.Domain == "" || route.VRF == expectedRoute.Domain {
@@ -321,7 +346,7 @@ func (t *VerifyEVPNType5Routes) Execute(ctx context.Context, dev device.Device)
 	for _, expectedPath := range t.Paths {
 		found := false
 
-		if routes, exists := deviceRoutes[expectedPath.Prefix]; exists {
+		if routes, exists := deviceRoutes[rib.Canonical(expectedPath.Prefix)]; exists {
 			for _, route := range routes {
 				if route.VNI == expectedPath.VNI {
 					for _, path := range route.Paths {
@@ -380,6 +405,36 @@ func (t *VerifyEVPNType5Routes) Execute(ctx context.Context, dev device.Device)
 	return result, nil
 }
 
+// families returns the Type-5 tables ("ipv4", "ipv6") the inputs need.
+// IPv4 is read unless every input prefix is IPv6.
+func (t *VerifyEVPNType5Routes) families() []string {
+	v4, v6 := false, false
+	check := func(prefix string) {
+		if rib.IsIPv6(prefix) {
+			v6 = true
+		} else {
+			v4 = true
+		}
+	}
+	for _, p := range t.Prefixes {
+		check(p.Prefix)
+	}
+	for _, r := range t.Routes {
+		check(r.Prefix)
+	}
+	for _, p := range t.Paths {
+		check(p.Prefix)
+	}
+	var families []string
+	if v4 || !v6 {
+		families = append(families, "ipv4")
+	}
+	if v6 {
+		families = append(families, "ipv6")
+	}
+	return families
+}
+
 // parseBGPRoutePaths extracts BGP paths from a route data structure
 func parseBGPRoutePaths(route map[string]any) []EVPNRoutePath {
 	var paths []EVPNRoutePath
@@ -463,6 +518,11 @@ func (t *VerifyEVPNType5Routes) ValidateInput(input any) error {
 		if prefix.VNI < 1 || prefix.VNI > 16777215 {
 			return fmt.Errorf("prefix at index %d has invalid VNI %d (must be 1-16777215)", i, prefix.VNI)
 		}
+		if prefix.VRF != "" {
+			if _, err := rib.VRFRouteCommand(prefix.VRF, prefix.Prefix); err != nil {
+				return fmt.Errorf("prefix at index %d: %w", i, err)
+			}
+		}
 	}
 
 	// Validate routes
@@ -0,0 +1,88 @@
+package evpn
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const type5IPv4 = `{"vrf": {"PROD": {"bgpRouteEntries": {
+  "192.168.10.0/24": {"vni": 50001, "routeDistinguisher": "10.0.0.1:50001",
+    "bgpRoutePaths": [{"active": true, "valid": true, "nexthop": "10.1.1.1"}]}
+}}}}`
+
+const type5IPv6 = `{"vrf": {"PROD": {"bgpRouteEntries": {
+  "2001:db8:10::/64": {"vni": 50001, "bgpRoutePaths": [{"active": false, "valid": true}]},
+  "2001:db8:20::/64": {"vni": 50002, "bgpRoutePaths": [{"active": true, "valid": true}]}
+}}}}`
+
+func runType5(t *testing.T, inputs map[string]any, dev *device.FakeDevice) *test.TestResult {
+	t.Helper()
+	tst, err := NewVerifyEVPNType5Routes(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+	res, err := tst.Execute(context.Background(), dev)
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	return res
+}
+
+func TestVerifyEVPNType5Routes(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show bgp evpn route-type ip-prefix ipv4", type5IPv4).
+		OnJSON(t, "show bgp evpn route-type ip-prefix ipv6", type5IPv6).
+		OnJSON(t, "show ip route vrf PROD 192.168.10.0/24", `{"vrfs": {"PROD": {"routes": {"192.168.10.0/24": {"routeType": "bgp"}}}}}`)
+
+	res := runType5(t, map[string]any{"prefixes": []any{
+		map[string]any{"prefix": "192.168.10.0/24", "vni": 50001, "vrf": "PROD"},
+		map[string]any{"prefix": "2001:db8:10:0::/64", "vni": 50001},
+		map[string]any{"prefix": "192.168.99.0/24", "vni": 50001},
+	}}, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	for _, want := range []string{
+		"prefix 2001:db8:10:0::/64 VNI 50001 has no active and valid paths",
+		"prefix 192.168.99.0/24 VNI 50001 not found",
+	} {
+		if !strings.Contains(res.Message, want) {
+			t.Errorf("message %q should contain %q", res.Message, want)
+		}
+	}
+	if strings.Contains(res.Message, "192.168.10.0/24") {
+		t.Errorf("present route should not be reported: %s", res.Message)
+	}
+}
+
+func TestVerifyEVPNType5Routes_NotInstalledInVRF(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show bgp evpn route-type ip-prefix ipv6", type5IPv6).
+		OnJSON(t, "show ipv6 route vrf PROD 2001:db8:20::/64", `{"vrfs": {"PROD": {"routes": {}}}}`)
+
+	res := runType5(t, map[string]any{"prefixes": []any{
+		map[string]any{"prefix": "2001:db8:20::/64", "vni": 50002, "vrf": "PROD"},
+	}}, dev)
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, "not installed in VRF PROD") {
+		t.Errorf("got %v %q, want a not-installed failure", res.Status, res.Message)
+	}
+}
+
+func TestVerifyEVPNType5Routes_CommandError(t *testing.T) {
+	// No ipv6 table in the fake: the lookup fails, which is an error, not a failure.
+	dev := device.NewFakeDevice().OnJSON(t, "show bgp evpn route-type ip-prefix ipv4", type5IPv4)
+
+	res := runType5(t, map[string]any{"prefixes": []any{
+		map[string]any{"prefix": "2001:db8:20::/64", "vni": 50002},
+	}}, dev)
+	if res.Status != test.TestError || !strings.Contains(res.Message, "Failed to get EVPN Type-5 routes") {
+		t.Errorf("got %v %q, want a command error", res.Status, res.Message)
+	}
+}
@@ -0,0 +1,234 @@
+package flowtracking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyHardwareFlowTrackerStatus verifies hardware flow tracking state.
+//
+// This test performs the following checks:
+//  1. Verifies that hardware flow tracking is running.
+//  2. For each specified tracker:
+//     - Confirms the tracker exists and is active.
+//     - If `record_export` is set, validates the inactive timeout and active
+//     (export) interval, in milliseconds.
+//     - For each entry in `exporters`, confirms the exporter is configured
+//     and, when given, uses the expected local interface and template interval.
+//
+// Expected Results:
+//   - Success: Flow tracking is running and every tracker is active with the expected settings.
+//   - Failure: Flow tracking is not running, a tracker is missing or inactive, or an exporter is missing or misconfigured.
+//   - Error: Unable to retrieve flow tracking information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyHardwareFlowTrackerStatus"
+//     module: "flow_tracking"
+//     inputs:
+//     trackers:
+//   - tracker_name: "FLOW-TRACKER"
+//     record_export:
+//     on_inactive_timeout: 70000
+//     on_interval: 300000
+//     exporters:
+//   - name: "CV-TELEMETRY"
+//     local_interface: "Loopback0"
+//     template_interval: 3600000
+type VerifyHardwareFlowTrackerStatus struct {
+	test.BaseTest
+	Trackers []FlowTracker `yaml:"trackers" json:"trackers"`
+}
+
+type FlowTracker struct {
+	TrackerName  string         `yaml:"tracker_name" json:"tracker_name"`
+	RecordExport *RecordExport  `yaml:"record_export,omitempty" json:"record_export,omitempty"`
+	Exporters    []FlowExporter `yaml:"exporters,omitempty" json:"exporters,omitempty"`
+}
+
+type RecordExport struct {
+	OnInactiveTimeout int `yaml:"on_inactive_timeout" json:"on_inactive_timeout"`
+	OnInterval        int `yaml:"on_interval" json:"on_interval"`
+}
+
+type FlowExporter struct {
+	Name             string `yaml:"name" json:"name"`
+	LocalInterface   string `yaml:"local_interface,omitempty" json:"local_interface,omitempty"`
+	TemplateInterval int    `yaml:"template_interval,omitempty" json:"template_interval,omitempty"`
+}
+
+func NewVerifyHardwareFlowTrackerStatus(inputs map[string]any) (test.Test, error) {
+	t := &VerifyHardwareFlowTrackerStatus{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyHardwareFlowTrackerStatus",
+			TestDescription: "Verify hardware flow tracker state and settings",
+			TestCategories:  []string{"flow_tracking"},
+		},
+	}
+
+	if inputs != nil {
+		if trackers, ok := inputs["trackers"].([]any); ok {
+			for i, item := range trackers {
+				trackerMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("trackers[%d]: expected map, got %T", i, item)
+				}
+				tracker, err := parseFlowTracker(trackerMap)
+				if err != nil {
+					return nil, fmt.Errorf("trackers[%d]: %w", i, err)
+				}
+				t.Trackers = append(t.Trackers, tracker)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func parseFlowTracker(m map[string]any) (FlowTracker, error) {
+	tracker := FlowTracker{}
+	if err := test.GetString(m, "tracker_name", &tracker.TrackerName); err != nil {
+		return tracker, err
+	}
+
+	if re, ok := m["record_export"].(map[string]any); ok {
+		tracker.RecordExport = &RecordExport{}
+		if err := test.GetInt(re, "on_inactive_timeout", &tracker.RecordExport.OnInactiveTimeout); err != nil {
+			return tracker, fmt.Errorf("record_export: %w", err)
+		}
+		if err := test.GetInt(re, "on_interval", &tracker.RecordExport.OnInterval); err != nil {
+			return tracker, fmt.Errorf("record_export: %w", err)
+		}
+	}
+
+	if exporters, ok := m["exporters"].([]any); ok {
+		for i, item := range exporters {
+			em, ok := item.(map[string]any)
+			if !ok {
+				return tracker, fmt.Errorf("exporters[%d]: expected map, got %T", i, item)
+			}
+			exporter := FlowExporter{}
+			if err := test.GetString(em, "name", &exporter.Name); err != nil {
+				return tracker, fmt.Errorf("exporters[%d]: %w", i, err)
+			}
+			if err := test.GetString(em, "local_interface", &exporter.LocalInterface); err != nil {
+				return tracker, fmt.Errorf("exporters[%d]: %w", i, err)
+			}
+			if err := test.GetInt(em, "template_interval", &exporter.TemplateInterval); err != nil {
+				return tracker, fmt.Errorf("exporters[%d]: %w", i, err)
+			}
+			tracker.Exporters = append(tracker.Exporters, exporter)
+		}
+	}
+
+	return tracker, nil
+}
+
+func (t *VerifyHardwareFlowTrackerStatus) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show flow tracking hardware",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get hardware flow tracking status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected flow tracking output: %v", err)
+		return result, nil
+	}
+
+	if running, _ := data["running"].(bool); !running {
+		result.Status = test.TestFailure
+		result.Message = "Hardware flow tracking is not running"
+		return result, nil
+	}
+
+	trackers, _ := data["trackers"].(map[string]any)
+	issues := []string{}
+
+	for _, expected := range t.Trackers {
+		tracker, ok := trackers[expected.TrackerName].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Flow tracker %s not found", expected.TrackerName))
+			continue
+		}
+		if active, _ := tracker["active"].(bool); !active {
+			issues = append(issues, fmt.Sprintf("Flow tracker %s is inactive", expected.TrackerName))
+			continue
+		}
+
+		if re := expected.RecordExport; re != nil {
+			inactiveTimeout, _ := tracker["inactiveTimeout"].(float64)
+			activeInterval, _ := tracker["activeInterval"].(float64)
+			if int(inactiveTimeout) != re.OnInactiveTimeout {
+				issues = append(issues, fmt.Sprintf("Flow tracker %s: inactive timeout is %d, expected %d",
+					expected.TrackerName, int(inactiveTimeout), re.OnInactiveTimeout))
+			}
+			if int(activeInterval) != re.OnInterval {
+				issues = append(issues, fmt.Sprintf("Flow tracker %s: export interval is %d, expected %d",
+					expected.TrackerName, int(activeInterval), re.OnInterval))
+			}
+		}
+
+		exporters, _ := tracker["exporters"].(map[string]any)
+		for _, want := range expected.Exporters {
+			exporter, ok := exporters[want.Name].(map[string]any)
+			if !ok {
+				issues = append(issues, fmt.Sprintf("Flow tracker %s: exporter %s not found", expected.TrackerName, want.Name))
+				continue
+			}
+			if localIntf, _ := exporter["localIntf"].(string); want.LocalInterface != "" && localIntf != want.LocalInterface {
+				issues = append(issues, fmt.Sprintf("Flow tracker %s exporter %s: local interface is %s, expected %s",
+					expected.TrackerName, want.Name, localIntf, want.LocalInterface))
+			}
+			if interval, _ := exporter["templateInterval"].(float64); want.TemplateInterval != 0 && int(interval) != want.TemplateInterval {
+				issues = append(issues, fmt.Sprintf("Flow tracker %s exporter %s: template interval is %d, expected %d",
+					expected.TrackerName, want.Name, int(interval), want.TemplateInterval))
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Flow tracking issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Hardware flow tracking running; %d trackers verified", len(t.Trackers))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyHardwareFlowTrackerStatus) ValidateInput(input any) error {
+	if len(t.Trackers) == 0 {
+		return fmt.Errorf("at least one tracker must be specified")
+	}
+	for i, tracker := range t.Trackers {
+		if tracker.TrackerName == "" {
+			return fmt.Errorf("trackers[%d]: tracker_name is required", i)
+		}
+		for j, exporter := range tracker.Exporters {
+			if exporter.Name == "" {
+				return fmt.Errorf("trackers[%d].exporters[%d]: name is required", i, j)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package flowtracking
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const flowTrackingHardware = `{
+  "running": true,
+  "trackers": {
+    "FLOW-TRACKER": {
+      "active": true, "inactiveTimeout": 70000, "activeInterval": 300000,
+      "exporters": {"CV-TELEMETRY": {"localIntf": "Loopback0", "templateInterval": 3600000}}
+    },
+    "FLOW-IDLE": {"active": false, "exporters": {}}
+  }
+}`
+
+func runTracker(t *testing.T, inputs map[string]any, output string) *test.TestResult {
+	t.Helper()
+	tst, err := NewVerifyHardwareFlowTrackerStatus(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+	res, err := tst.Execute(context.Background(), device.NewFakeDevice().OnJSON(t, "show flow tracking hardware", output))
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	return res
+}
+
+func TestVerifyHardwareFlowTrackerStatus(t *testing.T) {
+	tracker := func(name string, extra map[string]any) map[string]any {
+		m := map[string]any{"tracker_name": name}
+		for k, v := range extra {
+			m[k] = v
+		}
+		return map[string]any{"trackers": []any{m}}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{"healthy", tracker("FLOW-TRACKER", map[string]any{
+			"record_export": map[string]any{"on_inactive_timeout": 70000, "on_interval": 300000},
+			"exporters":     []any{map[string]any{"name": "CV-TELEMETRY", "local_interface": "Loopback0", "template_interval": 3600000}},
+		}), flowTrackingHardware, test.TestSuccess, "1 trackers verified"},
+		{"inactive tracker", tracker("FLOW-IDLE", nil), flowTrackingHardware, test.TestFailure,
+			"Flow tracker FLOW-IDLE is inactive"},
+		{"missing exporter", tracker("FLOW-TRACKER", map[string]any{
+			"exporters": []any{map[string]any{"name": "IPFIX"}},
+		}), flowTrackingHardware, test.TestFailure, "Flow tracker FLOW-TRACKER: exporter IPFIX not found"},
+		{"not running", tracker("FLOW-TRACKER", nil), `{"running": false, "trackers": {}}`, test.TestFailure,
+			"Hardware flow tracking is not running"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := runTracker(t, tc.inputs, tc.output)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package generic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showVersion = `{"modelName": "DCS-7280CR3-32P4", "version": "4.32.1F", "memTotal": 32000000}`
+
+const showBgpSummary = `{"vrfs": {"default": {"peers": {
+  "10.0.0.1": {"peerState": "Established", "prefixReceived": 12},
+  "10.0.0.2": {"peerState": "Active", "prefixReceived": 0}
+}}}}`
+
+func TestVerifyShowCommand(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"text contains", map[string]any{"command": "show running-config section aaa", "format": "text", "contains": "aaa authorization exec default"},
+			test.TestSuccess, "contains"},
+		{"text missing", map[string]any{"command": "show running-config section aaa", "format": "text", "contains": "aaa accounting"},
+			test.TestFailure, "does not contain 'aaa accounting'"},
+		{"jsonpath equals", map[string]any{"command": "show version", "jsonpath": "$.modelName", "expected": "DCS-7280CR3-32P4"},
+			test.TestSuccess, "1 value(s) checked"},
+		{"jsonpath number", map[string]any{"command": "show version", "jsonpath": "memTotal", "expected": 32000000},
+			test.TestSuccess, "matched"},
+		{"jsonpath mismatch", map[string]any{"command": "show version", "jsonpath": "$.version", "expected": "4.33.0F"},
+			test.TestFailure, "$.version is 4.32.1F, expected 4.33.0F"},
+		{"quoted key", map[string]any{"command": "show ip bgp summary", "jsonpath": "$.vrfs.default.peers['10.0.0.1'].peerState", "expected": "Established"},
+			test.TestSuccess, "matched"},
+		{"wildcard", map[string]any{"command": "show ip bgp summary", "jsonpath": "$.vrfs.default.peers[*].peerState", "expected": "Established"},
+			test.TestFailure, "is Active, expected Established"},
+		{"path matches nothing", map[string]any{"command": "show version", "jsonpath": "$.serialNumber"},
+			test.TestFailure, "Path $.serialNumber matched nothing"},
+		{"json contains", map[string]any{"command": "show version", "contains": "7280CR3"},
+			test.TestSuccess, "matched"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show version", showVersion).
+				OnJSON(t, "show ip bgp summary", showBgpSummary)
+			dev.Outputs["show running-config section aaa"] = map[string]any{
+				"output": "aaa authorization exec default group TACACS local\naaa authentication login default group TACACS local\n",
+			}
+			tst, err := NewVerifyShowCommand(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyShowCommandValidateInput(t *testing.T) {
+	for _, inputs := range []map[string]any{
+		{},
+		{"command": "show version"},
+		{"command": "show version", "format": "xml", "contains": "x"},
+		{"command": "show version", "format": "text", "jsonpath": "$.a", "contains": "x"},
+		{"command": "show version", "contains": "x", "expected": "y"},
+		{"command": "show version", "jsonpath": "$.a[", "expected": "y"},
+		{"command": "reload now", "format": "text", "contains": "x"},
+		{"command": "configure terminal", "format": "text", "contains": "x"},
+		{"command": "showtech", "format": "text", "contains": "x"},
+		{"command": "show version\nreload now", "format": "text", "contains": "x"},
+	} {
+		tst, err := NewVerifyShowCommand(inputs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tst.ValidateInput(nil); err == nil {
+			t.Errorf("ValidateInput(%v) = nil, want error", inputs)
+		}
+	}
+}
+
+func TestEvalJSONPath(t *testing.T) {
+	doc := device.DecodeJSON(t, `{"a": {"b.c": [10, 20, 30]}, "list": [{"x": 1}, {"x": 2}]}`)
+	cases := []struct {
+		path string
+		want []any
+	}{
+		{"$", []any{doc}},
+		{"$.a['b.c'][1]", []any{20.0}},
+		{`$.a["b.c"][-1]`, []any{30.0}},
+		{"$.list[*].x", []any{1.0, 2.0}},
+		{"list[0].x", []any{1.0}},
+		{"$.missing.x", []any{}},
+		{"$.list[5]", []any{}},
+	}
+	for _, tc := range cases {
+		steps, err := parseJSONPath(tc.path)
+		if err != nil {
+			t.Fatalf("parseJSONPath(%q): %v", tc.path, err)
+		}
+		got := evalJSONPath(doc, steps)
+		if renderValue(got) != renderValue(tc.want) {
+			t.Errorf("%s = %s, want %s", tc.path, renderValue(got), renderValue(tc.want))
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package generic
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathStep is one segment of a parsed JSONPath: a map key, a list index,
+// or a wildcard over every child.
+type pathStep struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// parseJSONPath parses the small JSONPath subset VerifyShowCommand
+// supports: "$" root, ".key", "['key']" / "[\"key\"]" (for keys holding
+// dots, e.g. IP addresses), "[N]" list index, and "*" / "[*]" wildcards.
+// The leading "$" is optional.
+func parseJSONPath(path string) ([]pathStep, error) {
+	p := strings.TrimSpace(path)
+	p = strings.TrimPrefix(p, "$")
+	steps := []pathStep{}
+
+	for len(p) > 0 {
+		switch p[0] {
+		case '.':
+			p = p[1:]
+			end := strings.IndexAny(p, ".[")
+			if end < 0 {
+				end = len(p)
+			}
+			name := p[:end]
+			if name == "" {
+				return nil, fmt.Errorf("empty key in path %q", path)
+			}
+			if name == "*" {
+				steps = append(steps, pathStep{wildcard: true})
+			} else {
+				steps = append(steps, pathStep{key: name})
+			}
+			p = p[end:]
+		case '[':
+			end := strings.Index(p, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated '[' in path %q", path)
+			}
+			inner := strings.TrimSpace(p[1:end])
+			p = p[end+1:]
+			switch {
+			case inner == "*":
+				steps = append(steps, pathStep{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				steps = append(steps, pathStep{key: inner[1 : len(inner)-1]})
+			default:
+				idx, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index %q in path %q", inner, path)
+				}
+				steps = append(steps, pathStep{index: idx, isIndex: true})
+			}
+		default:
+			// Allow "key.sub" without a leading "$." for convenience.
+			if len(steps) == 0 && !strings.HasPrefix(strings.TrimSpace(path), "$") {
+				p = "." + p
+				continue
+			}
+			return nil, fmt.Errorf("unexpected %q in path %q", p[0], path)
+		}
+	}
+
+	return steps, nil
+}
+
+// evalJSONPath returns every value the path selects from doc. Missing keys
+// and out-of-range indexes select nothing rather than erroring.
+func evalJSONPath(doc any, steps []pathStep) []any {
+	current := []any{doc}
+	for _, step := range steps {
+		next := []any{}
+		for _, node := range current {
+			switch v := node.(type) {
+			case map[string]any:
+				if step.wildcard {
+					for _, child := range v {
+						next = append(next, child)
+					}
+				} else if !step.isIndex {
+					if child, ok := v[step.key]; ok {
+						next = append(next, child)
+					}
+				}
+			case []any:
+				if step.wildcard {
+					next = append(next, v...)
+				} else if step.isIndex {
+					idx := step.index
+					if idx < 0 {
+						idx += len(v)
+					}
+					if idx >= 0 && idx < len(v) {
+						next = append(next, v[idx])
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return current
+}
@@ -0,0 +1,231 @@
+package generic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyShowCommand runs an arbitrary show command and checks its output.
+//
+// This is an escape hatch for one-off checks that do not (yet) have a
+// dedicated test. Only `show` commands are accepted, so a catalog cannot
+// use it to change the device. The test supports three modes:
+//  1. Text contains: with `format: text`, the raw output must contain `contains`.
+//  2. JSON path equals: with `jsonpath` and `expected`, every value the path
+//     selects must equal `expected` (numbers compare numerically).
+//  3. JSON contains: with `contains` (and optionally `jsonpath`), the selected
+//     values, or the whole JSON document, must contain the substring.
+//
+// A `jsonpath` without `expected` or `contains` only requires the path to
+// select something. Supported JSONPath syntax: `$.a.b`, `$.a['10.0.0.1']`,
+// `$.list[0]`, and `*` / `[*]` wildcards.
+//
+// Expected Results:
+//   - Success: The output satisfies the configured check.
+//   - Failure: The output does not contain the text, the path selects nothing, or a value differs.
+//   - Error: The command failed or the path could not be parsed.
+//
+// Example YAML configuration:
+//   - name: "VerifyShowCommand"
+//     module: "generic"
+//     inputs:
+//     command: "show version"
+//     jsonpath: "$.modelName"
+//     expected: "DCS-7280CR3-32P4"
+//   - name: "VerifyShowCommand"
+//     module: "generic"
+//     inputs:
+//     command: "show running-config section aaa"
+//     format: "text"
+//     contains: "aaa authorization exec default group TACACS local"
+type VerifyShowCommand struct {
+	test.BaseTest
+	Command  string `yaml:"command" json:"command"`
+	Format   string `yaml:"format,omitempty" json:"format,omitempty"`
+	Contains string `yaml:"contains,omitempty" json:"contains,omitempty"`
+	JSONPath string `yaml:"jsonpath,omitempty" json:"jsonpath,omitempty"`
+	Expected any    `yaml:"expected,omitempty" json:"expected,omitempty"`
+}
+
+func NewVerifyShowCommand(inputs map[string]any) (test.Test, error) {
+	t := &VerifyShowCommand{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyShowCommand",
+			TestDescription: "Verify the output of an arbitrary show command",
+			TestCategories:  []string{"generic"},
+		},
+		Format: "json",
+	}
+
+	if err := test.GetString(inputs, "command", &t.Command); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "format", &t.Format); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "contains", &t.Contains); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "jsonpath", &t.JSONPath); err != nil {
+		return nil, err
+	}
+	if inputs != nil {
+		t.Expected = inputs["expected"]
+	}
+
+	return t, nil
+}
+
+func (t *VerifyShowCommand) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: t.Command,
+		Format:   t.Format,
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to run '%s': %v", t.Command, err)
+		return result, nil
+	}
+
+	if t.Format == "text" {
+		text, _ := device.TextOutput(cmdResult.Output)
+		if !strings.Contains(text, t.Contains) {
+			result.Status = test.TestFailure
+			result.Message = fmt.Sprintf("Output of '%s' does not contain '%s'", t.Command, t.Contains)
+			return result, nil
+		}
+		result.Message = fmt.Sprintf("Output of '%s' contains '%s'", t.Command, t.Contains)
+		return result, nil
+	}
+
+	values := []any{cmdResult.Output}
+	if t.JSONPath != "" {
+		steps, err := parseJSONPath(t.JSONPath)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Invalid jsonpath: %v", err)
+			return result, nil
+		}
+		values = evalJSONPath(cmdResult.Output, steps)
+		if len(values) == 0 {
+			result.Status = test.TestFailure
+			result.Message = fmt.Sprintf("Path %s matched nothing in output of '%s'", t.JSONPath, t.Command)
+			return result, nil
+		}
+	}
+
+	issues := []string{}
+	for _, value := range values {
+		if t.Expected != nil && !valuesEqual(value, t.Expected) {
+			issues = append(issues, fmt.Sprintf("%s is %s, expected %s", t.target(), renderValue(value), renderValue(t.Expected)))
+		}
+		if t.Contains != "" && !strings.Contains(renderValue(value), t.Contains) {
+			issues = append(issues, fmt.Sprintf("%s does not contain '%s'", t.target(), t.Contains))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Output of '%s' did not match: %s", t.Command, strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Output of '%s' matched (%d value(s) checked)", t.Command, len(values))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyShowCommand) ValidateInput(input any) error {
+	if strings.TrimSpace(t.Command) == "" {
+		return fmt.Errorf("command must be specified")
+	}
+	// Catalogs are often shared, so only read-only commands may run.
+	if !strings.HasPrefix(strings.ToLower(strings.TrimSpace(t.Command)), "show ") {
+		return fmt.Errorf("command must be a show command, got %q", t.Command)
+	}
+	if strings.ContainsAny(t.Command, "\n\r") {
+		return fmt.Errorf("command must be a single line")
+	}
+	switch t.Format {
+	case "json":
+		if t.JSONPath == "" && t.Contains == "" {
+			return fmt.Errorf("json format requires jsonpath or contains")
+		}
+		if t.Expected != nil && t.JSONPath == "" {
+			return fmt.Errorf("expected requires jsonpath")
+		}
+		if t.JSONPath != "" {
+			if _, err := parseJSONPath(t.JSONPath); err != nil {
+				return fmt.Errorf("invalid jsonpath: %w", err)
+			}
+		}
+	case "text":
+		if t.Contains == "" {
+			return fmt.Errorf("text format requires contains")
+		}
+		if t.JSONPath != "" || t.Expected != nil {
+			return fmt.Errorf("jsonpath and expected are only supported with json format")
+		}
+	default:
+		return fmt.Errorf("format must be 'json' or 'text', got %q", t.Format)
+	}
+	return nil
+}
+
+// target names what a failure refers to: the path, or the whole output.
+func (t *VerifyShowCommand) target() string {
+	if t.JSONPath != "" {
+		return t.JSONPath
+	}
+	return "output"
+}
+
+// renderValue formats a selected value for messages and substring checks:
+// strings as-is, everything else as compact JSON.
+func renderValue(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// valuesEqual compares a decoded JSON value with a YAML input value.
+// Numbers compare numerically so 3 (int from YAML) equals 3.0 (JSON).
+func valuesEqual(actual, expected any) bool {
+	a, aNum := toFloat(actual)
+	e, eNum := toFloat(expected)
+	if aNum && eNum {
+		return a == e
+	}
+	return renderValue(actual) == renderValue(expected)
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
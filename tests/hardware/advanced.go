@@ -3,6 +3,7 @@ package hardware
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
@@ -175,21 +176,23 @@ func (t *VerifyAdverseDrops) ValidateInput(input any) error {
 	return nil
 }
 
-// VerifySupervisorRedundancy verifies the redundancy protocol configured on the active supervisor.
+// VerifySupervisorRedundancy verifies the standby supervisor is present and
+// ready to take over.
 //
-// This test validates that supervisor redundancy is properly configured and operational
-// on devices that support redundant supervisors. Supervisor redundancy ensures high
-// availability and seamless failover in case of primary supervisor failure.
+// This test validates that supervisor redundancy is operational on devices
+// with redundant supervisors, using `show redundancy status`. A chassis
+// whose standby is missing, disabled or not yet synchronized has no
+// failover, which is easy to miss until the active supervisor fails.
 //
 // The test performs the following checks:
-//  1. Retrieves supervisor redundancy status and configuration.
-//  2. Validates that redundancy is enabled and properly configured.
-//  3. Checks the status of both primary and standby supervisors.
-//  4. Verifies synchronization state between supervisors.
+//  1. Validates the configured and operational redundancy protocols agree,
+//     and match `expected_protocol` when given.
+//  2. Checks the peer supervisor is in the standby or standbyHot state.
+//  3. Checks the supervisors are communicating and switchover-ready.
 //
 // Expected Results:
-//   - Success: Supervisor redundancy is properly configured and operational.
-//   - Failure: Redundancy is disabled, misconfigured, or supervisors are not synchronized.
+//   - Success: The standby supervisor is healthy and ready for switchover.
+//   - Failure: Redundancy is degraded: the standby is absent or unhealthy, the protocol fell back, or switchover is not ready.
 //   - Error: Unable to retrieve supervisor redundancy information.
 //
 // Examples:
@@ -199,7 +202,7 @@ func (t *VerifyAdverseDrops) ValidateInput(input any) error {
 //
 //   - name: VerifySupervisorRedundancy with protocol validation
 //     VerifySupervisorRedundancy:
-//     expected_protocol: "rpr"  # Route Processor Redundancy
+//     expected_protocol: "sso"  # Stateful Switchover
 type VerifySupervisorRedundancy struct {
 	test.BaseTest
 	ExpectedProtocol string `yaml:"expected_protocol,omitempty" json:"expected_protocol,omitempty"`
@@ -209,15 +212,14 @@ func NewVerifySupervisorRedundancy(inputs map[string]any) (test.Test, error) {
 	t := &VerifySupervisorRedundancy{
 		BaseTest: test.BaseTest{
 			TestName:        "VerifySupervisorRedundancy",
-			TestDescription: "Verify redundancy protocol configured on active supervisor",
+			TestDescription: "Verify the standby supervisor is healthy and switchover-ready",
 			TestCategories:  []string{"hardware", "redundancy"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
-	if inputs != nil {
-		if protocol, ok := inputs["expected_protocol"].(string); ok {
-			t.ExpectedProtocol = protocol
-		}
+	if err := test.GetString(inputs, "expected_protocol", &t.ExpectedProtocol); err != nil {
+		return nil, err
 	}
 
 	return t, nil
@@ -237,7 +239,7 @@ func (t *VerifySupervisorRedundancy) Execute(ctx context.Context, dev device.Dev
 	}
 
 	cmd := device.Command{
-		Template: "show redundancy",
+		Template: "show redundancy status",
 		Format:   "json",
 		UseCache: false,
 	}
@@ -245,70 +247,195 @@ func (t *VerifySupervisorRedundancy) Execute(ctx context.Context, dev device.Dev
 	cmdResult, err := dev.Execute(ctx, cmd)
 	if err != nil {
 		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to get redundancy data: %v", err)
+		result.Message = fmt.Sprintf("Failed to get redundancy status: %v", err)
 		return result, nil
 	}
 
-	if redundancyData, ok := cmdResult.Output.(map[string]any); ok {
-		// Check redundancy protocol
-		if protocol, ok := redundancyData["protocol"].(string); ok {
-			if t.ExpectedProtocol != "" && !strings.EqualFold(protocol, t.ExpectedProtocol) {
-				result.Status = test.TestFailure
-				result.Message = fmt.Sprintf("Redundancy protocol: %s (expected: %s)", protocol, t.ExpectedProtocol)
-				return result, nil
-			}
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected redundancy status output: %v", err)
+		return result, nil
+	}
+
+	configured, _ := data["configuredProtocol"].(string)
+	operational, _ := data["operationalProtocol"].(string)
+	peerState, _ := data["peerState"].(string)
+	communication, _ := data["communicationDesc"].(string)
+	switchoverReady, hasSwitchover := data["switchoverReady"].(bool)
+
+	issues := []string{}
+	if t.ExpectedProtocol != "" && !strings.EqualFold(configured, t.ExpectedProtocol) {
+		issues = append(issues, fmt.Sprintf("configured protocol is %s, expected %s", configured, t.ExpectedProtocol))
+	}
+	if operational != "" && !strings.EqualFold(operational, configured) {
+		issues = append(issues, fmt.Sprintf("operational protocol %s differs from configured %s", operational, configured))
+	}
+	switch peerState {
+	case "standby", "standbyHot":
+	case "":
+		issues = append(issues, "no standby supervisor reported")
+	default:
+		issues = append(issues, fmt.Sprintf("standby supervisor is %s", peerState))
+	}
+	if communication != "" && communication != "Up" {
+		issues = append(issues, fmt.Sprintf("supervisor communication is %s", communication))
+	}
+	if hasSwitchover && !switchoverReady {
+		issues = append(issues, "switchover is not ready")
+	}
+
+	result.Details = map[string]any{
+		"configured_protocol":  configured,
+		"operational_protocol": operational,
+		"peer_state":           peerState,
+		"communication":        communication,
+		"switchover_ready":     switchoverReady,
+	}
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Supervisor redundancy degraded: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Redundancy protocol %s, standby supervisor %s", operational, peerState)
+	}
+
+	return result, nil
+}
+
+func (t *VerifySupervisorRedundancy) ValidateInput(input any) error {
+	// No specific validation required
+	return nil
+}
+
+// VerifyActiveSupervisorConsistency verifies the active and standby
+// supervisors run the same EOS release and hold the same configuration.
+//
+// A version skew between supervisors blocks ISSU and means a switchover
+// changes the running release, so it should be caught before a change.
+//
+// The test performs the following checks:
+//  1. Reads the peer supervisor state from `show redundancy status`; a
+//     chassis with no peer supervisor is skipped.
+//  2. Compares the active's release from `show version` with the standby
+//     supervisor's softwareVersion in `show module`.
+//  3. Checks every file in `show redundancy file-replication` (startup
+//     config and other checkpoints) is synchronized to the standby.
+//
+// Expected Results:
+//   - Success: Both supervisors run the same release and all replicated files are synchronized.
+//   - Failure: The releases differ, or a replicated file is out of sync.
+//   - Skipped: The chassis has a single supervisor.
+//   - Error: Unable to retrieve redundancy, version or module information.
+//
+// Examples:
+//   - name: VerifyActiveSupervisorConsistency
+//     VerifyActiveSupervisorConsistency:
+type VerifyActiveSupervisorConsistency struct {
+	test.BaseTest
+}
+
+func NewVerifyActiveSupervisorConsistency(inputs map[string]any) (test.Test, error) {
+	return &VerifyActiveSupervisorConsistency{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyActiveSupervisorConsistency",
+			TestDescription: "Verify active and standby supervisors run the same release and configuration",
+			TestCategories:  []string{"hardware", "redundancy"},
+		},
+	}, nil
+}
+
+func (t *VerifyActiveSupervisorConsistency) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	if skipResult := platform.SkipOnVirtualPlatforms(dev, t.Name(), t.Categories(), "supervisor redundancy is not applicable"); skipResult != nil {
+		return skipResult, nil
+	}
+
+	outputs := map[string]map[string]any{}
+	for _, template := range []string{"show redundancy status", "show version", "show module", "show redundancy file-replication"} {
+		cmdResult, err := dev.Execute(ctx, device.Command{Template: template, Format: "json", UseCache: false})
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get %s: %v", template, err)
+			return result, nil
 		}
+		data, err := test.AsMap(cmdResult.Output)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Unexpected %s output: %v", template, err)
+			return result, nil
+		}
+		outputs[template] = data
 
-		// Check redundancy state
-		if state, ok := redundancyData["redundancyState"].(string); ok {
-			if !strings.Contains(strings.ToLower(state), "active") {
-				result.Status = test.TestFailure
-				result.Message = fmt.Sprintf("Redundancy state: %s (expected: active)", state)
+		if template == "show redundancy status" {
+			switch peerState, _ := data["peerState"].(string); peerState {
+			case "", "notInserted":
+				result.Status = test.TestSkipped
+				result.Message = "Single supervisor chassis"
 				return result, nil
 			}
 		}
+	}
 
-		// Check supervisor status
-		if supervisors, ok := redundancyData["supervisors"].(map[string]any); ok {
-			activeSupervisors := 0
-			standbySupervisors := 0
-
-			for supName, supData := range supervisors {
-				if sup, ok := supData.(map[string]any); ok {
-					if state, ok := sup["state"].(string); ok {
-						switch strings.ToLower(state) {
-						case "active":
-							activeSupervisors++
-						case "standby", "standby-hot":
-							standbySupervisors++
-						default:
-							result.Status = test.TestFailure
-							result.Message = fmt.Sprintf("Supervisor %s: unexpected state %s", supName, state)
-							return result, nil
-						}
-					}
-				}
-			}
+	activeVersion, _ := outputs["show version"]["version"].(string)
+	standbyVersion := ""
+	modules, _ := outputs["show module"]["modules"].(map[string]any)
+	for _, moduleInfo := range modules {
+		module, ok := moduleInfo.(map[string]any)
+		if !ok {
+			continue
+		}
+		status, _ := module["status"].(string)
+		if strings.EqualFold(status, "standby") {
+			standbyVersion, _ = module["softwareVersion"].(string)
+			break
+		}
+	}
 
-			if activeSupervisors != 1 {
-				result.Status = test.TestFailure
-				result.Message = fmt.Sprintf("Expected 1 active supervisor, found %d", activeSupervisors)
-				return result, nil
-			}
+	issues := []string{}
+	switch {
+	case standbyVersion == "":
+		issues = append(issues, "standby supervisor version not reported")
+	case standbyVersion != activeVersion:
+		issues = append(issues, fmt.Sprintf("version skew: active runs %s, standby runs %s", activeVersion, standbyVersion))
+	}
 
-			if standbySupervisors == 0 {
-				result.Status = test.TestFailure
-				result.Message = "No standby supervisor found - redundancy not available"
-				return result, nil
-			}
+	unsynced := []string{}
+	files, _ := outputs["show redundancy file-replication"]["files"].(map[string]any)
+	for name, fileInfo := range files {
+		file, ok := fileInfo.(map[string]any)
+		if !ok {
+			continue
+		}
+		if status, _ := file["status"].(string); !strings.EqualFold(status, "synchronized") {
+			unsynced = append(unsynced, fmt.Sprintf("%s (%s)", name, status))
 		}
 	}
+	if len(unsynced) > 0 {
+		sort.Strings(unsynced)
+		issues = append(issues, fmt.Sprintf("files not synchronized to standby: %s", strings.Join(unsynced, ", ")))
+	}
+
+	result.Details = map[string]any{
+		"active_version":  activeVersion,
+		"standby_version": standbyVersion,
+	}
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Supervisor inconsistency: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Active and standby supervisors run %s with %d file(s) synchronized", activeVersion, len(files))
+	}
 
 	return result, nil
 }
 
-func (t *VerifySupervisorRedundancy) ValidateInput(input any) error {
-	// No specific validation required
+func (t *VerifyActiveSupervisorConsistency) ValidateInput(input any) error {
 	return nil
 }
 
@@ -0,0 +1,107 @@
+package hardware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifySupervisorRedundancy(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"healthy", map[string]any{"expected_protocol": "sso"}, `{
+			"configuredProtocol": "sso", "operationalProtocol": "sso",
+			"peerState": "standbyHot", "communicationDesc": "Up", "switchoverReady": true}`,
+			test.TestSuccess, "Redundancy protocol sso, standby supervisor standbyHot"},
+		{"standby not inserted", nil, `{
+			"configuredProtocol": "sso", "operationalProtocol": "sso",
+			"peerState": "notInserted", "communicationDesc": "Down", "switchoverReady": false}`,
+			test.TestFailure, "standby supervisor is notInserted; supervisor communication is Down; switchover is not ready"},
+		{"protocol fell back", nil, `{
+			"configuredProtocol": "sso", "operationalProtocol": "rpr",
+			"peerState": "standby", "communicationDesc": "Up", "switchoverReady": true}`,
+			test.TestFailure, "operational protocol rpr differs from configured sso"},
+		{"unexpected protocol", map[string]any{"expected_protocol": "sso"}, `{
+			"configuredProtocol": "rpr", "operationalProtocol": "rpr",
+			"peerState": "standby", "communicationDesc": "Up", "switchoverReady": true}`,
+			test.TestFailure, "configured protocol is rpr, expected sso"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifySupervisorRedundancy(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show redundancy status", tc.body)
+			dev.Model = "DCS-7508N"
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyActiveSupervisorConsistency(t *testing.T) {
+	const modules = `{"modules": {
+		"1": {"modelName": "DCS-7500-SUP2", "status": "active", "softwareVersion": "4.30.1F"},
+		"2": {"modelName": "DCS-7500-SUP2", "status": "standby", "softwareVersion": "%s"},
+		"3": {"modelName": "7500R3-36CQ-LC", "status": "ok", "softwareVersion": ""}}}`
+	const synced = `{"files": {"startup-config": {"status": "synchronized"}, "ssh-host-key": {"status": "synchronized"}}}`
+
+	cases := []struct {
+		name    string
+		peer    string
+		standby string
+		files   string
+		status  test.TestStatus
+		want    string
+	}{
+		{"consistent", "standby", "4.30.1F", synced, test.TestSuccess,
+			"Active and standby supervisors run 4.30.1F with 2 file(s) synchronized"},
+		{"version skew", "standbyHot", "4.31.2F", synced, test.TestFailure,
+			"version skew: active runs 4.30.1F, standby runs 4.31.2F"},
+		{"startup-config out of sync", "standby", "4.30.1F",
+			`{"files": {"startup-config": {"status": "pending"}}}`, test.TestFailure,
+			"files not synchronized to standby: startup-config (pending)"},
+		{"single supervisor", "notInserted", "", synced, test.TestSkipped, "Single supervisor chassis"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyActiveSupervisorConsistency(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show redundancy status", `{"peerState": "`+tc.peer+`"}`).
+				OnJSON(t, "show version", `{"version": "4.30.1F"}`).
+				OnJSON(t, "show module", strings.Replace(modules, "%s", tc.standby, 1)).
+				OnJSON(t, "show redundancy file-replication", tc.files)
+			dev.Model = "DCS-7508N"
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,395 @@
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/platform"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyRoutingTableResource verifies forwarding resource utilization from `show hardware capacity`.
+//
+// Every forwarding resource the ASIC reports (FIB/LEM, ECMP/FEC, ACL TCAM
+// banks, MAC table, ...) is compared against a utilization threshold. Full
+// tables fail silently in the data plane, so this catches them before they
+// drop routes or entries.
+//
+// The test performs the following checks:
+//  1. Retrieves every table entry from `show hardware capacity`.
+//  2. Resolves the threshold for each entry: a `thresholds` key matching
+//     "<table>-<feature>" first, then "<table>", then `max_utilization_pct`.
+//  3. Reports each resource whose used/max ratio exceeds its threshold.
+//
+// Expected Results:
+//   - Success: All forwarding resources are within their thresholds.
+//   - Failure: One or more resources exceed their utilization threshold.
+//   - Error: Unable to retrieve hardware capacity information.
+//   - Skipped: The device is a virtual platform.
+//
+// Example YAML configuration:
+//   - name: "VerifyRoutingTableResource"
+//     module: "hardware"
+//     inputs:
+//     max_utilization_pct: 80
+//     thresholds:
+//     LEM: 90
+//     TCAM-ACL: 70
+type VerifyRoutingTableResource struct {
+	test.BaseTest
+	MaxUtilizationPct int            `yaml:"max_utilization_pct,omitempty" json:"max_utilization_pct,omitempty"`
+	Thresholds        map[string]int `yaml:"thresholds,omitempty" json:"thresholds,omitempty"`
+}
+
+func NewVerifyRoutingTableResource(inputs map[string]any) (test.Test, error) {
+	t := &VerifyRoutingTableResource{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyRoutingTableResource",
+			TestDescription: "Verify forwarding table and TCAM utilization",
+			TestCategories:  []string{"hardware", "capacity"},
+		},
+		MaxUtilizationPct: 90,
+		Thresholds:        map[string]int{},
+	}
+
+	if err := test.GetInt(inputs, "max_utilization_pct", &t.MaxUtilizationPct); err != nil {
+		return nil, err
+	}
+
+	if inputs != nil {
+		if thresholds, ok := inputs["thresholds"].(map[string]any); ok {
+			for name := range thresholds {
+				var pct int
+				if err := test.GetInt(thresholds, name, &pct); err != nil {
+					return nil, fmt.Errorf("thresholds: %w", err)
+				}
+				t.Thresholds[name] = pct
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyRoutingTableResource) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	if skipResult := platform.SkipOnVirtualPlatforms(dev, t.Name(), t.Categories(), "hardware forwarding tables are not present"); skipResult != nil {
+		return skipResult, nil
+	}
+
+	cmd := device.Command{
+		Template: "show hardware capacity",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get hardware capacity: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected hardware capacity output: %v", err)
+		return result, nil
+	}
+
+	resources := parseCapacityTables(data)
+	if len(resources) == 0 {
+		result.Status = test.TestFailure
+		result.Message = "No hardware capacity tables reported"
+		return result, nil
+	}
+
+	issues := t.checkResources(resources)
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Forwarding resources over threshold: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d forwarding resources within utilization thresholds", len(resources))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyRoutingTableResource) ValidateInput(input any) error {
+	if t.MaxUtilizationPct < 0 || t.MaxUtilizationPct > 100 {
+		return fmt.Errorf("max_utilization_pct must be between 0 and 100")
+	}
+	for name, pct := range t.Thresholds {
+		if pct < 0 || pct > 100 {
+			return fmt.Errorf("thresholds.%s must be between 0 and 100", name)
+		}
+	}
+	return nil
+}
+
+// capacityResource is one row of `show hardware capacity`.
+type capacityResource struct {
+	Table   string
+	Feature string
+	Chip    string
+	Used    int
+	Max     int
+}
+
+// Name returns the label used in messages and threshold lookups:
+// "<table>" or "<table>-<feature>".
+func (r capacityResource) Name() string {
+	if r.Feature == "" {
+		return r.Table
+	}
+	return r.Table + "-" + r.Feature
+}
+
+func parseCapacityTables(data map[string]any) []capacityResource {
+	tables, _ := data["tables"].([]any)
+	resources := make([]capacityResource, 0, len(tables))
+	for _, item := range tables {
+		entry, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		r := capacityResource{
+			Table:   strFromMap(entry, "table"),
+			Feature: strFromMap(entry, "feature"),
+			Chip:    strFromMap(entry, "chip"),
+		}
+		if used, ok := entry["used"].(float64); ok {
+			r.Used = int(used)
+		}
+		if maxLimit, ok := entry["maxLimit"].(float64); ok {
+			r.Max = int(maxLimit)
+		}
+		if r.Table == "" || r.Max <= 0 {
+			continue
+		}
+		resources = append(resources, r)
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].Name() < resources[j].Name()
+	})
+	return resources
+}
+
+func (t *VerifyRoutingTableResource) threshold(r capacityResource) int {
+	if pct, ok := t.Thresholds[r.Name()]; ok {
+		return pct
+	}
+	if pct, ok := t.Thresholds[r.Table]; ok {
+		return pct
+	}
+	return t.MaxUtilizationPct
+}
+
+func (t *VerifyRoutingTableResource) checkResources(resources []capacityResource) []string {
+	issues := []string{}
+	for _, r := range resources {
+		limit := t.threshold(r)
+		pct := float64(r.Used) * 100 / float64(r.Max)
+		if pct <= float64(limit) {
+			continue
+		}
+		name := r.Name()
+		if r.Chip != "" {
+			name = fmt.Sprintf("%s (%s)", name, r.Chip)
+		}
+		issues = append(issues, fmt.Sprintf("%s %d/%d (%.1f%% > %d%%)", name, r.Used, r.Max, pct, limit))
+	}
+	return issues
+}
+
+// VerifyLinecardResources verifies per-linecard forwarding resource utilization on modular chassis.
+//
+// On a modular system every linecard has its own NPUs, and each keeps its
+// own copy of the host table, LPM and the other on-chip memories. One
+// linecard filling up drops traffic only on its own ports, which averages
+// away in chassis-wide numbers. The test performs the following checks:
+//  1. Retrieves every table entry from `show hardware capacity`.
+//  2. Attributes each entry to a linecard by its chip name, which on
+//     modular systems carries the slot, e.g. "Jericho3/0" for the first
+//     chip on linecard 3. Entries without a slot are not per-linecard.
+//  3. Reports each resource whose used/max ratio on a linecard in scope
+//     exceeds `max_utilization`.
+//
+// Expected Results:
+//   - Success: Every resource on every linecard in scope is within the threshold.
+//   - Failure: A resource exceeds the threshold, or a linecard in scope reports no resources.
+//   - Error: Unable to retrieve hardware capacity information.
+//   - Skipped: The device is a virtual platform or not a modular chassis.
+//
+// Example YAML configuration:
+//   - name: "VerifyLinecardResources"
+//     module: "hardware"
+//     inputs:
+//     max_utilization: 80
+//     linecards: ["Linecard3", "Linecard4"]
+type VerifyLinecardResources struct {
+	test.BaseTest
+	MaxUtilization int      `yaml:"max_utilization,omitempty" json:"max_utilization,omitempty"`
+	Linecards      []string `yaml:"linecards,omitempty" json:"linecards,omitempty"`
+}
+
+func NewVerifyLinecardResources(inputs map[string]any) (test.Test, error) {
+	t := &VerifyLinecardResources{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyLinecardResources",
+			TestDescription: "Verify per-linecard NPU memory and host table utilization",
+			TestCategories:  []string{"hardware", "capacity"},
+		},
+		MaxUtilization: 90,
+	}
+
+	if err := test.GetInt(inputs, "max_utilization", &t.MaxUtilization); err != nil {
+		return nil, err
+	}
+	if err := test.GetStringSlice(inputs, "linecards", &t.Linecards); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyLinecardResources) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	if skipResult := platform.SkipOnVirtualPlatforms(dev, t.Name(), t.Categories(), "hardware forwarding tables are not present"); skipResult != nil {
+		return skipResult, nil
+	}
+
+	cmd := device.Command{
+		Template: "show hardware capacity",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get hardware capacity: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected hardware capacity output: %v", err)
+		return result, nil
+	}
+
+	byLinecard := map[string][]capacityResource{}
+	for _, r := range parseCapacityTables(data) {
+		if lc := capacityLinecard(r.Chip); lc != "" {
+			byLinecard[lc] = append(byLinecard[lc], r)
+		}
+	}
+	if len(byLinecard) == 0 {
+		result.Status = test.TestSkipped
+		result.Message = "No per-linecard resources reported; not a modular chassis"
+		return result, nil
+	}
+
+	scope := t.Linecards
+	if len(scope) == 0 {
+		for lc := range byLinecard {
+			scope = append(scope, lc)
+		}
+		sort.Strings(scope)
+	}
+
+	rows := []map[string]any{}
+	issues := []string{}
+	for _, want := range scope {
+		lc := canonicalLinecard(want)
+		resources := byLinecard[lc]
+		if len(resources) == 0 {
+			issues = append(issues, fmt.Sprintf("%s: no resources reported", want))
+			continue
+		}
+		for _, r := range resources {
+			pct := float64(r.Used) * 100 / float64(r.Max)
+			rows = append(rows, map[string]any{
+				"linecard":        lc,
+				"chip":            r.Chip,
+				"resource":        r.Name(),
+				"used":            r.Used,
+				"max":             r.Max,
+				"utilization_pct": pct,
+			})
+			if pct > float64(t.MaxUtilization) {
+				issues = append(issues, fmt.Sprintf("%s %s (%s) %d/%d (%.1f%% > %d%%)",
+					lc, r.Name(), r.Chip, r.Used, r.Max, pct, t.MaxUtilization))
+			}
+		}
+	}
+	result.Details = map[string]any{"linecard_resources": rows}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Linecard resources over threshold: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All resources on %d linecards within %d%% utilization", len(scope), t.MaxUtilization)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyLinecardResources) ValidateInput(input any) error {
+	if t.MaxUtilization < 0 || t.MaxUtilization > 100 {
+		return fmt.Errorf("max_utilization must be between 0 and 100")
+	}
+	for i, lc := range t.Linecards {
+		if canonicalLinecard(lc) == "" {
+			return fmt.Errorf("linecards[%d]: %q is not a linecard name like \"Linecard3\"", i, lc)
+		}
+	}
+	return nil
+}
+
+// capacityLinecard returns the linecard a chip sits on, "Linecard3" for
+// "Jericho3/0", or "" for a chip name without a slot.
+func capacityLinecard(chip string) string {
+	i := strings.LastIndexByte(chip, '/')
+	if i <= 0 {
+		return ""
+	}
+	j := i
+	for j > 0 && chip[j-1] >= '0' && chip[j-1] <= '9' {
+		j--
+	}
+	if j == i {
+		return ""
+	}
+	return "Linecard" + chip[j:i]
+}
+
+// canonicalLinecard normalises a linecard name from the inputs, accepting
+// any case and a bare slot number, or returns "" when it is neither.
+func canonicalLinecard(name string) string {
+	slot := name
+	if len(name) > len("linecard") && strings.EqualFold(name[:len("linecard")], "linecard") {
+		slot = name[len("linecard"):]
+	}
+	if _, err := strconv.Atoi(slot); err != nil {
+		return ""
+	}
+	return "Linecard" + slot
+}
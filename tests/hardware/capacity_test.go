@@ -0,0 +1,132 @@
+package hardware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestRoutingTableResource_OverThresholdTcamBank(t *testing.T) {
+	// Shape of `show hardware capacity`: a flat list of table rows, one per
+	// table/feature/chip combination.
+	data := map[string]any{
+		"tables": []any{
+			map[string]any{"table": "LEM", "feature": "", "chip": "", "used": float64(40000), "maxLimit": float64(98304)},
+			map[string]any{"table": "TCAM", "feature": "ACL", "chip": "Jericho0", "used": float64(950), "maxLimit": float64(1024)},
+			map[string]any{"table": "TCAM", "feature": "QOS", "chip": "Jericho0", "used": float64(100), "maxLimit": float64(1024)},
+			map[string]any{"table": "FEC", "feature": "", "chip": "", "used": float64(0), "maxLimit": float64(0)},
+		},
+	}
+
+	resources := parseCapacityTables(data)
+	if len(resources) != 3 {
+		t.Fatalf("expected 3 resources (zero-max rows skipped), got %d: %+v", len(resources), resources)
+	}
+
+	tst := &VerifyRoutingTableResource{MaxUtilizationPct: 90, Thresholds: map[string]int{}}
+	issues := tst.checkResources(resources)
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %v", issues)
+	}
+	if want := "TCAM-ACL (Jericho0) 950/1024"; !strings.Contains(issues[0], want) {
+		t.Errorf("issue %q should contain %q", issues[0], want)
+	}
+}
+
+func TestRoutingTableResource_PerResourceThresholds(t *testing.T) {
+	resources := []capacityResource{
+		{Table: "LEM", Used: 60, Max: 100},
+		{Table: "TCAM", Feature: "ACL", Used: 60, Max: 100},
+		{Table: "TCAM", Feature: "QOS", Used: 60, Max: 100},
+	}
+
+	// Feature-specific key beats the table key, which beats the default.
+	tst := &VerifyRoutingTableResource{
+		MaxUtilizationPct: 50,
+		Thresholds:        map[string]int{"TCAM": 70, "TCAM-ACL": 55},
+	}
+	issues := tst.checkResources(resources)
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %v", issues)
+	}
+	if !strings.HasPrefix(issues[0], "LEM 60/100") || !strings.HasPrefix(issues[1], "TCAM-ACL 60/100") {
+		t.Errorf("unexpected issues: %v", issues)
+	}
+}
+
+func TestNewVerifyRoutingTableResource_Inputs(t *testing.T) {
+	tst, err := NewVerifyRoutingTableResource(map[string]any{
+		"max_utilization_pct": 80,
+		"thresholds":          map[string]any{"LEM": 95},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := tst.(*VerifyRoutingTableResource)
+	if v.MaxUtilizationPct != 80 || v.Thresholds["LEM"] != 95 {
+		t.Errorf("inputs not parsed: %+v", v)
+	}
+
+	v.Thresholds["TCAM"] = 120
+	if err := v.ValidateInput(nil); err == nil {
+		t.Error("expected threshold above 100 to be rejected")
+	}
+}
+
+func TestVerifyLinecardResources(t *testing.T) {
+	const capacity = `{"tables": [
+  {"table": "LEM", "feature": "", "chip": "Jericho3/0", "used": 70000, "maxLimit": 98304},
+  {"table": "Routing", "feature": "Host", "chip": "Jericho3/1", "used": 120000, "maxLimit": 131072},
+  {"table": "LEM", "feature": "", "chip": "Jericho4/0", "used": 20000, "maxLimit": 98304},
+  {"table": "FEC", "feature": "", "chip": "", "used": 10, "maxLimit": 4096}
+]}`
+	const fixed = `{"tables": [{"table": "LEM", "feature": "", "chip": "Jericho0", "used": 1, "maxLimit": 98304}]}`
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"linecard over threshold", nil, capacity, test.TestFailure,
+			"Linecard3 Routing-Host (Jericho3/1) 120000/131072 (91.6% > 90%)"},
+		{"scoped to a healthy linecard", map[string]any{"linecards": []any{"linecard4"}}, capacity, test.TestSuccess,
+			"All resources on 1 linecards within 90% utilization"},
+		{"lower threshold", map[string]any{"max_utilization": 70, "linecards": []any{"3"}}, capacity, test.TestFailure,
+			"Linecard3 LEM (Jericho3/0) 70000/98304 (71.2% > 70%)"},
+		{"linecard in scope missing", map[string]any{"linecards": []any{"Linecard5"}}, capacity, test.TestFailure,
+			"Linecard5: no resources reported"},
+		{"fixed system", nil, fixed, test.TestSkipped, "not a modular chassis"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyLinecardResources(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show hardware capacity", tc.body)
+			dev.Model = "DCS-7508N"
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyLinecardResources(map[string]any{"linecards": []any{"Supervisor1"}})
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected a non-linecard name to be rejected")
+	}
+}
@@ -44,6 +44,7 @@ func NewVerifyEnvironmentSystemCooling(inputs map[string]any) (test.Test, error)
 			TestName:        "VerifyEnvironmentSystemCooling",
 			TestDescription: "Verify device system cooling status",
 			TestCategories:  []string{"hardware", "environmental"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
@@ -113,10 +114,12 @@ func (t *VerifyEnvironmentSystemCooling) ValidateInput(input any) error {
 //  2. Validates that all fans are operational and within speed tolerances.
 //  3. Checks for any fan failures or performance warnings.
 //  4. Optionally validates fan speeds against expected ranges.
+//  5. If `max_speed_pct` is set, flags fans running above it; a fan pinned
+//     near 100% usually means the chassis is fighting a thermal problem.
 //
 // Expected Results:
 //   - Success: All fans are operational and within acceptable speed ranges.
-//   - Failure: One or more fans are failed, warning, or operating outside expected parameters.
+//   - Failure: One or more fans are failed, warning, running hot, or operating outside expected parameters.
 //   - Error: Unable to retrieve fan status or performance data.
 //
 // Examples:
@@ -128,10 +131,15 @@ func (t *VerifyEnvironmentSystemCooling) ValidateInput(input any) error {
 //     VerifyEnvironmentCooling:
 //     check_fan_speed: true
 //     min_fan_speed_pct: 30  # Minimum acceptable fan speed percentage
+//
+//   - name: VerifyEnvironmentCooling with hot-fan detection
+//     VerifyEnvironmentCooling:
+//     max_speed_pct: 90  # Fail when any fan runs above 90%
 type VerifyEnvironmentCooling struct {
 	test.BaseTest
 	CheckFanSpeed  bool `yaml:"check_fan_speed,omitempty" json:"check_fan_speed,omitempty"`
 	MinFanSpeedPct int  `yaml:"min_fan_speed_pct,omitempty" json:"min_fan_speed_pct,omitempty"`
+	MaxSpeedPct    int  `yaml:"max_speed_pct,omitempty" json:"max_speed_pct,omitempty"`
 }
 
 func NewVerifyEnvironmentCooling(inputs map[string]any) (test.Test, error) {
@@ -140,6 +148,7 @@ func NewVerifyEnvironmentCooling(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyEnvironmentCooling",
 			TestDescription: "Verify status of power supply fans and fan trays",
 			TestCategories:  []string{"hardware", "environmental"},
+			TestSeverity:    test.SeverityCritical,
 		},
 		CheckFanSpeed:  false,
 		MinFanSpeedPct: 30, // Default minimum 30%
@@ -154,6 +163,11 @@ func NewVerifyEnvironmentCooling(inputs map[string]any) (test.Test, error) {
 		} else if minSpeed, ok := inputs["min_fan_speed_pct"].(int); ok {
 			t.MinFanSpeedPct = minSpeed
 		}
+		if maxSpeed, ok := inputs["max_speed_pct"].(float64); ok {
+			t.MaxSpeedPct = int(maxSpeed)
+		} else if maxSpeed, ok := inputs["max_speed_pct"].(int); ok {
+			t.MaxSpeedPct = maxSpeed
+		}
 	}
 
 	return t, nil
@@ -329,9 +343,16 @@ func (t *VerifyEnvironmentCooling) checkFanStatus(fanName string, fanData map[st
 		}
 	}
 
+	speedPct, hasSpeedPct := fanSpeedPct(fanData)
+
+	// A fan above max_speed_pct is running hot regardless of check_fan_speed.
+	if t.MaxSpeedPct > 0 && hasSpeedPct && int(speedPct) > t.MaxSpeedPct {
+		*issues = append(*issues, fmt.Sprintf("%s: speed %.0f%% above maximum %d%%", fanName, speedPct, t.MaxSpeedPct))
+	}
+
 	// Check fan speed if requested
 	if t.CheckFanSpeed {
-		if speedPct, ok := fanData["speedPercent"].(float64); ok {
+		if hasSpeedPct {
 			if int(speedPct) < t.MinFanSpeedPct {
 				*issues = append(*issues, fmt.Sprintf("%s: speed %.0f%% below minimum %d%%", fanName, speedPct, t.MinFanSpeedPct))
 			}
@@ -344,10 +365,25 @@ func (t *VerifyEnvironmentCooling) checkFanStatus(fanName string, fanData map[st
 	}
 }
 
+// fanSpeedPct returns the fan's current speed in percent. EOS reports it
+// as `actualSpeed`; some variants use `speedPercent`.
+func fanSpeedPct(fanData map[string]any) (float64, bool) {
+	if v, ok := fanData["actualSpeed"].(float64); ok {
+		return v, true
+	}
+	if v, ok := fanData["speedPercent"].(float64); ok {
+		return v, true
+	}
+	return 0, false
+}
+
 func (t *VerifyEnvironmentCooling) ValidateInput(input any) error {
 	if t.MinFanSpeedPct < 0 || t.MinFanSpeedPct > 100 {
 		return fmt.Errorf("minimum fan speed percentage must be between 0 and 100")
 	}
+	if t.MaxSpeedPct < 0 || t.MaxSpeedPct > 100 {
+		return fmt.Errorf("maximum fan speed percentage must be between 0 and 100")
+	}
 	return nil
 }
 
@@ -391,6 +427,7 @@ func NewVerifyEnvironmentPower(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyEnvironmentPower",
 			TestDescription: "Verify power supplies state and input voltage",
 			TestCategories:  []string{"hardware", "environmental"},
+			TestSeverity:    test.SeverityCritical,
 		},
 		CheckVoltage:    false,
 		MinInputVoltage: 100.0, // Default minimum 100V
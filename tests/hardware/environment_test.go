@@ -255,3 +255,29 @@ func TestCoolingParse_MapShape(t *testing.T) {
 		t.Errorf("status should be 'ok', got %q", fans[0].Status)
 	}
 }
+
+func TestCoolingCheck_FanAboveMaxSpeed(t *testing.T) {
+	tray := map[string]any{
+		"status": "ok",
+		"fans": []any{
+			map[string]any{"label": "1/1", "status": "ok", "actualSpeed": float64(98), "configuredSpeed": float64(98)},
+			map[string]any{"label": "1/2", "status": "ok", "actualSpeed": float64(45), "configuredSpeed": float64(45)},
+		},
+	}
+
+	var fans []FanReport
+	var issues []string
+	t1 := &VerifyEnvironmentCooling{MaxSpeedPct: 90}
+	t1.collectContainerFans("FanTraySlot/1", tray, &fans, &issues)
+
+	if len(issues) != 1 || issues[0] != "1/1: speed 98% above maximum 90%" {
+		t.Errorf("expected one hot-fan issue for 1/1, got %v", issues)
+	}
+
+	// Without max_speed_pct the same fans pass.
+	issues = nil
+	(&VerifyEnvironmentCooling{}).collectContainerFans("FanTraySlot/1", tray, &fans, &issues)
+	if len(issues) != 0 {
+		t.Errorf("expected no issues without max_speed_pct, got %v", issues)
+	}
+}
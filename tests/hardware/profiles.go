@@ -17,7 +17,8 @@ import (
 //
 // Expected Results:
 //   - Success: The test will pass if the device UFT mode matches the expected mode configuration.
-//   - Failure: The test will fail if the UFT mode differs from the expected configuration.
+//   - Failure: The test will fail if the UFT mode differs from the expected configuration, or if a
+//     different mode is configured and waiting for a reload to take effect.
 //   - Error: The test will report an error if UFT mode information cannot be retrieved or parsed.
 //
 // Examples:
@@ -82,48 +83,75 @@ func (t *VerifyUnifiedForwardingTableMode) Execute(ctx context.Context, dev devi
 		return result, nil
 	}
 
-	// Parse the UFT mode from the output
-	var actualMode any
-	if data, ok := cmdResult.Output.(map[string]any); ok {
+	actualMode, configuredMode := parseUFTMode(cmdResult.Output)
+	if actualMode == nil {
+		result.Status = test.TestError
+		result.Message = "UFT mode information not found in device output"
+		return result, nil
+	}
+
+	if issue := t.uftModeIssue(actualMode, configuredMode); issue != "" {
+		result.Status = test.TestFailure
+		result.Message = issue
+	} else {
+		result.Message = fmt.Sprintf("UFT mode %v is active", actualMode)
+	}
+
+	return result, nil
+}
+
+// uftModeIssue compares the active mode with the expected one. A configured
+// mode that differs from the active one only takes effect after a reload,
+// so it is reported alongside the comparison. Returns "" when healthy.
+func (t *VerifyUnifiedForwardingTableMode) uftModeIssue(actual, configured any) string {
+	reloadPending := configured != nil && !t.modesEqual(configured, actual)
+
+	// Compare the modes (handle both numeric and string cases)
+	switch {
+	case !t.modesEqual(actual, t.Mode) && reloadPending:
+		return fmt.Sprintf("UFT mode mismatch: expected %v, got %v (mode %v configured, reload pending)", t.Mode, actual, configured)
+	case !t.modesEqual(actual, t.Mode):
+		return fmt.Sprintf("UFT mode mismatch: expected %v, got %v", t.Mode, actual)
+	case reloadPending:
+		return fmt.Sprintf("UFT mode %v is active but mode %v is configured; the next reload will change it", actual, configured)
+	}
+	return ""
+}
+
+// parseUFTMode extracts the active UFT mode and, when the device reports
+// one, the configured mode that applies after the next reload. Current EOS
+// reports uftMode/configuredUftMode at the top level; older releases used a
+// "mode" key, either at the root or under "partitions".
+func parseUFTMode(output any) (active, configured any) {
+	data, ok := output.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	if mode, exists := data["uftMode"]; exists {
+		active = mode
+	}
+	if active == nil {
 		if partitions, ok := data["partitions"].(map[string]any); ok {
-			// Look for the mode in the partitions data
-			// This may vary based on EOS version, so we'll look for common keys
 			for key, value := range partitions {
 				if strings.Contains(strings.ToLower(key), "mode") {
-					actualMode = value
+					active = value
 					break
 				}
 			}
-
-			// If mode not found in partitions, check if there's a direct mode field
-			if actualMode == nil {
-				if mode, exists := data["mode"]; exists {
-					actualMode = mode
-				}
-			}
-		}
-
-		// Fallback: check for mode directly in root
-		if actualMode == nil {
-			if mode, exists := data["mode"]; exists {
-				actualMode = mode
-			}
 		}
 	}
-
-	if actualMode == nil {
-		result.Status = test.TestError
-		result.Message = "UFT mode information not found in device output"
-		return result, nil
+	if active == nil {
+		if mode, exists := data["mode"]; exists {
+			active = mode
+		}
 	}
 
-	// Compare the modes (handle both numeric and string cases)
-	if !t.modesEqual(actualMode, t.Mode) {
-		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("UFT mode mismatch: expected %v, got %v", t.Mode, actualMode)
+	if mode, exists := data["configuredUftMode"]; exists {
+		configured = mode
 	}
 
-	return result, nil
+	return active, configured
 }
 
 func (t *VerifyUnifiedForwardingTableMode) modesEqual(actual, expected any) bool {
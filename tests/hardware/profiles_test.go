@@ -0,0 +1,53 @@
+package hardware
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUFTMode_Mismatch(t *testing.T) {
+	// Shape of `show platform trident forwarding-table partition`.
+	active, configured := parseUFTMode(map[string]any{
+		"uftMode":           "0",
+		"configuredUftMode": "0",
+		"l2TableSize":       float64(288000),
+		"l3HostTableSize":   float64(16000),
+		"lpmTableSize":      float64(16000),
+	})
+	if active != "0" || configured != "0" {
+		t.Fatalf("parseUFTMode = %v, %v; want 0, 0", active, configured)
+	}
+
+	tst := &VerifyUnifiedForwardingTableMode{Mode: 2}
+	issue := tst.uftModeIssue(active, configured)
+	if want := "UFT mode mismatch: expected 2, got 0"; issue != want {
+		t.Errorf("issue = %q, want %q", issue, want)
+	}
+}
+
+func TestUFTMode_ReloadPending(t *testing.T) {
+	tst := &VerifyUnifiedForwardingTableMode{Mode: 2}
+
+	// Correct mode configured but not yet applied.
+	issue := tst.uftModeIssue("0", "2")
+	if !strings.Contains(issue, "expected 2, got 0 (mode 2 configured, reload pending)") {
+		t.Errorf("issue = %q, want reload-pending mismatch", issue)
+	}
+
+	// Correct mode active, but a reload would move away from it.
+	issue = tst.uftModeIssue(float64(2), "3")
+	if !strings.Contains(issue, "UFT mode 2 is active but mode 3 is configured") {
+		t.Errorf("issue = %q, want pending change warning", issue)
+	}
+
+	if issue := tst.uftModeIssue("2", "2"); issue != "" {
+		t.Errorf("issue = %q, want none", issue)
+	}
+}
+
+func TestUFTMode_LegacyModeKey(t *testing.T) {
+	active, configured := parseUFTMode(map[string]any{"mode": float64(1)})
+	if active != float64(1) || configured != nil {
+		t.Errorf("parseUFTMode = %v, %v; want 1, nil", active, configured)
+	}
+}
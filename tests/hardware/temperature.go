@@ -41,6 +41,7 @@ func NewVerifyTemperature(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyTemperature",
 			TestDescription: "Verify system temperature is within acceptable range",
 			TestCategories:  []string{"hardware", "environmental"},
+			TestSeverity:    test.SeverityCritical,
 		},
 		CheckTempSensors: true,
 		FailureMargin:    5.0,
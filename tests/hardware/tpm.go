@@ -0,0 +1,126 @@
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/platform"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyTpmStatus verifies the TPM and secure boot are healthy.
+//
+// Supply-chain compliance baselines require the boot chain to be measured
+// and verified, which depends on both a working TPM and secure boot being
+// enforced. The test checks:
+//  1. `show tpm status` reports a TPM that is present, enabled and activated.
+//  2. `show secure-boot` reports secure boot as enabled.
+//
+// Expected Results:
+//   - Success: The TPM is functioning and secure boot is enabled.
+//   - Failure: The TPM is missing, disabled or not activated, or secure boot is disabled.
+//   - Error: Either status cannot be retrieved or does not report the expected fields.
+//   - Skipped: The device is a virtual platform without a TPM.
+//
+// Examples:
+//   - name: VerifyTpmStatus
+//     VerifyTpmStatus: {}
+type VerifyTpmStatus struct {
+	test.BaseTest
+}
+
+func NewVerifyTpmStatus(inputs map[string]any) (test.Test, error) {
+	t := &VerifyTpmStatus{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyTpmStatus",
+			TestDescription: "Verify the TPM is functioning and secure boot is enabled",
+			TestCategories:  []string{"hardware", "security"},
+		},
+	}
+
+	return t, nil
+}
+
+func (t *VerifyTpmStatus) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	// Skip on virtual/lab platforms, which have no TPM to report on
+	if skipResult := platform.SkipOnVirtualPlatforms(dev, t.Name(), t.Categories(), "TPM and secure boot are not present"); skipResult != nil {
+		return skipResult, nil
+	}
+
+	tpm, err := showStatus(ctx, dev, "show tpm status")
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get TPM status: %v", err)
+		return result, nil
+	}
+	secureBoot, err := showStatus(ctx, dev, "show secure-boot")
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get secure boot status: %v", err)
+		return result, nil
+	}
+
+	present, hasPresent := tpm["tpmPresent"].(bool)
+	enabled, hasEnabled := tpm["tpmEnabled"].(bool)
+	activated, hasActivated := tpm["tpmActivated"].(bool)
+	secureBootEnabled, hasSecureBoot := secureBoot["enabled"].(bool)
+	if !hasPresent || !hasSecureBoot {
+		result.Status = test.TestError
+		result.Message = "Could not determine TPM and secure boot status from device response"
+		return result, nil
+	}
+
+	issues := []string{}
+	switch {
+	case !present:
+		issues = append(issues, "no TPM present")
+	case hasEnabled && !enabled:
+		issues = append(issues, "TPM is disabled")
+	case hasActivated && !activated:
+		issues = append(issues, "TPM is not activated")
+	}
+	if !secureBootEnabled {
+		issues = append(issues, "secure boot is disabled")
+	}
+
+	result.Details = map[string]any{
+		"tpm_present":         present,
+		"tpm_enabled":         enabled,
+		"tpm_activated":       activated,
+		"secure_boot_enabled": secureBootEnabled,
+	}
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Boot integrity not enforced: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = "TPM is functioning and secure boot is enabled"
+	}
+
+	return result, nil
+}
+
+func (t *VerifyTpmStatus) ValidateInput(input any) error {
+	return nil
+}
+
+// showStatus runs a JSON show command and returns its top-level object.
+func showStatus(ctx context.Context, dev device.Device, command string) (map[string]any, error) {
+	cmdResult, err := dev.Execute(ctx, device.Command{Template: command, Format: "json", UseCache: false})
+	if err != nil {
+		return nil, err
+	}
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected output: %w", err)
+	}
+	return data, nil
+}
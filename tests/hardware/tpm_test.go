@@ -0,0 +1,52 @@
+package hardware
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyTpmStatus(t *testing.T) {
+	const healthyTPM = `{"tpmPresent": true, "tpmEnabled": true, "tpmActivated": true}`
+	cases := []struct {
+		name       string
+		tpm        string
+		secureBoot string
+		status     test.TestStatus
+		want       string
+	}{
+		{"healthy", healthyTPM, `{"enabled": true}`, test.TestSuccess, "TPM is functioning and secure boot is enabled"},
+		{"secure boot disabled", healthyTPM, `{"enabled": false}`, test.TestFailure,
+			"Boot integrity not enforced: secure boot is disabled"},
+		{"tpm not activated", `{"tpmPresent": true, "tpmEnabled": true, "tpmActivated": false}`, `{"enabled": true}`,
+			test.TestFailure, "TPM is not activated"},
+		{"no tpm and secure boot off", `{"tpmPresent": false}`, `{"enabled": false}`, test.TestFailure,
+			"no TPM present; secure boot is disabled"},
+		{"unknown shape", healthyTPM, `{}`, test.TestError, "Could not determine"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyTpmStatus(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show tpm status", tc.tpm).
+				OnJSON(t, "show secure-boot", tc.secureBoot)
+			dev.Model = "DCS-7280CR3-32P4"
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -367,6 +367,7 @@ func NewVerifyTransceiversManufacturers(inputs map[string]any) (test.Test, error
 			TestName:        "VerifyTransceiversManufacturers",
 			TestDescription: "Verify all transceivers come from approved manufacturers",
 			TestCategories:  []string{"hardware", "optics"},
+			TestSeverity:    test.SeverityMinor,
 		},
 	}
 
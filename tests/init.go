@@ -1,193 +1,295 @@
 package tests
 
 import (
+	"errors"
+
 	"github.com/fluidstackio/go-anta/pkg/test"
 	"github.com/fluidstackio/go-anta/tests/connectivity"
+	"github.com/fluidstackio/go-anta/tests/cvx"
 	"github.com/fluidstackio/go-anta/tests/evpn"
+	"github.com/fluidstackio/go-anta/tests/flowtracking"
+	"github.com/fluidstackio/go-anta/tests/generic"
 	"github.com/fluidstackio/go-anta/tests/hardware"
 	"github.com/fluidstackio/go-anta/tests/interfaces"
+	"github.com/fluidstackio/go-anta/tests/l3"
+	"github.com/fluidstackio/go-anta/tests/lanz"
 	"github.com/fluidstackio/go-anta/tests/logging"
+	"github.com/fluidstackio/go-anta/tests/multicast"
+	"github.com/fluidstackio/go-anta/tests/qos"
 	"github.com/fluidstackio/go-anta/tests/routing"
 	"github.com/fluidstackio/go-anta/tests/security"
 	"github.com/fluidstackio/go-anta/tests/services"
+	"github.com/fluidstackio/go-anta/tests/sflow"
 	"github.com/fluidstackio/go-anta/tests/software"
 	"github.com/fluidstackio/go-anta/tests/stp"
+	"github.com/fluidstackio/go-anta/tests/stun"
 	"github.com/fluidstackio/go-anta/tests/system"
 	"github.com/fluidstackio/go-anta/tests/vlan"
 	"github.com/fluidstackio/go-anta/tests/vxlan"
 )
 
 func init() {
-	RegisterAllTests()
+	if err := RegisterAllTests(test.GetRegistry()); err != nil {
+		panic(err)
+	}
 }
 
-func RegisterAllTests() {
-	registry := test.GetRegistry()
+// RegisterAllTests registers every built-in test with registry and
+// returns the joined Register errors, so a duplicate or empty name
+// surfaces instead of being dropped.
+func RegisterAllTests(registry *test.Registry) error {
+	var errs []error
+	register := func(module, name string, factory test.TestFactory) {
+		if err := registry.Register(module, name, factory); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	register("connectivity", "VerifyReachability", connectivity.NewVerifyReachability)
+	register("connectivity", "VerifyTraceroute", connectivity.NewVerifyTraceroute)
+	register("connectivity", "VerifyLLDPNeighbors", connectivity.NewVerifyLLDPNeighbors)
+	register("connectivity", "VerifyLLDPSystemCapabilities", connectivity.NewVerifyLLDPSystemCapabilities)
+	register("connectivity", "VerifyCDPNeighbors", connectivity.NewVerifyCDPNeighbors)
+	register("connectivity", "VerifyMgmtInterface", connectivity.NewVerifyMgmtInterface)
 
-	_ = registry.Register("connectivity", "VerifyReachability", connectivity.NewVerifyReachability)
-	_ = registry.Register("connectivity", "VerifyTraceroute", connectivity.NewVerifyTraceroute)
-	_ = registry.Register("connectivity", "VerifyLLDPNeighbors", connectivity.NewVerifyLLDPNeighbors)
+	// CVX Tests
+	register("cvx", "VerifyCVXStatus", cvx.NewVerifyCVXStatus)
 
 	// EVPN Tests
-	_ = registry.Register("evpn", "VerifyEVPNType5Routes", evpn.NewVerifyEVPNType5Routes)
+	register("evpn", "VerifyEVPNType5Routes", evpn.NewVerifyEVPNType5Routes)
+
+	// Flow Tracking Tests
+	register("flow_tracking", "VerifyHardwareFlowTrackerStatus", flowtracking.NewVerifyHardwareFlowTrackerStatus)
+
+	// Generic Tests
+	register("generic", "VerifyShowCommand", generic.NewVerifyShowCommand)
 
 	// Hardware Tests - All hardware tests from ANTA Python implementation
-	_ = registry.Register("hardware", "VerifyTemperature", hardware.NewVerifyTemperature)
-	_ = registry.Register("hardware", "VerifyTransceivers", hardware.NewVerifyTransceivers)
-	_ = registry.Register("hardware", "VerifyTransceiversManufacturers", hardware.NewVerifyTransceiversManufacturers)
-	_ = registry.Register("hardware", "VerifyTransceiversTemperature", hardware.NewVerifyTransceiversTemperature)
-	_ = registry.Register("hardware", "VerifyInventory", hardware.NewVerifyInventory)
-	_ = registry.Register("hardware", "VerifyUnifiedForwardingTableMode", hardware.NewVerifyUnifiedForwardingTableMode)
-	_ = registry.Register("hardware", "VerifyTcamProfile", hardware.NewVerifyTcamProfile)
+	register("hardware", "VerifyTemperature", hardware.NewVerifyTemperature)
+	register("hardware", "VerifyTransceivers", hardware.NewVerifyTransceivers)
+	register("hardware", "VerifyTransceiversManufacturers", hardware.NewVerifyTransceiversManufacturers)
+	register("hardware", "VerifyTransceiversTemperature", hardware.NewVerifyTransceiversTemperature)
+	register("hardware", "VerifyInventory", hardware.NewVerifyInventory)
+	register("hardware", "VerifyUnifiedForwardingTableMode", hardware.NewVerifyUnifiedForwardingTableMode)
+	register("hardware", "VerifyTcamProfile", hardware.NewVerifyTcamProfile)
 
 	// Environment Tests
-	_ = registry.Register("hardware", "VerifyEnvironmentSystemCooling", hardware.NewVerifyEnvironmentSystemCooling)
-	_ = registry.Register("hardware", "VerifyEnvironmentCooling", hardware.NewVerifyEnvironmentCooling)
-	_ = registry.Register("hardware", "VerifyEnvironmentPower", hardware.NewVerifyEnvironmentPower)
+	register("hardware", "VerifyEnvironmentSystemCooling", hardware.NewVerifyEnvironmentSystemCooling)
+	register("hardware", "VerifyEnvironmentCooling", hardware.NewVerifyEnvironmentCooling)
+	register("hardware", "VerifyEnvironmentPower", hardware.NewVerifyEnvironmentPower)
 
 	// Advanced Hardware Tests
-	_ = registry.Register("hardware", "VerifyAdverseDrops", hardware.NewVerifyAdverseDrops)
-	_ = registry.Register("hardware", "VerifySupervisorRedundancy", hardware.NewVerifySupervisorRedundancy)
-	_ = registry.Register("hardware", "VerifyPCIeErrors", hardware.NewVerifyPCIeErrors)
-	_ = registry.Register("hardware", "VerifyAbsenceOfLinecards", hardware.NewVerifyAbsenceOfLinecards)
+	register("hardware", "VerifyAdverseDrops", hardware.NewVerifyAdverseDrops)
+	register("hardware", "VerifySupervisorRedundancy", hardware.NewVerifySupervisorRedundancy)
+	register("hardware", "VerifyActiveSupervisorConsistency", hardware.NewVerifyActiveSupervisorConsistency)
+	register("hardware", "VerifyPCIeErrors", hardware.NewVerifyPCIeErrors)
+	register("hardware", "VerifyAbsenceOfLinecards", hardware.NewVerifyAbsenceOfLinecards)
 
 	// Chassis and Module Tests
-	_ = registry.Register("hardware", "VerifyChassisHealth", hardware.NewVerifyChassisHealth)
-	_ = registry.Register("hardware", "VerifyHardwareCapacityUtilization", hardware.NewVerifyHardwareCapacityUtilization)
-	_ = registry.Register("hardware", "VerifyModuleStatus", hardware.NewVerifyModuleStatus)
+	register("hardware", "VerifyChassisHealth", hardware.NewVerifyChassisHealth)
+	register("hardware", "VerifyHardwareCapacityUtilization", hardware.NewVerifyHardwareCapacityUtilization)
+	register("hardware", "VerifyRoutingTableResource", hardware.NewVerifyRoutingTableResource)
+	register("hardware", "VerifyLinecardResources", hardware.NewVerifyLinecardResources)
+	register("hardware", "VerifyModuleStatus", hardware.NewVerifyModuleStatus)
+	register("hardware", "VerifyTpmStatus", hardware.NewVerifyTpmStatus)
 
 	// Interface Tests
-	_ = registry.Register("interfaces", "VerifyInterfacesStatus", interfaces.NewVerifyInterfacesStatus)
-	_ = registry.Register("interfaces", "VerifyInterfaceErrors", interfaces.NewVerifyInterfaceErrors)
-	_ = registry.Register("interfaces", "VerifyInterfaceUtilization", interfaces.NewVerifyInterfaceUtilization)
+	register("interfaces", "VerifyInterfacesStatus", interfaces.NewVerifyInterfacesStatus)
+	register("interfaces", "VerifyInterfaceErrors", interfaces.NewVerifyInterfaceErrors)
+	register("interfaces", "VerifyInterfaceUtilization", interfaces.NewVerifyInterfaceUtilization)
+	register("interfaces", "VerifyInterfacesSpeed", interfaces.NewVerifyInterfacesSpeed)
+	register("interfaces", "VerifyInterfacesMTU", interfaces.NewVerifyInterfacesMTU)
+	register("interfaces", "VerifyMacTableSize", interfaces.NewVerifyMacTableSize)
+	register("interfaces", "VerifyMacAging", interfaces.NewVerifyMacAging)
+	register("interfaces", "VerifyPortSecurity", interfaces.NewVerifyPortSecurity)
+
+	// L3 Tests
+	register("l3", "VerifyArpEntries", l3.NewVerifyArpEntries)
+	register("l3", "VerifyIPv6Neighbors", l3.NewVerifyIPv6Neighbors)
+	register("l3", "VerifyRouteNextHopReachability", l3.NewVerifyRouteNextHopReachability)
+	register("l3", "VerifyIPHelperAddresses", l3.NewVerifyIPHelperAddresses)
+
+	// LANZ Tests
+	register("lanz", "VerifyLANZEnabled", lanz.NewVerifyLANZEnabled)
 
 	// Logging Tests
-	_ = registry.Register("logging", "VerifySyslogLogging", logging.NewVerifySyslogLogging)
-	_ = registry.Register("logging", "VerifyLoggingPersistent", logging.NewVerifyLoggingPersistent)
-	_ = registry.Register("logging", "VerifyLoggingSourceIntf", logging.NewVerifyLoggingSourceIntf)
-	_ = registry.Register("logging", "VerifyLoggingHosts", logging.NewVerifyLoggingHosts)
-	_ = registry.Register("logging", "VerifyLoggingLogsGeneration", logging.NewVerifyLoggingLogsGeneration)
-	_ = registry.Register("logging", "VerifyLoggingHostname", logging.NewVerifyLoggingHostname)
-	_ = registry.Register("logging", "VerifyLoggingTimestamp", logging.NewVerifyLoggingTimestamp)
-	_ = registry.Register("logging", "VerifyLoggingAccounting", logging.NewVerifyLoggingAccounting)
-	_ = registry.Register("logging", "VerifyLoggingErrors", logging.NewVerifyLoggingErrors)
+	register("logging", "VerifySyslogLogging", logging.NewVerifySyslogLogging)
+	register("logging", "VerifyLoggingPersistent", logging.NewVerifyLoggingPersistent)
+	register("logging", "VerifyLoggingBuffer", logging.NewVerifyLoggingBuffer)
+	register("logging", "VerifyLoggingSourceIntf", logging.NewVerifyLoggingSourceIntf)
+	register("logging", "VerifyLoggingHosts", logging.NewVerifyLoggingHosts)
+	register("logging", "VerifyLoggingHostsReachable", logging.NewVerifyLoggingHostsReachable)
+	register("logging", "VerifyLoggingLogsGeneration", logging.NewVerifyLoggingLogsGeneration)
+	register("logging", "VerifyLoggingHostname", logging.NewVerifyLoggingHostname)
+	register("logging", "VerifyLoggingTimestamp", logging.NewVerifyLoggingTimestamp)
+	register("logging", "VerifyLoggingAccounting", logging.NewVerifyLoggingAccounting)
+	register("logging", "VerifyLoggingErrors", logging.NewVerifyLoggingErrors)
+
+	// Multicast Tests
+	register("multicast", "VerifyIGMPSnoopingVlans", multicast.NewVerifyIGMPSnoopingVlans)
+	register("multicast", "VerifyIGMPSnoopingGlobal", multicast.NewVerifyIGMPSnoopingGlobal)
+	register("multicast", "VerifyPIMNeighbors", multicast.NewVerifyPIMNeighbors)
+	register("multicast", "VerifyMroutes", multicast.NewVerifyMroutes)
+
+	// QoS Tests
+	register("qos", "VerifyQosPolicyMapApplied", qos.NewVerifyQosPolicyMapApplied)
+	register("qos", "VerifyQosShapeRate", qos.NewVerifyQosShapeRate)
+	register("qos", "VerifyPriorityFlowControl", qos.NewVerifyPriorityFlowControl)
+	register("qos", "VerifyQueueDrops", qos.NewVerifyQueueDrops)
 
 	// BGP Tests - All 26 BGP tests from ANTA Python implementation
-	_ = registry.Register("routing", "VerifyBGPPeers", routing.NewVerifyBGPPeers)
-	_ = registry.Register("routing", "VerifyBGPUnnumbered", routing.NewVerifyBGPUnnumbered)
-	_ = registry.Register("routing", "VerifyBGPPeerCount", routing.NewVerifyBGPPeerCount)
-	_ = registry.Register("routing", "VerifyBGPPeersHealth", routing.NewVerifyBGPPeersHealth)
-	_ = registry.Register("routing", "VerifyBGPSpecificPeers", routing.NewVerifyBGPSpecificPeers)
-	_ = registry.Register("routing", "VerifyBGPPeerSession", routing.NewVerifyBGPPeerSession)
-	_ = registry.Register("routing", "VerifyBGPExchangedRoutes", routing.NewVerifyBGPExchangedRoutes)
-	_ = registry.Register("routing", "VerifyBGPPeerMPCaps", routing.NewVerifyBGPPeerMPCaps)
-	_ = registry.Register("routing", "VerifyBGPPeerASNCap", routing.NewVerifyBGPPeerASNCap)
-	_ = registry.Register("routing", "VerifyBGPPeerRouteRefreshCap", routing.NewVerifyBGPPeerRouteRefreshCap)
-	_ = registry.Register("routing", "VerifyBGPPeerMD5Auth", routing.NewVerifyBGPPeerMD5Auth)
-	_ = registry.Register("routing", "VerifyEVPNType2Route", routing.NewVerifyEVPNType2Route)
-	_ = registry.Register("routing", "VerifyBGPAdvCommunities", routing.NewVerifyBGPAdvCommunities)
-	_ = registry.Register("routing", "VerifyBGPTimers", routing.NewVerifyBGPTimers)
-	_ = registry.Register("routing", "VerifyBGPPeerDropStats", routing.NewVerifyBGPPeerDropStats)
-	_ = registry.Register("routing", "VerifyBGPPeerUpdateErrors", routing.NewVerifyBGPPeerUpdateErrors)
-	_ = registry.Register("routing", "VerifyBgpRouteMaps", routing.NewVerifyBgpRouteMaps)
-	_ = registry.Register("routing", "VerifyBGPPeerRouteLimit", routing.NewVerifyBGPPeerRouteLimit)
-	_ = registry.Register("routing", "VerifyBGPPeerGroup", routing.NewVerifyBGPPeerGroup)
-	_ = registry.Register("routing", "VerifyBGPPeerSessionRibd", routing.NewVerifyBGPPeerSessionRibd)
-	_ = registry.Register("routing", "VerifyBGPPeersHealthRibd", routing.NewVerifyBGPPeersHealthRibd)
-	_ = registry.Register("routing", "VerifyBGPNlriAcceptance", routing.NewVerifyBGPNlriAcceptance)
-	_ = registry.Register("routing", "VerifyBGPRoutePaths", routing.NewVerifyBGPRoutePaths)
-	_ = registry.Register("routing", "VerifyBGPRouteECMP", routing.NewVerifyBGPRouteECMP)
-	_ = registry.Register("routing", "VerifyBGPRedistribution", routing.NewVerifyBGPRedistribution)
-	_ = registry.Register("routing", "VerifyBGPPeerTtlMultiHops", routing.NewVerifyBGPPeerTtlMultiHops)
+	register("routing", "VerifyBGPPeers", routing.NewVerifyBGPPeers)
+	register("routing", "VerifyBGPUnnumbered", routing.NewVerifyBGPUnnumbered)
+	register("routing", "VerifyBGPPeerCount", routing.NewVerifyBGPPeerCount)
+	register("routing", "VerifyBGPPeersHealth", routing.NewVerifyBGPPeersHealth)
+	register("routing", "VerifyBGPSpecificPeers", routing.NewVerifyBGPSpecificPeers)
+	register("routing", "VerifyBGPPeerSession", routing.NewVerifyBGPPeerSession)
+	register("routing", "VerifyBGPExchangedRoutes", routing.NewVerifyBGPExchangedRoutes)
+	register("routing", "VerifyBGPPeerMPCaps", routing.NewVerifyBGPPeerMPCaps)
+	register("routing", "VerifyBGPPeerASNCap", routing.NewVerifyBGPPeerASNCap)
+	register("routing", "VerifyBGPPeerRouteRefreshCap", routing.NewVerifyBGPPeerRouteRefreshCap)
+	register("routing", "VerifyBGPAdditionalPaths", routing.NewVerifyBGPAdditionalPaths)
+	register("routing", "VerifyBGPPeerMD5Auth", routing.NewVerifyBGPPeerMD5Auth)
+	register("routing", "VerifyEVPNType2Route", routing.NewVerifyEVPNType2Route)
+	register("routing", "VerifyEVPNMacMobility", routing.NewVerifyEVPNMacMobility)
+	register("routing", "VerifyBGPAdvCommunities", routing.NewVerifyBGPAdvCommunities)
+	register("routing", "VerifyBGPTimers", routing.NewVerifyBGPTimers)
+	register("routing", "VerifyBGPPeerDropStats", routing.NewVerifyBGPPeerDropStats)
+	register("routing", "VerifyBGPPeerUpdateErrors", routing.NewVerifyBGPPeerUpdateErrors)
+	register("routing", "VerifyBgpRouteMaps", routing.NewVerifyBgpRouteMaps)
+	register("routing", "VerifyBGPPeerRouteLimit", routing.NewVerifyBGPPeerRouteLimit)
+	register("routing", "VerifyBGPPeerGroup", routing.NewVerifyBGPPeerGroup)
+	register("routing", "VerifyBGPPeerSessionRibd", routing.NewVerifyBGPPeerSessionRibd)
+	register("routing", "VerifyBGPPeersHealthRibd", routing.NewVerifyBGPPeersHealthRibd)
+	register("routing", "VerifyBGPNlriAcceptance", routing.NewVerifyBGPNlriAcceptance)
+	register("routing", "VerifyBGPRoutePaths", routing.NewVerifyBGPRoutePaths)
+	register("routing", "VerifyBGPRouteECMP", routing.NewVerifyBGPRouteECMP)
+	register("routing", "VerifyBGPRouteAttributes", routing.NewVerifyBGPRouteAttributes)
+	register("routing", "VerifyBGPRedistribution", routing.NewVerifyBGPRedistribution)
+	register("routing", "VerifyBGPPeerTtlMultiHops", routing.NewVerifyBGPPeerTtlMultiHops)
+	register("routing", "VerifyBGPPeerConnectedCheck", routing.NewVerifyBGPPeerConnectedCheck)
+	register("routing", "VerifyBGPConfederation", routing.NewVerifyBGPConfederation)
+	register("routing", "VerifyBGPGracefulRestart", routing.NewVerifyBGPGracefulRestart)
+	register("routing", "VerifyBGPPeerFlapCount", routing.NewVerifyBGPPeerFlapCount)
+	register("routing", "VerifyBGPVrfAllPeersEstablished", routing.NewVerifyBGPVrfAllPeersEstablished)
 
 	// BFD Tests - All 4 BFD tests from ANTA Python implementation
-	_ = registry.Register("routing", "VerifyBFDSpecificPeers", routing.NewVerifyBFDSpecificPeers)
-	_ = registry.Register("routing", "VerifyBFDPeersIntervals", routing.NewVerifyBFDPeersIntervals)
-	_ = registry.Register("routing", "VerifyBFDPeersHealth", routing.NewVerifyBFDPeersHealth)
-	_ = registry.Register("routing", "VerifyBFDPeersRegProtocols", routing.NewVerifyBFDPeersRegProtocols)
+	register("routing", "VerifyBFDSpecificPeers", routing.NewVerifyBFDSpecificPeers)
+	register("routing", "VerifyBFDPeersIntervals", routing.NewVerifyBFDPeersIntervals)
+	register("routing", "VerifyBFDPeersHealth", routing.NewVerifyBFDPeersHealth)
+	register("routing", "VerifyBFDPeersRegProtocols", routing.NewVerifyBFDPeersRegProtocols)
 
 	// Other routing tests
-	_ = registry.Register("routing", "VerifyOSPFNeighbors", routing.NewVerifyOSPFNeighbors)
-	_ = registry.Register("routing", "VerifyStaticRoutes", routing.NewVerifyStaticRoutes)
+	register("routing", "VerifyOSPFNeighbors", routing.NewVerifyOSPFNeighbors)
+	register("routing", "VerifyStaticRoutes", routing.NewVerifyStaticRoutes)
+	register("routing", "VerifyVrfRouteLeaking", routing.NewVerifyVrfRouteLeaking)
 
 	// Path Selection Tests
-	_ = registry.Register("routing", "VerifyPathsHealth", routing.NewVerifyPathsHealth)
-	_ = registry.Register("routing", "VerifySpecificPath", routing.NewVerifySpecificPath)
+	register("routing", "VerifyPathsHealth", routing.NewVerifyPathsHealth)
+	register("routing", "VerifySpecificPath", routing.NewVerifySpecificPath)
 
 	// Security Tests
-	_ = registry.Register("security", "VerifySSHStatus", security.NewVerifySSHStatus)
-	_ = registry.Register("security", "VerifySSHIPv4Acl", security.NewVerifySSHIPv4Acl)
-	_ = registry.Register("security", "VerifySSHIPv6Acl", security.NewVerifySSHIPv6Acl)
-	_ = registry.Register("security", "VerifyTelnetStatus", security.NewVerifyTelnetStatus)
-	_ = registry.Register("security", "VerifyAPIHttpStatus", security.NewVerifyAPIHttpStatus)
-	_ = registry.Register("security", "VerifyAPIHttpsSSL", security.NewVerifyAPIHttpsSSL)
-	_ = registry.Register("security", "VerifyAPIIPv4Acl", security.NewVerifyAPIIPv4Acl)
-	_ = registry.Register("security", "VerifyAPIIPv6Acl", security.NewVerifyAPIIPv6Acl)
+	register("security", "VerifySSHStatus", security.NewVerifySSHStatus)
+	register("security", "VerifySSHIPv4Acl", security.NewVerifySSHIPv4Acl)
+	register("security", "VerifySSHIPv6Acl", security.NewVerifySSHIPv6Acl)
+	register("security", "VerifyTelnetStatus", security.NewVerifyTelnetStatus)
+	register("security", "VerifyAPIHttpStatus", security.NewVerifyAPIHttpStatus)
+	register("security", "VerifyAPIHttpsSSL", security.NewVerifyAPIHttpsSSL)
+	register("security", "VerifyAPIIPv4Acl", security.NewVerifyAPIIPv4Acl)
+	register("security", "VerifyAPIIPv6Acl", security.NewVerifyAPIIPv6Acl)
+	register("security", "VerifyAPIEnabledVRFs", security.NewVerifyAPIEnabledVRFs)
+	register("security", "VerifyCoppStatus", security.NewVerifyCoppStatus)
 
 	// AAA Tests
-	_ = registry.Register("security", "VerifyTacacsSourceIntf", security.NewVerifyTacacsSourceIntf)
-	_ = registry.Register("security", "VerifyTacacsServers", security.NewVerifyTacacsServers)
-	_ = registry.Register("security", "VerifyTacacsServerGroups", security.NewVerifyTacacsServerGroups)
-	_ = registry.Register("security", "VerifyAuthenMethods", security.NewVerifyAuthenMethods)
-	_ = registry.Register("security", "VerifyAuthzMethods", security.NewVerifyAuthzMethods)
-	_ = registry.Register("security", "VerifyAcctDefaultMethods", security.NewVerifyAcctDefaultMethods)
-	_ = registry.Register("security", "VerifyAcctConsoleMethods", security.NewVerifyAcctConsoleMethods)
+	register("security", "VerifyTacacsSourceIntf", security.NewVerifyTacacsSourceIntf)
+	register("security", "VerifyTacacsServers", security.NewVerifyTacacsServers)
+	register("security", "VerifyTacacsServerGroups", security.NewVerifyTacacsServerGroups)
+	register("security", "VerifyTacacsReachability", security.NewVerifyTacacsReachability)
+	register("security", "VerifyRadiusSourceIntf", security.NewVerifyRadiusSourceIntf)
+	register("security", "VerifyRadiusServers", security.NewVerifyRadiusServers)
+	register("security", "VerifyRadiusServerGroups", security.NewVerifyRadiusServerGroups)
+	register("security", "VerifyAuthenMethods", security.NewVerifyAuthenMethods)
+	register("security", "VerifyAuthzMethods", security.NewVerifyAuthzMethods)
+	register("security", "VerifyAcctDefaultMethods", security.NewVerifyAcctDefaultMethods)
+	register("security", "VerifyAcctConsoleMethods", security.NewVerifyAcctConsoleMethods)
+	register("security", "VerifyLocalUsers", security.NewVerifyLocalUsers)
 
 	// Services Tests
-	_ = registry.Register("services", "VerifyHostname", services.NewVerifyHostname)
-	_ = registry.Register("services", "VerifyDNSLookup", services.NewVerifyDNSLookup)
-	_ = registry.Register("services", "VerifyDNSServers", services.NewVerifyDNSServers)
-	_ = registry.Register("services", "VerifyErrdisableRecovery", services.NewVerifyErrdisableRecovery)
+	register("services", "VerifyHostname", services.NewVerifyHostname)
+	register("services", "VerifyDNSLookup", services.NewVerifyDNSLookup)
+	register("services", "VerifyDNSServers", services.NewVerifyDNSServers)
+	register("services", "VerifyErrdisableRecovery", services.NewVerifyErrdisableRecovery)
+	register("services", "VerifyDhcpRelayStatus", services.NewVerifyDhcpRelayStatus)
+	register("services", "VerifyDhcpServerEnabled", services.NewVerifyDhcpServerEnabled)
+
+	// sFlow Tests
+	register("sflow", "VerifySflowStatus", sflow.NewVerifySflowStatus)
+	register("sflow", "VerifySflowSamplingRate", sflow.NewVerifySflowSamplingRate)
 
 	// Software Tests (Note: VerifyEOSVersion is in system module)
-	_ = registry.Register("software", "VerifyTerminAttrVersion", software.NewVerifyTerminAttrVersion)
-	_ = registry.Register("software", "VerifyEOSExtensions", software.NewVerifyEOSExtensions)
+	register("software", "VerifyTerminAttrVersion", software.NewVerifyTerminAttrVersion)
+	register("software", "VerifyEOSExtensions", software.NewVerifyEOSExtensions)
 
 	// STP Tests
-	_ = registry.Register("stp", "VerifySTPMode", stp.NewVerifySTPMode)
-	_ = registry.Register("stp", "VerifySTPBlockedPorts", stp.NewVerifySTPBlockedPorts)
-	_ = registry.Register("stp", "VerifySTPCounters", stp.NewVerifySTPCounters)
-	_ = registry.Register("stp", "VerifySTPForwardingPorts", stp.NewVerifySTPForwardingPorts)
-	_ = registry.Register("stp", "VerifySTPRootPriority", stp.NewVerifySTPRootPriority)
-	_ = registry.Register("stp", "VerifyStpTopologyChanges", stp.NewVerifyStpTopologyChanges)
-	_ = registry.Register("stp", "VerifySTPDisabledVlans", stp.NewVerifySTPDisabledVlans)
-
-	_ = registry.Register("system", "VerifyEOSVersion", system.NewVerifyEOSVersion)
-	_ = registry.Register("system", "VerifyUptime", system.NewVerifyUptime)
-	_ = registry.Register("system", "VerifyNTP", system.NewVerifyNTP)
-	_ = registry.Register("system", "VerifyDNSResolution", NewVerifyDNSResolution)
-	_ = registry.Register("system", "VerifyReloadCause", system.NewVerifyReloadCause)
-	_ = registry.Register("system", "VerifyCoredump", system.NewVerifyCoredump)
-	_ = registry.Register("system", "VerifyAgentLogs", system.NewVerifyAgentLogs)
-	_ = registry.Register("system", "VerifyCPUUtilization", system.NewVerifyCPUUtilization)
-	_ = registry.Register("system", "VerifyMemoryUtilization", system.NewVerifyMemoryUtilization)
-	_ = registry.Register("system", "VerifyFileSystemUtilization", system.NewVerifyFileSystemUtilization)
-	_ = registry.Register("system", "VerifyMaintenance", system.NewVerifyMaintenance)
-	_ = registry.Register("system", "VerifyFlashUtilization", system.NewVerifyFlashUtilization)
+	register("stp", "VerifySTPMode", stp.NewVerifySTPMode)
+	register("stp", "VerifySTPBlockedPorts", stp.NewVerifySTPBlockedPorts)
+	register("stp", "VerifyStpPvstSimulation", stp.NewVerifyStpPvstSimulation)
+	register("stp", "VerifySTPCounters", stp.NewVerifySTPCounters)
+	register("stp", "VerifySTPForwardingPorts", stp.NewVerifySTPForwardingPorts)
+	register("stp", "VerifySTPRootPriority", stp.NewVerifySTPRootPriority)
+	register("stp", "VerifyStpTopologyChanges", stp.NewVerifyStpTopologyChanges)
+	register("stp", "VerifySTPDisabledVlans", stp.NewVerifySTPDisabledVlans)
+
+	// STUN Tests
+	register("stun", "VerifyStunClient", stun.NewVerifyStunClient)
+	register("stun", "VerifyStunServer", stun.NewVerifyStunServer)
+
+	register("system", "VerifyEOSVersion", system.NewVerifyEOSVersion)
+	register("system", "VerifyUptime", system.NewVerifyUptime)
+	register("system", "VerifyNTP", system.NewVerifyNTP)
+	register("system", "VerifyNTPAuthentication", system.NewVerifyNTPAuthentication)
+	register("system", "VerifyNTPSynchronized", system.NewVerifyNTPSynchronized)
+	register("system", "VerifyClock", system.NewVerifyClock)
+	register("system", "VerifyDNSResolution", NewVerifyDNSResolution)
+	register("system", "VerifyReloadCause", system.NewVerifyReloadCause)
+	register("system", "VerifyNoReloadScheduled", system.NewVerifyNoReloadScheduled)
+	register("system", "VerifyBootImage", system.NewVerifyBootImage)
+	register("system", "VerifyISSUReadiness", system.NewVerifyISSUReadiness)
+	register("system", "VerifyCoredump", system.NewVerifyCoredump)
+	register("system", "VerifyAgentLogs", system.NewVerifyAgentLogs)
+	register("system", "VerifyCPUUtilization", system.NewVerifyCPUUtilization)
+	register("system", "VerifyCPUHistoryBelow", system.NewVerifyCPUHistoryBelow)
+	register("system", "VerifyMemoryUtilization", system.NewVerifyMemoryUtilization)
+	register("system", "VerifyFileSystemUtilization", system.NewVerifyFileSystemUtilization)
+	register("system", "VerifyMaintenance", system.NewVerifyMaintenance)
+	register("system", "VerifyFlashUtilization", system.NewVerifyFlashUtilization)
 
 	// MLAG Tests
-	_ = registry.Register("system", "VerifyMlagStatus", system.NewVerifyMlagStatus)
-	_ = registry.Register("system", "VerifyMlagInterfaces", system.NewVerifyMlagInterfaces)
-	_ = registry.Register("system", "VerifyMlagConfigSanity", system.NewVerifyMlagConfigSanity)
-	_ = registry.Register("system", "VerifyMlagReloadDelay", system.NewVerifyMlagReloadDelay)
-	_ = registry.Register("system", "VerifyMlagDualPrimary", system.NewVerifyMlagDualPrimary)
+	register("system", "VerifyMlagStatus", system.NewVerifyMlagStatus)
+	register("system", "VerifyMlagInterfaces", system.NewVerifyMlagInterfaces)
+	register("system", "VerifyMlagConfigSanity", system.NewVerifyMlagConfigSanity)
+	register("system", "VerifyMlagReloadDelay", system.NewVerifyMlagReloadDelay)
+	register("system", "VerifyMlagDualPrimary", system.NewVerifyMlagDualPrimary)
+	register("system", "VerifyMlagPortChannels", system.NewVerifyMlagPortChannels)
 
 	// Configuration Tests
-	_ = registry.Register("system", "VerifyZeroTouch", system.NewVerifyZeroTouch)
-	_ = registry.Register("system", "VerifyRunningConfigDiffs", system.NewVerifyRunningConfigDiffs)
-	_ = registry.Register("system", "VerifyRunningConfigLines", system.NewVerifyRunningConfigLines)
+	register("system", "VerifyZeroTouch", system.NewVerifyZeroTouch)
+	register("system", "VerifyRunningConfigDiffs", system.NewVerifyRunningConfigDiffs)
+	register("system", "VerifyRunningConfigLines", system.NewVerifyRunningConfigLines)
 
 	// VLAN Tests
-	_ = registry.Register("vlan", "VerifyVlanInternalPolicy", vlan.NewVerifyVlanInternalPolicy)
-	_ = registry.Register("vlan", "VerifyDynamicVlanSource", vlan.NewVerifyDynamicVlanSource)
-	_ = registry.Register("vlan", "VerifyVlanStatus", vlan.NewVerifyVlanStatus)
+	register("vlan", "VerifyVlanInternalPolicy", vlan.NewVerifyVlanInternalPolicy)
+	register("vlan", "VerifyDynamicVlanSource", vlan.NewVerifyDynamicVlanSource)
+	register("vlan", "VerifyVlanStatus", vlan.NewVerifyVlanStatus)
 
 	// VXLAN Tests
-	_ = registry.Register("vxlan", "VerifyVxlan1Interface", vxlan.NewVerifyVxlan1Interface)
-	_ = registry.Register("vxlan", "VerifyVxlanConfigSanity", vxlan.NewVerifyVxlanConfigSanity)
-	_ = registry.Register("vxlan", "VerifyVxlanVniBinding", vxlan.NewVerifyVxlanVniBinding)
-	_ = registry.Register("vxlan", "VerifyVxlanVtep", vxlan.NewVerifyVxlanVtep)
-	_ = registry.Register("vxlan", "VerifyVxlan1ConnSettings", vxlan.NewVerifyVxlan1ConnSettings)
+	register("vxlan", "VerifyVxlan1Interface", vxlan.NewVerifyVxlan1Interface)
+	register("vxlan", "VerifyVxlanConfigSanity", vxlan.NewVerifyVxlanConfigSanity)
+	register("vxlan", "VerifyVxlanVniBinding", vxlan.NewVerifyVxlanVniBinding)
+	register("vxlan", "VerifyVxlanVtep", vxlan.NewVerifyVxlanVtep)
+	register("vxlan", "VerifyVxlan1ConnSettings", vxlan.NewVerifyVxlan1ConnSettings)
+
+	return errors.Join(errs...)
 }
 
 func NewVerifyDNSResolution(inputs map[string]any) (test.Test, error) {
@@ -0,0 +1,19 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestRegisteredTestsPassSelfCheck(t *testing.T) {
+	if err := test.GetRegistry().SelfCheck(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisterAllTestsHasNoRegisterErrors(t *testing.T) {
+	if err := RegisterAllTests(test.NewRegistry()); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -11,6 +11,10 @@ import (
 
 // VerifyInterfaceErrors verifies that interface error counters are within acceptable limits.
 //
+// When the runner has a baseline for the device (see test.BaselineProvider),
+// thresholds apply to how much each counter grew since the baseline, so
+// errors left over from an old, fixed incident do not fail the test.
+//
 // Expected Results:
 //   - Success: The test will pass if all interface error counters are within the specified thresholds.
 //   - Failure: The test will fail if any interface has error counters above the specified thresholds.
@@ -36,6 +40,20 @@ type VerifyInterfaceErrors struct {
 	test.BaseTest
 	Interfaces []InterfaceErrorThresholds `yaml:"interfaces,omitempty" json:"interfaces,omitempty"`
 	CheckAll   bool                       `yaml:"check_all,omitempty" json:"check_all,omitempty"`
+
+	// baseline holds the counters recorded before the run, when the
+	// runner has them; thresholds then apply to the increase since.
+	baseline map[string]InterfaceErrorCounters
+}
+
+const interfaceErrorsCmd = "show interfaces counters errors"
+
+func (t *VerifyInterfaceErrors) BaselineCommands() []string {
+	return []string{interfaceErrorsCmd}
+}
+
+func (t *VerifyInterfaceErrors) SetBaseline(outputs map[string]any) {
+	t.baseline = parseErrorCounters(outputs[interfaceErrorsCmd])
 }
 
 type InterfaceErrorThresholds struct {
@@ -113,7 +131,7 @@ func (t *VerifyInterfaceErrors) Execute(ctx context.Context, dev device.Device)
 	}
 
 	cmd := device.Command{
-		Template: "show interfaces counters errors",
+		Template: interfaceErrorsCmd,
 		Format:   "json",
 		UseCache: false,
 	}
@@ -131,41 +149,10 @@ func (t *VerifyInterfaceErrors) Execute(ctx context.Context, dev device.Device)
 		return result, nil
 	}
 
-	deviceErrors := make(map[string]InterfaceErrorCounters)
-
-	if errorData, ok := cmdResult.Output.(map[string]any); ok {
-		if counters, ok := errorData["interfaceErrorCounters"].(map[string]any); ok {
-			for intfName, intfCounters := range counters {
-				if counters, ok := intfCounters.(map[string]any); ok {
-					errorCounters := InterfaceErrorCounters{
-						Interface: intfName,
-					}
-
-					if fcs, ok := counters["fcsErrors"].(float64); ok {
-						errorCounters.FcsErrors = int(fcs)
-					}
-					if align, ok := counters["alignmentErrors"].(float64); ok {
-						errorCounters.AlignmentErrors = int(align)
-					}
-					if symbol, ok := counters["symbolErrors"].(float64); ok {
-						errorCounters.SymbolErrors = int(symbol)
-					}
-					if inErr, ok := counters["inErrors"].(float64); ok {
-						errorCounters.InErrors = int(inErr)
-					}
-					if outErr, ok := counters["outErrors"].(float64); ok {
-						errorCounters.OutErrors = int(outErr)
-					}
-					if tooShorts, ok := counters["frameTooShorts"].(float64); ok {
-						errorCounters.FrameTooShorts = int(tooShorts)
-					}
-					if tooLongs, ok := counters["frameTooLongs"].(float64); ok {
-						errorCounters.FrameTooLongs = int(tooLongs)
-					}
-
-					deviceErrors[intfName] = errorCounters
-				}
-			}
+	deviceErrors := parseErrorCounters(cmdResult.Output)
+	if t.baseline != nil {
+		for intfName, counters := range deviceErrors {
+			deviceErrors[intfName] = counters.since(t.baseline[intfName])
 		}
 	}
 
@@ -224,17 +211,24 @@ func (t *VerifyInterfaceErrors) Execute(ctx context.Context, dev device.Device)
 	if len(failures) > 0 {
 		details["issues"] = failures
 	}
+	suffix := ""
+	if t.baseline != nil {
+		details["since_baseline"] = true
+		suffix = " since baseline"
+	}
 	result.Details = details
 
 	if len(failures) > 0 {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("Interface error counter failures: %v", failures)
+		result.Message = fmt.Sprintf("Interface error counter failures%s: %v", suffix, failures)
 	} else if len(withErrors) > 0 {
 		// Test passed under the configured thresholds, but some
 		// interfaces have nonzero counters. Surface that in the
 		// message so it's not lost in the noise.
-		result.Message = fmt.Sprintf("%d/%d interfaces have nonzero error counters (within thresholds)",
-			len(withErrors), len(deviceErrors))
+		result.Message = fmt.Sprintf("%d/%d interfaces have nonzero error counters%s (within thresholds)",
+			len(withErrors), len(deviceErrors), suffix)
+	} else if t.baseline != nil {
+		result.Message = fmt.Sprintf("%d interfaces, no new errors since baseline", len(deviceErrors))
 	} else {
 		result.Message = fmt.Sprintf("%d interfaces, all error counters at 0", len(deviceErrors))
 	}
@@ -300,3 +294,62 @@ type InterfaceErrorCounters struct {
 func (c InterfaceErrorCounters) hasErrors() bool {
 	return c.FcsErrors+c.AlignmentErrors+c.SymbolErrors+c.InErrors+c.OutErrors+c.FrameTooShorts+c.FrameTooLongs > 0
 }
+
+// parseErrorCounters reads `show interfaces counters errors` output. It
+// returns nil for anything else, so a malformed baseline is ignored.
+func parseErrorCounters(output any) map[string]InterfaceErrorCounters {
+	errorData, ok := output.(map[string]any)
+	if !ok {
+		return nil
+	}
+	deviceErrors := make(map[string]InterfaceErrorCounters)
+	if counters, ok := errorData["interfaceErrorCounters"].(map[string]any); ok {
+		for intfName, intfCounters := range counters {
+			if counters, ok := intfCounters.(map[string]any); ok {
+				errorCounters := InterfaceErrorCounters{
+					Interface: intfName,
+				}
+
+				if fcs, ok := counters["fcsErrors"].(float64); ok {
+					errorCounters.FcsErrors = int(fcs)
+				}
+				if align, ok := counters["alignmentErrors"].(float64); ok {
+					errorCounters.AlignmentErrors = int(align)
+				}
+				if symbol, ok := counters["symbolErrors"].(float64); ok {
+					errorCounters.SymbolErrors = int(symbol)
+				}
+				if inErr, ok := counters["inErrors"].(float64); ok {
+					errorCounters.InErrors = int(inErr)
+				}
+				if outErr, ok := counters["outErrors"].(float64); ok {
+					errorCounters.OutErrors = int(outErr)
+				}
+				if tooShorts, ok := counters["frameTooShorts"].(float64); ok {
+					errorCounters.FrameTooShorts = int(tooShorts)
+				}
+				if tooLongs, ok := counters["frameTooLongs"].(float64); ok {
+					errorCounters.FrameTooLongs = int(tooLongs)
+				}
+
+				deviceErrors[intfName] = errorCounters
+			}
+		}
+	}
+	return deviceErrors
+}
+
+// since returns the increase of each counter over base. An interface
+// missing from the baseline is compared against zero.
+func (c InterfaceErrorCounters) since(base InterfaceErrorCounters) InterfaceErrorCounters {
+	return InterfaceErrorCounters{
+		Interface:       c.Interface,
+		FcsErrors:       test.CounterDelta(c.FcsErrors, base.FcsErrors),
+		AlignmentErrors: test.CounterDelta(c.AlignmentErrors, base.AlignmentErrors),
+		SymbolErrors:    test.CounterDelta(c.SymbolErrors, base.SymbolErrors),
+		InErrors:        test.CounterDelta(c.InErrors, base.InErrors),
+		OutErrors:       test.CounterDelta(c.OutErrors, base.OutErrors),
+		FrameTooShorts:  test.CounterDelta(c.FrameTooShorts, base.FrameTooShorts),
+		FrameTooLongs:   test.CounterDelta(c.FrameTooLongs, base.FrameTooLongs),
+	}
+}
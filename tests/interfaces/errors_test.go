@@ -1,7 +1,12 @@
 package interfaces
 
 import (
+	"context"
+	"strings"
 	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
 )
 
 func TestHasErrors(t *testing.T) {
@@ -15,3 +20,63 @@ func TestHasErrors(t *testing.T) {
 		t.Error("non-zero InErrors should flag")
 	}
 }
+
+func TestVerifyInterfaceErrors_Baseline(t *testing.T) {
+	const counters = `{"interfaceErrorCounters": {
+  "Ethernet1": {"fcsErrors": 5000, "inErrors": 5000},
+  "Ethernet2": {"fcsErrors": 12, "inErrors": 0}
+}}`
+	const baseline = `{"interfaceErrorCounters": {
+  "Ethernet1": {"fcsErrors": 5000, "inErrors": 5000},
+  "Ethernet2": {"fcsErrors": 10, "inErrors": 0}
+}}`
+	run := func(t *testing.T, baseline string) *test.TestResult {
+		t.Helper()
+		tst, err := NewVerifyInterfaceErrors(map[string]any{"interfaces": []any{
+			map[string]any{"name": "Ethernet1"},
+			map[string]any{"name": "Ethernet2", "fcs_errors": float64(5)},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if baseline != "" {
+			tst.(test.BaselineConsumer).SetBaseline(map[string]any{interfaceErrorsCmd: device.DecodeJSON(t, baseline)})
+		}
+		res, err := tst.Execute(context.Background(), device.NewFakeDevice().OnJSON(t, interfaceErrorsCmd, counters))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	t.Run("absolute", func(t *testing.T) {
+		res := run(t, "")
+		if res.Status != test.TestFailure || !strings.Contains(res.Message, "Ethernet1: FCS errors 5000 > 0") {
+			t.Errorf("result = %v %q, want Ethernet1's absolute counters to fail", res.Status, res.Message)
+		}
+	})
+
+	t.Run("unchanged since baseline", func(t *testing.T) {
+		res := run(t, baseline)
+		if res.Status != test.TestSuccess {
+			t.Fatalf("result = %v %q, want success", res.Status, res.Message)
+		}
+		if res.Message != "1/2 interfaces have nonzero error counters since baseline (within thresholds)" {
+			t.Errorf("message = %q", res.Message)
+		}
+		if res.Details["since_baseline"] != true {
+			t.Errorf("details should mark the baseline comparison: %v", res.Details)
+		}
+	})
+
+	t.Run("growth since baseline", func(t *testing.T) {
+		res := run(t, `{"interfaceErrorCounters": {
+  "Ethernet1": {"fcsErrors": 4990, "inErrors": 5000},
+  "Ethernet2": {"fcsErrors": 1}
+}}`)
+		want := "Interface error counter failures since baseline: [Ethernet1: FCS errors 10 > 0 Ethernet2: FCS errors 11 > 5]"
+		if res.Status != test.TestFailure || res.Message != want {
+			t.Errorf("result = %v %q, want %q", res.Status, res.Message, want)
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyMacTableSize verifies the number of learned MAC addresses per VLAN is within bounds.
+//
+// A VLAN learning far more addresses than expected usually means a loop or
+// MAC flooding; one learning none points at a learning or forwarding fault.
+//
+// The test performs the following checks for each specified VLAN:
+//  1. Retrieves per-VLAN counts from `show mac address-table count`.
+//  2. Validates the dynamic (learned) entry count is at least `min`.
+//  3. Validates the count does not exceed `max`, when set.
+//
+// Expected Results:
+//   - Success: Every VLAN's learned MAC count is within its bounds.
+//   - Failure: A VLAN has fewer than `min` or more than `max` learned addresses.
+//   - Error: Unable to retrieve MAC address table counts.
+//
+// Example YAML configuration:
+//   - name: "VerifyMacTableSize"
+//     module: "interfaces"
+//     inputs:
+//     vlans:
+//   - vlan: 10
+//     min: 1
+//     max: 500
+//   - vlan: 20
+//     max: 2000
+type VerifyMacTableSize struct {
+	test.BaseTest
+	VLANs []MacTableLimit `yaml:"vlans" json:"vlans"`
+}
+
+type MacTableLimit struct {
+	VLAN int `yaml:"vlan" json:"vlan"`
+	Min  int `yaml:"min,omitempty" json:"min,omitempty"`
+	Max  int `yaml:"max,omitempty" json:"max,omitempty"`
+}
+
+func NewVerifyMacTableSize(inputs map[string]any) (test.Test, error) {
+	t := &VerifyMacTableSize{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyMacTableSize",
+			TestDescription: "Verify learned MAC address counts per VLAN",
+			TestCategories:  []string{"interfaces", "l2"},
+		},
+	}
+
+	if inputs != nil {
+		if vlans, ok := inputs["vlans"].([]any); ok {
+			for i, item := range vlans {
+				vlanMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("vlans[%d]: expected map, got %T", i, item)
+				}
+				limit := MacTableLimit{}
+				if err := test.GetInt(vlanMap, "vlan", &limit.VLAN); err != nil {
+					return nil, fmt.Errorf("vlans[%d]: %w", i, err)
+				}
+				if err := test.GetInt(vlanMap, "min", &limit.Min); err != nil {
+					return nil, fmt.Errorf("vlans[%d]: %w", i, err)
+				}
+				if err := test.GetInt(vlanMap, "max", &limit.Max); err != nil {
+					return nil, fmt.Errorf("vlans[%d]: %w", i, err)
+				}
+				t.VLANs = append(t.VLANs, limit)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyMacTableSize) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show mac address-table count",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get MAC address table counts: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected MAC address table output: %v", err)
+		return result, nil
+	}
+
+	counts := parseMacVlanCounts(data)
+	issues := checkMacTableLimits(counts, t.VLANs)
+
+	details := map[string]any{}
+	for _, limit := range t.VLANs {
+		details[strconv.Itoa(limit.VLAN)] = counts[limit.VLAN]
+	}
+	result.Details = map[string]any{"vlan_counts": details}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("MAC table size issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Learned MAC counts within bounds for %d VLANs", len(t.VLANs))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyMacTableSize) ValidateInput(input any) error {
+	if len(t.VLANs) == 0 {
+		return fmt.Errorf("at least one VLAN must be specified")
+	}
+	for i, limit := range t.VLANs {
+		if limit.VLAN < 1 || limit.VLAN > 4094 {
+			return fmt.Errorf("vlans[%d]: invalid VLAN ID %d", i, limit.VLAN)
+		}
+		if limit.Min < 0 || limit.Max < 0 {
+			return fmt.Errorf("vlans[%d]: min and max must not be negative", i)
+		}
+		if limit.Max > 0 && limit.Min > limit.Max {
+			return fmt.Errorf("vlans[%d]: min %d exceeds max %d", i, limit.Min, limit.Max)
+		}
+	}
+	return nil
+}
+
+// parseMacVlanCounts returns the dynamic entry count per VLAN from
+// `show mac address-table count` ("vlanCounts" keyed by VLAN ID string).
+func parseMacVlanCounts(data map[string]any) map[int]int {
+	counts := map[int]int{}
+	vlanCounts, _ := data["vlanCounts"].(map[string]any)
+	for key, raw := range vlanCounts {
+		vlan, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if dynamic, ok := entry["dynamic"].(float64); ok {
+			counts[vlan] = int(dynamic)
+		}
+	}
+	return counts
+}
+
+func checkMacTableLimits(counts map[int]int, limits []MacTableLimit) []string {
+	sorted := append([]MacTableLimit(nil), limits...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].VLAN < sorted[j].VLAN })
+
+	issues := []string{}
+	for _, limit := range sorted {
+		count := counts[limit.VLAN]
+		if count < limit.Min {
+			issues = append(issues, fmt.Sprintf("VLAN %d: %d learned MACs (min %d)", limit.VLAN, count, limit.Min))
+		}
+		if limit.Max > 0 && count > limit.Max {
+			issues = append(issues, fmt.Sprintf("VLAN %d: %d learned MACs (max %d)", limit.VLAN, count, limit.Max))
+		}
+	}
+	return issues
+}
+
+// VerifyMacAging verifies the MAC address table aging timer.
+//
+// Expected Results:
+//   - Success: The aging time equals `aging_seconds`.
+//   - Failure: The aging time differs from `aging_seconds`.
+//   - Error: Unable to retrieve the MAC address table aging time.
+//
+// Example YAML configuration:
+//   - name: "VerifyMacAging"
+//     module: "interfaces"
+//     inputs:
+//     aging_seconds: 300
+type VerifyMacAging struct {
+	test.BaseTest
+	AgingSeconds int `yaml:"aging_seconds" json:"aging_seconds"`
+}
+
+func NewVerifyMacAging(inputs map[string]any) (test.Test, error) {
+	t := &VerifyMacAging{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyMacAging",
+			TestDescription: "Verify MAC address table aging time",
+			TestCategories:  []string{"interfaces", "l2"},
+			TestSeverity:    test.SeverityMinor,
+		},
+	}
+
+	if err := test.GetInt(inputs, "aging_seconds", &t.AgingSeconds); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyMacAging) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show mac address-table aging-time",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get MAC aging time: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected MAC aging output: %v", err)
+		return result, nil
+	}
+
+	agingTime, ok := data["agingTime"].(float64)
+	if !ok {
+		result.Status = test.TestError
+		result.Message = "MAC aging time not reported"
+		return result, nil
+	}
+
+	if int(agingTime) != t.AgingSeconds {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("MAC aging time is %d seconds, expected %d", int(agingTime), t.AgingSeconds)
+	} else {
+		result.Message = fmt.Sprintf("MAC aging time is %d seconds", t.AgingSeconds)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyMacAging) ValidateInput(input any) error {
+	if t.AgingSeconds <= 0 {
+		return fmt.Errorf("aging_seconds must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package interfaces
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// Shape of `show mac address-table count`.
+const showMacCount = `{"vlanCounts": {
+  "10": {"dynamic": 120, "unicast": 120, "multicast": 0},
+  "20": {"dynamic": 4500, "unicast": 4500, "multicast": 2}
+}, "totalCount": 4622}`
+
+func TestVerifyMacTableSize(t *testing.T) {
+	cases := []struct {
+		name   string
+		vlans  []any
+		status test.TestStatus
+		want   string
+	}{
+		{"within bounds", []any{map[string]any{"vlan": 10, "min": 1, "max": 500}},
+			test.TestSuccess, "Learned MAC counts within bounds for 1 VLANs"},
+		{"over max", []any{
+			map[string]any{"vlan": 20, "max": 2000},
+			map[string]any{"vlan": 10, "min": 1, "max": 500},
+		}, test.TestFailure, "MAC table size issues: VLAN 20: 4500 learned MACs (max 2000)"},
+		{"missing VLAN below min", []any{map[string]any{"vlan": 30, "min": 1}},
+			test.TestFailure, "VLAN 30: 0 learned MACs (min 1)"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyMacTableSize(map[string]any{"vlans": tc.vlans})
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show mac address-table count", showMacCount)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyMacTableSizeValidateInput(t *testing.T) {
+	tst, err := NewVerifyMacTableSize(map[string]any{
+		"vlans": []any{map[string]any{"vlan": 10, "min": 50, "max": 10}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected min > max to be rejected")
+	}
+}
+
+func TestVerifyMacAging(t *testing.T) {
+	cases := []struct {
+		name   string
+		aging  int
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{"matches", 300, `{"agingTime": 300}`, test.TestSuccess, "MAC aging time is 300 seconds"},
+		{"wrong timer", 300, `{"agingTime": 1800}`, test.TestFailure, "MAC aging time is 1800 seconds, expected 300"},
+		{"not reported", 300, `{}`, test.TestError, "MAC aging time not reported"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyMacAging(map[string]any{"aging_seconds": tc.aging})
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show mac address-table aging-time", tc.output)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
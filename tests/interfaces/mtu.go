@@ -0,0 +1,185 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyInterfacesMTU verifies interface MTUs, since a mismatch silently
+// blackholes packets larger than the smaller MTU.
+//
+// Every Ethernet and Port-Channel interface in `show interfaces` must have
+// `default_mtu` (9214, the EOS jumbo maximum, unless set) unless it is listed
+// in `ignored_interfaces`. `overrides` sets the MTU for individual
+// interfaces and may name any interface, such as a Vlan or Management
+// interface outside the default set.
+//
+// Expected Results:
+//   - Success: Every checked interface has its expected MTU.
+//   - Failure: An interface has a different MTU, or an overridden interface is missing.
+//   - Error: Unable to retrieve interface information.
+//
+// Example YAML configuration:
+//   - name: "VerifyInterfacesMTU"
+//     module: "interfaces"
+//     inputs:
+//     default_mtu: 9214
+//     overrides:
+//     Ethernet48: 1500
+//     Vlan100: 9164
+//     ignored_interfaces:
+//   - "Ethernet47"
+type VerifyInterfacesMTU struct {
+	test.BaseTest
+	DefaultMTU        int            `yaml:"default_mtu,omitempty" json:"default_mtu,omitempty"`
+	Overrides         map[string]int `yaml:"overrides,omitempty" json:"overrides,omitempty"`
+	IgnoredInterfaces []string       `yaml:"ignored_interfaces,omitempty" json:"ignored_interfaces,omitempty"`
+}
+
+// defaultFabricMTU is the largest MTU EOS accepts on Ethernet interfaces.
+const defaultFabricMTU = 9214
+
+func NewVerifyInterfacesMTU(inputs map[string]any) (test.Test, error) {
+	t := &VerifyInterfacesMTU{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyInterfacesMTU",
+			TestDescription: "Verify interface MTUs match the expected values",
+			TestCategories:  []string{"interfaces"},
+		},
+		DefaultMTU: defaultFabricMTU,
+	}
+
+	if inputs != nil {
+		if err := test.GetInt(inputs, "default_mtu", &t.DefaultMTU); err != nil {
+			return nil, err
+		}
+		if raw, ok := inputs["overrides"]; ok {
+			overrides, ok := raw.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("overrides: expected map, got %T", raw)
+			}
+			t.Overrides = make(map[string]int, len(overrides))
+			for name := range overrides {
+				var mtu int
+				if err := test.GetInt(overrides, name, &mtu); err != nil {
+					return nil, fmt.Errorf("overrides.%w", err)
+				}
+				t.Overrides[name] = mtu
+			}
+		}
+		if err := test.GetStringSlice(inputs, "ignored_interfaces", &t.IgnoredInterfaces); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyInterfacesMTU) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show interfaces",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get interfaces: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected interfaces output: %v", err)
+		return result, nil
+	}
+
+	checked, issues := t.checkMTUs(parseInterfaceMTUs(data))
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Interface MTU mismatches: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("MTU verified on %d interfaces", checked)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyInterfacesMTU) ValidateInput(input any) error {
+	if t.DefaultMTU < 68 || t.DefaultMTU > 65535 {
+		return fmt.Errorf("default_mtu must be between 68 and 65535, got %d", t.DefaultMTU)
+	}
+	for name, mtu := range t.Overrides {
+		if mtu < 68 || mtu > 65535 {
+			return fmt.Errorf("overrides.%s: MTU must be between 68 and 65535, got %d", name, mtu)
+		}
+	}
+	return nil
+}
+
+func parseInterfaceMTUs(data map[string]any) map[string]int {
+	mtus := map[string]int{}
+	interfaces, _ := data["interfaces"].(map[string]any)
+	for name, raw := range interfaces {
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if mtu, ok := entry["mtu"].(float64); ok {
+			mtus[name] = int(mtu)
+		}
+	}
+	return mtus
+}
+
+// checkMTUs returns how many interfaces were checked and the mismatches,
+// in interface-name order.
+func (t *VerifyInterfacesMTU) checkMTUs(mtus map[string]int) (int, []string) {
+	ignored := make(map[string]bool, len(t.IgnoredInterfaces))
+	for _, name := range t.IgnoredInterfaces {
+		ignored[name] = true
+	}
+
+	expected := map[string]int{}
+	for name := range mtus {
+		if !ignored[name] && (strings.HasPrefix(name, "Ethernet") || strings.HasPrefix(name, "Port-Channel")) {
+			expected[name] = t.DefaultMTU
+		}
+	}
+	for name, mtu := range t.Overrides {
+		expected[name] = mtu
+	}
+
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var issues []string
+	for _, name := range names {
+		got, ok := mtus[name]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s: not found", name))
+			continue
+		}
+		if got != expected[name] {
+			issues = append(issues, fmt.Sprintf("%s: MTU %d, expected %d", name, got, expected[name]))
+		}
+	}
+	return len(names), issues
+}
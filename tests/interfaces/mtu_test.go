@@ -0,0 +1,75 @@
+package interfaces
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// Shape of `show interfaces`, trimmed to the fields the test reads.
+const showInterfacesMTU = `{"interfaces": {
+  "Ethernet1": {"mtu": 9214},
+  "Ethernet2": {"mtu": 1500},
+  "Ethernet48": {"mtu": 1500},
+  "Port-Channel10": {"mtu": 9214},
+  "Management1": {"mtu": 1500},
+  "Loopback0": {"mtu": 65535},
+  "Vlan100": {"mtu": 9164}
+}}`
+
+func TestVerifyInterfacesMTU(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"wrong MTU", nil, test.TestFailure,
+			"Interface MTU mismatches: Ethernet2: MTU 1500, expected 9214; Ethernet48: MTU 1500, expected 9214"},
+		{"overrides and ignores", map[string]any{
+			"overrides":          map[string]any{"Ethernet48": 1500, "Vlan100": 9164},
+			"ignored_interfaces": []any{"Ethernet2"},
+		}, test.TestSuccess, "MTU verified on 4 interfaces"},
+		{"override mismatch", map[string]any{
+			"overrides":          map[string]any{"Ethernet48": 1500, "Vlan100": 9214},
+			"ignored_interfaces": []any{"Ethernet2"},
+		}, test.TestFailure, "Interface MTU mismatches: Vlan100: MTU 9164, expected 9214"},
+		{"custom default", map[string]any{"default_mtu": 1500, "overrides": map[string]any{"Ethernet1": 9214, "Port-Channel10": 9214}},
+			test.TestSuccess, "MTU verified on 4 interfaces"},
+		{"override of missing interface", map[string]any{"default_mtu": 1500, "overrides": map[string]any{
+			"Ethernet1": 9214, "Port-Channel10": 9214, "Vlan200": 9164}}, test.TestFailure, "Vlan200: not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyInterfacesMTU(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show interfaces", showInterfacesMTU)
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+				t.Errorf("got %v %q, want %v containing %q", res.Status, res.Message, tc.status, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyInterfacesMTU_Inputs(t *testing.T) {
+	if _, err := NewVerifyInterfacesMTU(map[string]any{"overrides": map[string]any{"Ethernet1": "jumbo"}}); err == nil ||
+		!strings.Contains(err.Error(), "overrides.Ethernet1: expected number") {
+		t.Errorf("constructor error = %v, want overrides.Ethernet1 type error", err)
+	}
+	tst, _ := NewVerifyInterfacesMTU(map[string]any{"overrides": map[string]any{"Ethernet1": 70000}})
+	if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), "overrides.Ethernet1") {
+		t.Errorf("ValidateInput = %v, want out-of-range override", err)
+	}
+}
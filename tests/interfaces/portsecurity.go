@@ -0,0 +1,191 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyPortSecurity verifies port-security settings and state on access ports.
+//
+// The test performs the following checks for each specified interface:
+//  1. Confirms port-security is enabled (the interface appears in `show port-security`).
+//  2. Validates the maximum secure address count equals `max_macs`, when set.
+//  3. Validates the violation action (shutdown, protect, restrict), when set.
+//  4. Reports interfaces that have recorded security violations.
+//
+// Expected Results:
+//   - Success: Every interface has port-security enabled as configured and no violations.
+//   - Failure: Port-security is disabled, misconfigured, or the port is in violation.
+//   - Error: Unable to retrieve port-security information.
+//
+// Example YAML configuration:
+//   - name: "VerifyPortSecurity"
+//     module: "interfaces"
+//     inputs:
+//     interfaces:
+//   - interface: "Ethernet5"
+//     max_macs: 2
+//     violation_action: "shutdown"
+//   - interface: "Ethernet6"
+type VerifyPortSecurity struct {
+	test.BaseTest
+	Interfaces []PortSecurityInterface `yaml:"interfaces" json:"interfaces"`
+}
+
+type PortSecurityInterface struct {
+	Interface       string `yaml:"interface" json:"interface"`
+	MaxMacs         int    `yaml:"max_macs,omitempty" json:"max_macs,omitempty"`
+	ViolationAction string `yaml:"violation_action,omitempty" json:"violation_action,omitempty"`
+}
+
+func NewVerifyPortSecurity(inputs map[string]any) (test.Test, error) {
+	t := &VerifyPortSecurity{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyPortSecurity",
+			TestDescription: "Verify port-security configuration and violation state",
+			TestCategories:  []string{"interfaces", "security"},
+		},
+	}
+
+	if inputs != nil {
+		if interfaces, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range interfaces {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := PortSecurityInterface{}
+				if err := test.GetString(intfMap, "interface", &intf.Interface); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if err := test.GetInt(intfMap, "max_macs", &intf.MaxMacs); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if err := test.GetString(intfMap, "violation_action", &intf.ViolationAction); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyPortSecurity) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show port-security",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get port-security status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected port-security output: %v", err)
+		return result, nil
+	}
+
+	issues := checkPortSecurity(parsePortSecurity(data), t.Interfaces)
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Port-security issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Port-security verified on %d interfaces", len(t.Interfaces))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyPortSecurity) ValidateInput(input any) error {
+	if len(t.Interfaces) == 0 {
+		return fmt.Errorf("at least one interface must be specified")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Interface == "" {
+			return fmt.Errorf("interfaces[%d]: interface must be specified", i)
+		}
+		if intf.MaxMacs < 0 {
+			return fmt.Errorf("interfaces[%d]: max_macs must not be negative", i)
+		}
+		switch strings.ToLower(intf.ViolationAction) {
+		case "", "shutdown", "protect", "restrict":
+		default:
+			return fmt.Errorf("interfaces[%d]: violation_action must be shutdown, protect or restrict, got %q", i, intf.ViolationAction)
+		}
+	}
+	return nil
+}
+
+type portSecurityInfo struct {
+	MaxSecureAddr      int
+	CurrentAddr        int
+	SecurityViolations int
+	Action             string
+}
+
+// parsePortSecurity indexes `show port-security` by interface. Only
+// interfaces with port-security enabled are listed by EOS.
+func parsePortSecurity(data map[string]any) map[string]portSecurityInfo {
+	ports := map[string]portSecurityInfo{}
+	interfaces, _ := data["interfaces"].(map[string]any)
+	for name, raw := range interfaces {
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		info := portSecurityInfo{}
+		if v, ok := entry["maxSecureAddr"].(float64); ok {
+			info.MaxSecureAddr = int(v)
+		}
+		if v, ok := entry["currentAddr"].(float64); ok {
+			info.CurrentAddr = int(v)
+		}
+		if v, ok := entry["securityViolations"].(float64); ok {
+			info.SecurityViolations = int(v)
+		}
+		info.Action, _ = entry["securityAction"].(string)
+		ports[name] = info
+	}
+	return ports
+}
+
+func checkPortSecurity(ports map[string]portSecurityInfo, expected []PortSecurityInterface) []string {
+	issues := []string{}
+	for _, want := range expected {
+		info, ok := ports[want.Interface]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s: port-security not enabled", want.Interface))
+			continue
+		}
+		if want.MaxMacs > 0 && info.MaxSecureAddr != want.MaxMacs {
+			issues = append(issues, fmt.Sprintf("%s: max MACs %d, expected %d", want.Interface, info.MaxSecureAddr, want.MaxMacs))
+		}
+		if want.ViolationAction != "" && !strings.EqualFold(info.Action, want.ViolationAction) {
+			issues = append(issues, fmt.Sprintf("%s: violation action %s, expected %s", want.Interface, info.Action, want.ViolationAction))
+		}
+		if info.SecurityViolations > 0 {
+			issues = append(issues, fmt.Sprintf("%s: in violation (%d violations, %d/%d MACs)",
+				want.Interface, info.SecurityViolations, info.CurrentAddr, info.MaxSecureAddr))
+		}
+	}
+	return issues
+}
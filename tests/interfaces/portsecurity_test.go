@@ -0,0 +1,71 @@
+package interfaces
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// Shape of `show port-security`.
+const showPortSecurity = `{"interfaces": {
+  "Ethernet5": {"maxSecureAddr": 2, "currentAddr": 2, "securityViolations": 3, "securityAction": "shutdown"},
+  "Ethernet6": {"maxSecureAddr": 4, "currentAddr": 1, "securityViolations": 0, "securityAction": "protect"}
+}}`
+
+func TestVerifyPortSecurity(t *testing.T) {
+	intf := func(name string, maxMacs int, action string) map[string]any {
+		m := map[string]any{"interface": name}
+		if maxMacs > 0 {
+			m["max_macs"] = maxMacs
+		}
+		if action != "" {
+			m["violation_action"] = action
+		}
+		return m
+	}
+	cases := []struct {
+		name   string
+		inputs []any
+		status test.TestStatus
+		want   string
+	}{
+		{"compliant", []any{intf("Ethernet6", 4, "protect")},
+			test.TestSuccess, "Port-security verified on 1 interfaces"},
+		{"in violation", []any{intf("Ethernet5", 2, "shutdown"), intf("Ethernet6", 4, "protect")},
+			test.TestFailure, "Port-security issues: Ethernet5: in violation (3 violations, 2/2 MACs)"},
+		{"misconfigured", []any{intf("Ethernet6", 1, "shutdown")},
+			test.TestFailure, "Port-security issues: Ethernet6: max MACs 4, expected 1; Ethernet6: violation action protect, expected shutdown"},
+		{"not enabled", []any{intf("Ethernet7", 0, "")},
+			test.TestFailure, "Ethernet7: port-security not enabled"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyPortSecurity(map[string]any{"interfaces": tc.inputs})
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show port-security", showPortSecurity)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyPortSecurityValidateInput(t *testing.T) {
+	tst, err := NewVerifyPortSecurity(map[string]any{
+		"interfaces": []any{map[string]any{"interface": "Ethernet5", "violation_action": "disable"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected error for unknown violation_action")
+	}
+}
@@ -0,0 +1,239 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyInterfacesSpeed verifies the negotiated speed, duplex and
+// auto-negotiation state of interfaces.
+//
+// The test performs the following checks for each specified interface:
+//  1. Confirms the interface exists in `show interfaces`.
+//  2. Validates its bandwidth equals `speed`, given in Gbps (0.1, 1, 2.5, 10, 100, ...),
+//     so a 10G link that came up at 1G is reported.
+//  3. Validates the duplex (full or half), when set.
+//  4. Validates auto-negotiation succeeded (`auto: true`) or is not in use
+//     (`auto: false`), when set.
+//
+// Expected Results:
+//   - Success: Every interface runs at the expected speed, duplex and auto-negotiation state.
+//   - Failure: An interface is missing or any of those differ.
+//   - Error: Unable to retrieve interface information.
+//
+// Example YAML configuration:
+//   - name: "VerifyInterfacesSpeed"
+//     module: "interfaces"
+//     inputs:
+//     interfaces:
+//   - interface: "Ethernet1"
+//     speed: 10
+//     duplex: "full"
+//     auto: false
+//   - interface: "Ethernet49/1"
+//     speed: 100
+//     auto: true
+type VerifyInterfacesSpeed struct {
+	test.BaseTest
+	Interfaces []InterfaceSpeed `yaml:"interfaces" json:"interfaces"`
+}
+
+type InterfaceSpeed struct {
+	Interface string  `yaml:"interface" json:"interface"`
+	Speed     float64 `yaml:"speed" json:"speed"` // Gbps
+	Duplex    string  `yaml:"duplex,omitempty" json:"duplex,omitempty"`
+	Auto      *bool   `yaml:"auto,omitempty" json:"auto,omitempty"`
+}
+
+func NewVerifyInterfacesSpeed(inputs map[string]any) (test.Test, error) {
+	t := &VerifyInterfacesSpeed{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyInterfacesSpeed",
+			TestDescription: "Verify interface speed, duplex and auto-negotiation",
+			TestCategories:  []string{"interfaces"},
+		},
+	}
+
+	if inputs != nil {
+		if interfaces, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range interfaces {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := InterfaceSpeed{}
+				if err := test.GetString(intfMap, "interface", &intf.Interface); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				switch speed := intfMap["speed"].(type) {
+				case nil:
+				case float64:
+					intf.Speed = speed
+				case int:
+					intf.Speed = float64(speed)
+				default:
+					return nil, fmt.Errorf("interfaces[%d]: speed: expected number, got %T", i, speed)
+				}
+				if err := test.GetString(intfMap, "duplex", &intf.Duplex); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if _, ok := intfMap["auto"]; ok {
+					var auto bool
+					if err := test.GetBool(intfMap, "auto", &auto); err != nil {
+						return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+					}
+					intf.Auto = &auto
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyInterfacesSpeed) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show interfaces",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get interfaces: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected interfaces output: %v", err)
+		return result, nil
+	}
+
+	issues := checkInterfaceSpeeds(parseInterfaceLinks(data), t.Interfaces)
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Interface speed issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Speed, duplex and auto-negotiation verified on %d interfaces", len(t.Interfaces))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyInterfacesSpeed) ValidateInput(input any) error {
+	if len(t.Interfaces) == 0 {
+		return fmt.Errorf("at least one interface must be specified")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Interface == "" {
+			return fmt.Errorf("interfaces[%d]: interface must be specified", i)
+		}
+		if intf.Speed <= 0 {
+			return fmt.Errorf("interfaces[%d]: speed must be a positive number of Gbps", i)
+		}
+		if _, ok := eosDuplex(intf.Duplex); !ok {
+			return fmt.Errorf("interfaces[%d]: duplex must be full or half, got %q", i, intf.Duplex)
+		}
+	}
+	return nil
+}
+
+// interfaceLink is the per-interface subset of `show interfaces`.
+type interfaceLink struct {
+	Bandwidth     float64 // bps
+	Duplex        string  // duplexFull, duplexHalf, ...
+	AutoNegotiate string  // success, off, unknown, ...
+}
+
+func parseInterfaceLinks(data map[string]any) map[string]interfaceLink {
+	links := map[string]interfaceLink{}
+	interfaces, _ := data["interfaces"].(map[string]any)
+	for name, raw := range interfaces {
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		link := interfaceLink{}
+		link.Bandwidth, _ = entry["bandwidth"].(float64)
+		link.Duplex, _ = entry["duplex"].(string)
+		link.AutoNegotiate, _ = entry["autoNegotiate"].(string)
+		links[name] = link
+	}
+	return links
+}
+
+func checkInterfaceSpeeds(links map[string]interfaceLink, expected []InterfaceSpeed) []string {
+	var issues []string
+	for _, want := range expected {
+		link, ok := links[want.Interface]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s: not found", want.Interface))
+			continue
+		}
+		wantBps := want.Speed * 1e9
+		if math.Abs(link.Bandwidth-wantBps) > 0.5 {
+			issues = append(issues, fmt.Sprintf("%s: speed %s, expected %s",
+				want.Interface, formatSpeed(link.Bandwidth), formatSpeed(wantBps)))
+		}
+		if duplex, _ := eosDuplex(want.Duplex); duplex != "" && link.Duplex != duplex {
+			issues = append(issues, fmt.Sprintf("%s: duplex %s, expected %s", want.Interface, link.Duplex, duplex))
+		}
+		if want.Auto != nil {
+			negotiated := link.AutoNegotiate == "success"
+			switch {
+			case *want.Auto && !negotiated:
+				issues = append(issues, fmt.Sprintf("%s: auto-negotiation %s, expected success", want.Interface, autoNegLabel(link.AutoNegotiate)))
+			case !*want.Auto && negotiated:
+				issues = append(issues, fmt.Sprintf("%s: auto-negotiated, expected a fixed speed", want.Interface))
+			}
+		}
+	}
+	return issues
+}
+
+// eosDuplex maps a duplex input to the value `show interfaces` reports.
+// Empty input means the duplex is not checked.
+func eosDuplex(duplex string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(duplex)) {
+	case "":
+		return "", true
+	case "full", "duplexfull":
+		return "duplexFull", true
+	case "half", "duplexhalf":
+		return "duplexHalf", true
+	}
+	return "", false
+}
+
+func autoNegLabel(state string) string {
+	if state == "" {
+		return "not reported"
+	}
+	return state
+}
+
+// formatSpeed renders a bandwidth in bps as EOS shows link speeds: 1Gbps,
+// 2.5Gbps, 100Mbps.
+func formatSpeed(bps float64) string {
+	if bps >= 1e9 {
+		return strconv.FormatFloat(bps/1e9, 'f', -1, 64) + "Gbps"
+	}
+	return strconv.FormatFloat(bps/1e6, 'f', -1, 64) + "Mbps"
+}
@@ -0,0 +1,90 @@
+package interfaces
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// Shape of `show interfaces`, trimmed to the fields the test reads.
+const showInterfacesSpeed = `{"interfaces": {
+  "Ethernet1": {"bandwidth": 1000000000, "duplex": "duplexFull", "autoNegotiate": "success"},
+  "Ethernet2": {"bandwidth": 10000000000, "duplex": "duplexFull", "autoNegotiate": "off"},
+  "Ethernet3": {"bandwidth": 100000000, "duplex": "duplexHalf", "autoNegotiate": "success"},
+  "Ethernet4": {"bandwidth": 2500000000, "duplex": "duplexFull", "autoNegotiate": "unknown"}
+}}`
+
+func TestVerifyInterfacesSpeed(t *testing.T) {
+	cases := []struct {
+		name       string
+		interfaces []any
+		status     test.TestStatus
+		want       string
+	}{
+		{"all match", []any{
+			map[string]any{"interface": "Ethernet2", "speed": 10, "duplex": "full", "auto": false},
+			map[string]any{"interface": "Ethernet3", "speed": 0.1, "duplex": "half", "auto": true},
+			map[string]any{"interface": "Ethernet4", "speed": 2.5},
+		}, test.TestSuccess, "verified on 3 interfaces"},
+		{"10G negotiated to 1G", []any{
+			map[string]any{"interface": "Ethernet1", "speed": 10, "duplex": "full"},
+		}, test.TestFailure, "Interface speed issues: Ethernet1: speed 1Gbps, expected 10Gbps"},
+		{"duplex mismatch", []any{
+			map[string]any{"interface": "Ethernet3", "speed": 0.1, "duplex": "full"},
+		}, test.TestFailure, "Ethernet3: duplex duplexHalf, expected duplexFull"},
+		{"autoneg expected", []any{
+			map[string]any{"interface": "Ethernet4", "speed": 2.5, "auto": true},
+		}, test.TestFailure, "Ethernet4: auto-negotiation unknown, expected success"},
+		{"fixed speed expected", []any{
+			map[string]any{"interface": "Ethernet1", "speed": 1, "auto": false},
+		}, test.TestFailure, "Ethernet1: auto-negotiated, expected a fixed speed"},
+		{"missing interface", []any{
+			map[string]any{"interface": "Ethernet9", "speed": 10},
+		}, test.TestFailure, "Ethernet9: not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyInterfacesSpeed(map[string]any{"interfaces": tc.interfaces})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show interfaces", showInterfacesSpeed)
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+				t.Errorf("got %v %q, want %v containing %q", res.Status, res.Message, tc.status, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyInterfacesSpeed_ValidateInput(t *testing.T) {
+	for _, tc := range []struct {
+		intf map[string]any
+		want string
+	}{
+		{map[string]any{"speed": 10}, "interface must be specified"},
+		{map[string]any{"interface": "Ethernet1"}, "speed must be a positive number"},
+		{map[string]any{"interface": "Ethernet1", "speed": 10, "duplex": "auto"}, `duplex must be full or half, got "auto"`},
+	} {
+		tst, err := NewVerifyInterfacesSpeed(map[string]any{"interfaces": []any{tc.intf}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("ValidateInput(%v) = %v, want %q", tc.intf, err, tc.want)
+		}
+	}
+
+	if _, err := NewVerifyInterfacesSpeed(map[string]any{"interfaces": []any{map[string]any{"interface": "Ethernet1", "speed": "10G"}}}); err == nil {
+		t.Error("expected constructor to reject a string speed")
+	}
+}
@@ -52,6 +52,7 @@ func NewVerifyInterfacesStatus(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyInterfacesStatus",
 			TestDescription: "Verify interface status and line protocol status",
 			TestCategories:  []string{"interfaces", "status"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
@@ -0,0 +1,157 @@
+package l3
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyArpEntries verifies specific IP-to-MAC ARP entries are resolved.
+//
+// This test performs the following checks for each specified entry:
+//  1. Confirms the IP has an ARP entry in the given VRF (default VRF when omitted).
+//  2. Validates the entry is not incomplete.
+//  3. If `mac` is set, validates the resolved MAC address (any common notation).
+//  4. If `interface` is set, validates the entry was learned on that interface.
+//
+// Expected Results:
+//   - Success: Every expected ARP entry is resolved with the expected MAC and interface.
+//   - Failure: An entry is missing, incomplete, or has a different MAC or interface.
+//   - Error: Unable to retrieve the ARP table from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyArpEntries"
+//     module: "l3"
+//     inputs:
+//     entries:
+//   - ip: "10.0.0.1"
+//     mac: "00:1c:73:00:00:01"
+//     interface: "Ethernet1"
+//   - ip: "192.168.10.1"
+//     vrf: "PROD"
+type VerifyArpEntries struct {
+	test.BaseTest
+	Entries []ArpEntry `yaml:"entries" json:"entries"`
+}
+
+type ArpEntry struct {
+	IP        string `yaml:"ip" json:"ip"`
+	MAC       string `yaml:"mac,omitempty" json:"mac,omitempty"`
+	Interface string `yaml:"interface,omitempty" json:"interface,omitempty"`
+	VRF       string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyArpEntries(inputs map[string]any) (test.Test, error) {
+	t := &VerifyArpEntries{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyArpEntries",
+			TestDescription: "Verify expected ARP entries are resolved",
+			TestCategories:  []string{"l3", "arp"},
+		},
+	}
+
+	if inputs != nil {
+		if entries, ok := inputs["entries"].([]any); ok {
+			for i, item := range entries {
+				entryMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("entries[%d]: expected map, got %T", i, item)
+				}
+				entry, err := parseNeighborInput(entryMap, "ip")
+				if err != nil {
+					return nil, fmt.Errorf("entries[%d]: %w", i, err)
+				}
+				t.Entries = append(t.Entries, ArpEntry{
+					IP:        entry.Address,
+					MAC:       entry.MAC,
+					Interface: entry.Interface,
+					VRF:       entry.VRF,
+				})
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyArpEntries) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	tables := map[string]map[string]neighborEntry{}
+	issues := []string{}
+
+	for i, expected := range t.Entries {
+		if test.Cancelled(ctx, result, i, len(t.Entries), "entries", issues) {
+			return result, nil
+		}
+		table, ok := tables[expected.VRF]
+		if !ok {
+			cmd := device.Command{
+				Template: fmt.Sprintf("show arp vrf %s", expected.VRF),
+				Format:   "json",
+				UseCache: false,
+			}
+
+			cmdResult, err := dev.Execute(ctx, cmd)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Failed to get ARP table for VRF %s: %v", expected.VRF, err)
+				return result, nil
+			}
+
+			data, err := test.AsMap(cmdResult.Output)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Unexpected ARP output: %v", err)
+				return result, nil
+			}
+			table = parseNeighborTable(data["ipV4Neighbors"])
+			tables[expected.VRF] = table
+		}
+
+		label := fmt.Sprintf("%s (VRF %s)", expected.IP, expected.VRF)
+		entry, ok := table[canonicalIP(expected.IP)]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("ARP entry %s not found", label))
+			continue
+		}
+		if entry.incomplete() {
+			issues = append(issues, fmt.Sprintf("ARP entry %s is incomplete", label))
+			continue
+		}
+		issues = append(issues, neighborMismatches("ARP entry "+label, expected.MAC, expected.Interface, entry)...)
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("ARP issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d ARP entries resolved", len(t.Entries))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyArpEntries) ValidateInput(input any) error {
+	if len(t.Entries) == 0 {
+		return fmt.Errorf("at least one ARP entry must be specified")
+	}
+	for i, entry := range t.Entries {
+		if ip := net.ParseIP(entry.IP); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("entries[%d]: ip %q is not a valid IPv4 address", i, entry.IP)
+		}
+		if entry.MAC != "" && normalizeMAC(entry.MAC) == "" {
+			return fmt.Errorf("entries[%d]: invalid mac %q", i, entry.MAC)
+		}
+	}
+	return nil
+}
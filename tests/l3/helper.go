@@ -0,0 +1,190 @@
+package l3
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyIPHelperAddresses verifies the DHCP relay (ip helper-address) entries on interfaces.
+//
+// This test performs the following checks for each specified interface:
+//  1. Confirms the interface exists in `show ip interface`.
+//  2. Reports every expected helper address that is not configured.
+//  3. Reports every configured helper address that is not expected.
+//
+// An empty `helpers` list asserts the interface has no helper addresses.
+//
+// Expected Results:
+//   - Success: Every interface has exactly the expected helper addresses.
+//   - Failure: An interface is missing, or has missing or extra helper addresses.
+//   - Error: Unable to retrieve interface information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyIPHelperAddresses"
+//     module: "l3"
+//     inputs:
+//     interfaces:
+//   - interface: "Vlan10"
+//     helpers: ["10.100.0.10", "10.100.0.11"]
+//   - interface: "Vlan20"
+//     helpers: []
+type VerifyIPHelperAddresses struct {
+	test.BaseTest
+	Interfaces []HelperInterface `yaml:"interfaces" json:"interfaces"`
+}
+
+type HelperInterface struct {
+	Interface string   `yaml:"interface" json:"interface"`
+	Helpers   []string `yaml:"helpers" json:"helpers"`
+}
+
+func NewVerifyIPHelperAddresses(inputs map[string]any) (test.Test, error) {
+	t := &VerifyIPHelperAddresses{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyIPHelperAddresses",
+			TestDescription: "Verify DHCP relay helper addresses on interfaces",
+			TestCategories:  []string{"l3", "dhcp"},
+		},
+	}
+
+	if inputs != nil {
+		if interfaces, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range interfaces {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := HelperInterface{}
+				if err := test.GetString(intfMap, "interface", &intf.Interface); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if err := test.GetStringSlice(intfMap, "helpers", &intf.Helpers); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyIPHelperAddresses) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show ip interface",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get IP interfaces: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected IP interface output: %v", err)
+		return result, nil
+	}
+	interfaces, _ := data["interfaces"].(map[string]any)
+
+	issues := []string{}
+	for _, expected := range t.Interfaces {
+		intfData, ok := interfaces[expected.Interface].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s: interface not found", expected.Interface))
+			continue
+		}
+		missing, extra := helperDiff(expected.Helpers, parseHelperAddresses(intfData["helperAddresses"]))
+		for _, addr := range missing {
+			issues = append(issues, fmt.Sprintf("%s: missing helper %s", expected.Interface, addr))
+		}
+		for _, addr := range extra {
+			issues = append(issues, fmt.Sprintf("%s: unexpected helper %s", expected.Interface, addr))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("IP helper issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Helper addresses match on %d interfaces", len(t.Interfaces))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyIPHelperAddresses) ValidateInput(input any) error {
+	if len(t.Interfaces) == 0 {
+		return fmt.Errorf("at least one interface must be specified")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Interface == "" {
+			return fmt.Errorf("interfaces[%d]: interface must be specified", i)
+		}
+		for _, addr := range intf.Helpers {
+			if net.ParseIP(addr) == nil {
+				return fmt.Errorf("interfaces[%d]: helper %q is not a valid IP address", i, addr)
+			}
+		}
+	}
+	return nil
+}
+
+// parseHelperAddresses accepts both the plain string list and the
+// [{"address": "..."}] form of helperAddresses.
+func parseHelperAddresses(raw any) []string {
+	list, _ := raw.([]any)
+	addrs := []string{}
+	for _, item := range list {
+		switch v := item.(type) {
+		case string:
+			addrs = append(addrs, v)
+		case map[string]any:
+			if addr, ok := v["address"].(string); ok {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	return addrs
+}
+
+// helperDiff returns the expected addresses not configured and the
+// configured addresses not expected, each sorted.
+func helperDiff(expected, configured []string) (missing, extra []string) {
+	have := map[string]bool{}
+	for _, addr := range configured {
+		have[canonicalIP(addr)] = true
+	}
+	want := map[string]bool{}
+	for _, addr := range expected {
+		want[canonicalIP(addr)] = true
+		if !have[canonicalIP(addr)] {
+			missing = append(missing, addr)
+		}
+	}
+	for _, addr := range configured {
+		if !want[canonicalIP(addr)] {
+			extra = append(extra, addr)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+	return missing, extra
+}
@@ -0,0 +1,167 @@
+package l3
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyIPv6Neighbors verifies specific IPv6 neighbor-discovery entries are reachable.
+//
+// This test performs the following checks for each specified entry:
+//  1. Confirms the address has an ND entry in the given VRF (default VRF when omitted).
+//  2. Validates the entry state is reachable (static/permanent entries are accepted).
+//  3. If `mac` is set, validates the resolved MAC address (any common notation).
+//  4. If `interface` is set, validates the entry was learned on that interface.
+//
+// Expected Results:
+//   - Success: Every expected ND entry is reachable with the expected MAC and interface.
+//   - Failure: An entry is missing, stale, incomplete, or has a different MAC or interface.
+//   - Error: Unable to retrieve the IPv6 neighbor table from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyIPv6Neighbors"
+//     module: "l3"
+//     inputs:
+//     neighbors:
+//   - ipv6: "fe80::21c:73ff:fe00:1"
+//     mac: "00:1c:73:00:00:01"
+//     interface: "Ethernet1"
+//   - ipv6: "2001:db8::2"
+//     vrf: "PROD"
+type VerifyIPv6Neighbors struct {
+	test.BaseTest
+	Neighbors []IPv6Neighbor `yaml:"neighbors" json:"neighbors"`
+}
+
+type IPv6Neighbor struct {
+	IPv6      string `yaml:"ipv6" json:"ipv6"`
+	MAC       string `yaml:"mac,omitempty" json:"mac,omitempty"`
+	Interface string `yaml:"interface,omitempty" json:"interface,omitempty"`
+	VRF       string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+// healthyNDStates are the neighbor states that count as resolved. Anything
+// else (stale, delay, probe, incomplete) means reachability is unconfirmed.
+var healthyNDStates = map[string]bool{
+	"reachable": true,
+	"permanent": true,
+}
+
+func NewVerifyIPv6Neighbors(inputs map[string]any) (test.Test, error) {
+	t := &VerifyIPv6Neighbors{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyIPv6Neighbors",
+			TestDescription: "Verify expected IPv6 neighbor entries are reachable",
+			TestCategories:  []string{"l3", "ipv6"},
+		},
+	}
+
+	if inputs != nil {
+		if neighbors, ok := inputs["neighbors"].([]any); ok {
+			for i, item := range neighbors {
+				neighborMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("neighbors[%d]: expected map, got %T", i, item)
+				}
+				entry, err := parseNeighborInput(neighborMap, "ipv6")
+				if err != nil {
+					return nil, fmt.Errorf("neighbors[%d]: %w", i, err)
+				}
+				t.Neighbors = append(t.Neighbors, IPv6Neighbor{
+					IPv6:      entry.Address,
+					MAC:       entry.MAC,
+					Interface: entry.Interface,
+					VRF:       entry.VRF,
+				})
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyIPv6Neighbors) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	tables := map[string]map[string]neighborEntry{}
+	issues := []string{}
+
+	for i, expected := range t.Neighbors {
+		if test.Cancelled(ctx, result, i, len(t.Neighbors), "neighbors", issues) {
+			return result, nil
+		}
+		table, ok := tables[expected.VRF]
+		if !ok {
+			cmd := device.Command{
+				Template: fmt.Sprintf("show ipv6 neighbors vrf %s", expected.VRF),
+				Format:   "json",
+				UseCache: false,
+			}
+
+			cmdResult, err := dev.Execute(ctx, cmd)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Failed to get IPv6 neighbors for VRF %s: %v", expected.VRF, err)
+				return result, nil
+			}
+
+			data, err := test.AsMap(cmdResult.Output)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Unexpected IPv6 neighbor output: %v", err)
+				return result, nil
+			}
+			table = parseNeighborTable(data["ipV6Neighbors"])
+			tables[expected.VRF] = table
+		}
+
+		label := fmt.Sprintf("%s (VRF %s)", expected.IPv6, expected.VRF)
+		entry, ok := table[canonicalIP(expected.IPv6)]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("ND entry %s not found", label))
+			continue
+		}
+		if entry.incomplete() {
+			issues = append(issues, fmt.Sprintf("ND entry %s is incomplete", label))
+			continue
+		}
+		if state := strings.ToLower(entry.State); !healthyNDStates[state] {
+			issues = append(issues, fmt.Sprintf("ND entry %s is %s, expected reachable", label, state))
+		}
+		issues = append(issues, neighborMismatches("ND entry "+label, expected.MAC, expected.Interface, entry)...)
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("IPv6 neighbor issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d IPv6 neighbors reachable", len(t.Neighbors))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyIPv6Neighbors) ValidateInput(input any) error {
+	if len(t.Neighbors) == 0 {
+		return fmt.Errorf("at least one IPv6 neighbor must be specified")
+	}
+	for i, neighbor := range t.Neighbors {
+		if ip := net.ParseIP(neighbor.IPv6); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("neighbors[%d]: ipv6 %q is not a valid IPv6 address", i, neighbor.IPv6)
+		}
+		if neighbor.MAC != "" && normalizeMAC(neighbor.MAC) == "" {
+			return fmt.Errorf("neighbors[%d]: invalid mac %q", i, neighbor.MAC)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package l3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const arpDefault = `{"ipV4Neighbors": [
+  {"address": "10.0.0.1", "hwAddress": "001c.7300.0001", "interface": "Ethernet1", "age": 0},
+  {"address": "10.0.0.9", "hwAddress": "incomplete", "interface": "Ethernet1", "age": 0},
+  {"address": "10.10.0.2", "hwAddress": "001c.7300.0002", "interface": "Vlan10, Ethernet5", "age": 30}
+], "totalEntries": 3}`
+
+func TestVerifyArpEntries(t *testing.T) {
+	entry := func(m map[string]any) map[string]any {
+		return map[string]any{"entries": []any{m}}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"resolved", entry(map[string]any{"ip": "10.0.0.1", "mac": "00:1c:73:00:00:01", "interface": "Ethernet1"}),
+			test.TestSuccess, "1 ARP entries resolved"},
+		{"svi entry", entry(map[string]any{"ip": "10.10.0.2", "interface": "Vlan10"}), test.TestSuccess, "resolved"},
+		{"incomplete", entry(map[string]any{"ip": "10.0.0.9"}), test.TestFailure,
+			"ARP entry 10.0.0.9 (VRF default) is incomplete"},
+		{"missing", entry(map[string]any{"ip": "10.0.0.77"}), test.TestFailure,
+			"ARP entry 10.0.0.77 (VRF default) not found"},
+		{"mac mismatch", entry(map[string]any{"ip": "10.0.0.1", "mac": "00-1C-73-00-00-FF"}), test.TestFailure,
+			"MAC is 001c.7300.0001, expected 00-1C-73-00-00-FF"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyArpEntries(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show arp vrf default", arpDefault))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeMAC(t *testing.T) {
+	for _, in := range []string{"00:1c:73:00:00:01", "001c.7300.0001", "00-1C-73-00-00-01"} {
+		if got := normalizeMAC(in); got != "00:1c:73:00:00:01" {
+			t.Errorf("normalizeMAC(%q) = %q", in, got)
+		}
+	}
+	if got := normalizeMAC("not-a-mac"); got != "" {
+		t.Errorf("normalizeMAC(invalid) = %q, want empty", got)
+	}
+}
+
+const ndDefault = `{"ipV6Neighbors": [
+  {"address": "fe80::21c:73ff:fe00:1", "hwAddress": "001c.7300.0001", "interface": "Ethernet1", "state": "reachable", "age": 12},
+  {"address": "2001:db8::2", "hwAddress": "001c.7300.0002", "interface": "Ethernet2", "state": "stale", "age": 900},
+  {"address": "2001:db8::3", "hwAddress": "", "interface": "Ethernet3", "state": "incomplete", "age": 0}
+]}`
+
+func TestVerifyIPv6Neighbors(t *testing.T) {
+	neighbor := func(m map[string]any) map[string]any {
+		return map[string]any{"neighbors": []any{m}}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"reachable", neighbor(map[string]any{"ipv6": "FE80:0:0:0:21C:73FF:FE00:1", "mac": "00:1c:73:00:00:01", "interface": "Ethernet1"}),
+			test.TestSuccess, "1 IPv6 neighbors reachable"},
+		{"stale", neighbor(map[string]any{"ipv6": "2001:db8::2"}), test.TestFailure,
+			"ND entry 2001:db8::2 (VRF default) is stale, expected reachable"},
+		{"incomplete", neighbor(map[string]any{"ipv6": "2001:db8::3"}), test.TestFailure,
+			"ND entry 2001:db8::3 (VRF default) is incomplete"},
+		{"missing", neighbor(map[string]any{"ipv6": "2001:db8::99"}), test.TestFailure, "not found"},
+		{"wrong interface", neighbor(map[string]any{"ipv6": "fe80::21c:73ff:fe00:1", "interface": "Ethernet9"}),
+			test.TestFailure, "interface is Ethernet1, expected Ethernet9"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyIPv6Neighbors(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show ipv6 neighbors vrf default", ndDefault))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+const showIPInterface = `{"interfaces": {
+  "Vlan10": {"name": "Vlan10", "lineProtocolStatus": "up", "helperAddresses": ["10.100.0.10", "10.100.0.11"]},
+  "Vlan20": {"name": "Vlan20", "lineProtocolStatus": "up", "helperAddresses": [{"address": "10.100.0.99"}]},
+  "Vlan30": {"name": "Vlan30", "lineProtocolStatus": "up"}
+}}`
+
+func TestVerifyIPHelperAddresses(t *testing.T) {
+	intf := func(name string, helpers ...any) map[string]any {
+		return map[string]any{"interfaces": []any{map[string]any{"interface": name, "helpers": helpers}}}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"match", intf("Vlan10", "10.100.0.11", "10.100.0.10"), test.TestSuccess, "match on 1 interfaces"},
+		{"no helpers expected", intf("Vlan30"), test.TestSuccess, "match"},
+		{"missing helper", intf("Vlan10", "10.100.0.10", "10.100.0.11", "10.100.0.12"), test.TestFailure,
+			"Vlan10: missing helper 10.100.0.12"},
+		{"extra helper", intf("Vlan20"), test.TestFailure, "Vlan20: unexpected helper 10.100.0.99"},
+		{"interface missing", intf("Vlan99", "10.100.0.10"), test.TestFailure, "Vlan99: interface not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyIPHelperAddresses(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show ip interface", showIPInterface))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRouteNextHopReachability(t *testing.T) {
+	route := func(prefix string, nextHops ...string) string {
+		vias := []string{}
+		for _, nh := range nextHops {
+			vias = append(vias, `{"nexthopAddr": "`+nh+`", "interface": "Ethernet1"}`)
+		}
+		return `{"vrfs": {"default": {"routes": {"` + prefix + `": {"routeType": "eBGP", "vias": [` +
+			strings.Join(vias, ", ") + `]}}}}}`
+	}
+	routes := func(prefixes ...string) map[string]any {
+		list := []any{}
+		for _, p := range prefixes {
+			list = append(list, map[string]any{"prefix": p})
+		}
+		return map[string]any{"routes": list}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{"resolved", routes("10.100.0.0/16"), route("10.100.0.0/16", "10.0.0.1"),
+			test.TestSuccess, "All 1 routes have resolved next-hops"},
+		{"arp incomplete", routes("10.100.0.0/16"), route("10.100.0.0/16", "10.0.0.1", "10.0.0.9"),
+			test.TestFailure, "Route 10.100.0.0/16 (VRF default): next-hop 10.0.0.9 ARP entry is incomplete"},
+		{"no arp entry", routes("10.100.0.0/16"), route("10.100.0.0/16", "10.0.0.77"),
+			test.TestFailure, "next-hop 10.0.0.77 has no ARP entry"},
+		{"only covering route", routes("10.100.0.0/16"), route("0.0.0.0/0", "10.0.0.1"),
+			test.TestFailure, "Route 10.100.0.0/16 (VRF default) not found (only covered by 0.0.0.0/0)"},
+		{"connected", routes("10.100.0.0/16"), `{"vrfs": {"default": {"routes": {"10.100.0.0/16": {"routeType": "connected", "vias": [{"interface": "Vlan100"}]}}}}}`,
+			test.TestSuccess, "resolved"},
+		{"ipv6 resolved", routes("2001:db8:100::/48"), route("2001:db8:100::/48", "fe80::21c:73ff:fe00:1"),
+			test.TestSuccess, "All 1 routes have resolved next-hops"},
+		{"ipv6 nd stale", routes("2001:db8:100::/48"), route("2001:db8:100::/48", "2001:db8::2"),
+			test.TestFailure, "Route 2001:db8:100::/48 (VRF default): next-hop 2001:db8::2 ND entry is stale, expected reachable"},
+		{"ipv6 nd incomplete", routes("2001:db8:100::/48"), route("2001:db8:100::/48", "2001:db8::3"),
+			test.TestFailure, "next-hop 2001:db8::3 ND entry is incomplete"},
+		{"ipv6 no nd entry", routes("2001:db8:100::/48"), route("2001:db8:100::/48", "2001:db8::77"),
+			test.TestFailure, "next-hop 2001:db8::77 has no ND entry"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyRouteNextHopReachability(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show ip route vrf default 10.100.0.0/16", tc.output).
+				OnJSON(t, "show ipv6 route vrf default 2001:db8:100::/48", tc.output).
+				OnJSON(t, "show arp vrf default", arpDefault).
+				OnJSON(t, "show ipv6 neighbors vrf default", ndDefault)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+
+	for _, bad := range []string{"10.100.0.0", "2001:db8::"} {
+		tst, err := NewVerifyRouteNextHopReachability(routes(bad))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tst.ValidateInput(nil); err == nil {
+			t.Errorf("prefix %q should be rejected", bad)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package l3
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// neighborInput is the common shape of ARP and IPv6 ND entry inputs.
+// Address holds the IPv4 or IPv6 address.
+type neighborInput struct {
+	Address   string
+	MAC       string
+	Interface string
+	VRF       string
+}
+
+// parseNeighborInput reads an entry map whose address key is addrKey
+// ("ip" for ARP, "ipv6" for ND). VRF defaults to "default".
+func parseNeighborInput(m map[string]any, addrKey string) (neighborInput, error) {
+	entry := neighborInput{VRF: "default"}
+	if err := test.GetString(m, addrKey, &entry.Address); err != nil {
+		return entry, err
+	}
+	if err := test.GetString(m, "mac", &entry.MAC); err != nil {
+		return entry, err
+	}
+	if err := test.GetString(m, "interface", &entry.Interface); err != nil {
+		return entry, err
+	}
+	if err := test.GetString(m, "vrf", &entry.VRF); err != nil {
+		return entry, err
+	}
+	return entry, nil
+}
+
+// neighborMismatches compares the MAC and interface of a resolved entry
+// against the expectation (empty means "don't care") and returns one message
+// per mismatch.
+func neighborMismatches(label, mac, intf string, actual neighborEntry) []string {
+	issues := []string{}
+	if mac != "" && normalizeMAC(mac) != normalizeMAC(actual.MAC) {
+		issues = append(issues, fmt.Sprintf("%s: MAC is %s, expected %s", label, actual.MAC, mac))
+	}
+	if intf != "" && !actual.onInterface(intf) {
+		issues = append(issues, fmt.Sprintf("%s: interface is %s, expected %s", label, actual.Interface, intf))
+	}
+	return issues
+}
+
+// neighborEntry is one row of the ipV4Neighbors / ipV6Neighbors list.
+type neighborEntry struct {
+	Address   string
+	MAC       string
+	Interface string
+	State     string
+}
+
+func (e neighborEntry) incomplete() bool {
+	return e.MAC == "" || strings.EqualFold(e.MAC, "incomplete") || strings.EqualFold(e.State, "incomplete")
+}
+
+// onInterface matches SVI entries too: EOS reports them as "Vlan10, Ethernet1".
+func (e neighborEntry) onInterface(intf string) bool {
+	for _, part := range strings.Split(e.Interface, ",") {
+		if strings.TrimSpace(part) == intf {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNeighborTable indexes a neighbor list by canonical address.
+func parseNeighborTable(raw any) map[string]neighborEntry {
+	table := map[string]neighborEntry{}
+	list, _ := raw.([]any)
+	for _, item := range list {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		entry := neighborEntry{}
+		entry.Address, _ = m["address"].(string)
+		entry.MAC, _ = m["hwAddress"].(string)
+		entry.Interface, _ = m["interface"].(string)
+		entry.State, _ = m["state"].(string)
+		if entry.Address == "" {
+			continue
+		}
+		table[canonicalIP(entry.Address)] = entry
+	}
+	return table
+}
+
+// canonicalIP returns the canonical text form of an address so IPv6
+// spellings compare equal; unparseable input is returned unchanged.
+func canonicalIP(addr string) string {
+	if ip := net.ParseIP(addr); ip != nil {
+		return ip.String()
+	}
+	return addr
+}
+
+// normalizeMAC converts any common MAC notation (00:1c:73:..., 001c.7300.0001,
+// 00-1C-73-...) to lowercase colon form, or "" if it is not a MAC.
+func normalizeMAC(mac string) string {
+	hex := strings.NewReplacer(":", "", ".", "", "-", "").Replace(strings.ToLower(mac))
+	if len(hex) != 12 {
+		return ""
+	}
+	for _, c := range hex {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return ""
+		}
+	}
+	parts := make([]string, 0, 6)
+	for i := 0; i < 12; i += 2 {
+		parts = append(parts, hex[i:i+2])
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,229 @@
+package l3
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/internal/rib"
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyRouteNextHopReachability verifies critical prefixes are routed
+// through next-hops that are actually resolved.
+//
+// A route can be present in the routing table while its next-hop has no
+// ARP or ND entry, in which case traffic to the prefix is blackholed. For
+// each specified prefix the test:
+//  1. Confirms the prefix is in the routing table of its VRF (default VRF
+//     when omitted), using `show ip route` or `show ipv6 route` by family.
+//  2. Checks every next-hop address of the route is resolved: a complete
+//     entry in `show arp` for IPv4, a reachable or permanent entry in
+//     `show ipv6 neighbors` for IPv6.
+//
+// Connected routes and vias without a next-hop address (Null0, VXLAN) have
+// nothing to resolve and are not checked.
+//
+// Expected Results:
+//   - Success: Every prefix is present and all its next-hops are resolved.
+//   - Failure: A prefix is missing, or one of its next-hops has a missing, incomplete or unconfirmed ARP/ND entry.
+//   - Error: Unable to retrieve the routing, ARP or ND table.
+//
+// Example YAML configuration:
+//   - name: "VerifyRouteNextHopReachability"
+//     module: "l3"
+//     inputs:
+//     routes:
+//   - prefix: "0.0.0.0/0"
+//   - prefix: "10.100.0.0/16"
+//     vrf: "PROD"
+//   - prefix: "2001:db8:100::/48"
+type VerifyRouteNextHopReachability struct {
+	test.BaseTest
+	Routes []NextHopRoute `yaml:"routes" json:"routes"`
+}
+
+type NextHopRoute struct {
+	Prefix string `yaml:"prefix" json:"prefix"`
+	VRF    string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+var (
+	arpVRFCmd = device.Template("show arp vrf {vrf}")
+	ndVRFCmd  = device.Template("show ipv6 neighbors vrf {vrf}")
+)
+
+func NewVerifyRouteNextHopReachability(inputs map[string]any) (test.Test, error) {
+	t := &VerifyRouteNextHopReachability{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyRouteNextHopReachability",
+			TestDescription: "Verify critical routes have resolved next-hops",
+			TestCategories:  []string{"l3", "routing", "arp", "ipv6"},
+		},
+	}
+
+	if inputs != nil {
+		if routes, ok := inputs["routes"].([]any); ok {
+			for i, item := range routes {
+				routeMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("routes[%d]: expected map, got %T", i, item)
+				}
+				route := NextHopRoute{VRF: "default"}
+				if err := test.GetString(routeMap, "prefix", &route.Prefix); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "vrf", &route.VRF); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				t.Routes = append(t.Routes, route)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyRouteNextHopReachability) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	// Neighbor tables are fetched once per family and VRF, keyed "ip/VRF"
+	// or "ipv6/VRF".
+	tables := map[string]map[string]neighborEntry{}
+	issues := []string{}
+
+	for i, route := range t.Routes {
+		if test.Cancelled(ctx, result, i, len(t.Routes), "routes", issues) {
+			return result, nil
+		}
+		prefix := rib.Canonical(route.Prefix)
+		label := fmt.Sprintf("%s (VRF %s)", prefix, route.VRF)
+		ipv6 := rib.IsIPv6(prefix)
+
+		cmdStr, err := rib.VRFRouteCommand(route.VRF, prefix)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = err.Error()
+			return result, nil
+		}
+		cmdResult, err := dev.Execute(ctx, device.Command{Template: cmdStr, Format: "json", UseCache: false})
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get route %s: %v", label, err)
+			return result, nil
+		}
+		data, err := test.AsMap(cmdResult.Output)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Unexpected route output: %v", err)
+			return result, nil
+		}
+
+		nextHops, found, covering := routeNextHops(data, route.VRF, prefix)
+		if !found {
+			if covering != "" {
+				issues = append(issues, fmt.Sprintf("Route %s not found (only covered by %s)", label, covering))
+			} else {
+				issues = append(issues, fmt.Sprintf("Route %s not found", label))
+			}
+			continue
+		}
+		if len(nextHops) == 0 {
+			continue
+		}
+
+		kind, tableCmd, listKey := "ARP", arpVRFCmd, "ipV4Neighbors"
+		if ipv6 {
+			kind, tableCmd, listKey = "ND", ndVRFCmd, "ipV6Neighbors"
+		}
+		tableKey := rib.Family(prefix) + "/" + route.VRF
+		table, ok := tables[tableKey]
+		if !ok {
+			cmdStr, _ := tableCmd.Render(map[string]any{"vrf": route.VRF})
+			cmdResult, err := dev.Execute(ctx, device.Command{Template: cmdStr, Format: "json", UseCache: false})
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Failed to get %s table for VRF %s: %v", kind, route.VRF, err)
+				return result, nil
+			}
+			neighbors, err := test.AsMap(cmdResult.Output)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Unexpected %s output: %v", kind, err)
+				return result, nil
+			}
+			table = parseNeighborTable(neighbors[listKey])
+			tables[tableKey] = table
+		}
+
+		for _, nh := range nextHops {
+			entry, ok := table[canonicalIP(nh)]
+			switch {
+			case !ok:
+				issues = append(issues, fmt.Sprintf("Route %s: next-hop %s has no %s entry", label, nh, kind))
+			case entry.incomplete():
+				issues = append(issues, fmt.Sprintf("Route %s: next-hop %s %s entry is incomplete", label, nh, kind))
+			case ipv6 && !healthyNDStates[strings.ToLower(entry.State)]:
+				issues = append(issues, fmt.Sprintf("Route %s: next-hop %s ND entry is %s, expected reachable",
+					label, nh, strings.ToLower(entry.State)))
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Unresolved routes: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d routes have resolved next-hops", len(t.Routes))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyRouteNextHopReachability) ValidateInput(input any) error {
+	if len(t.Routes) == 0 {
+		return fmt.Errorf("at least one route must be specified")
+	}
+	for i, route := range t.Routes {
+		if _, _, err := net.ParseCIDR(route.Prefix); err != nil {
+			return fmt.Errorf("routes[%d]: prefix %q is not a valid IPv4 or IPv6 prefix", i, route.Prefix)
+		}
+		if _, err := rib.VRFRouteCommand(route.VRF, route.Prefix); err != nil {
+			return fmt.Errorf("routes[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// routeNextHops returns the next-hop addresses of prefix from a
+// `show ip[v6] route vrf X <prefix>` response. EOS answers with the longest
+// match, so when prefix itself is absent covering names the route that
+// matched instead, if any.
+func routeNextHops(data map[string]any, vrf, prefix string) (nextHops []string, found bool, covering string) {
+	vrfs, _ := data["vrfs"].(map[string]any)
+	vrfData, _ := vrfs[vrf].(map[string]any)
+	routes, _ := vrfData["routes"].(map[string]any)
+	for key, raw := range routes {
+		if rib.Canonical(key) != prefix {
+			covering = key
+			continue
+		}
+		found = true
+		entry, _ := raw.(map[string]any)
+		vias, _ := entry["vias"].([]any)
+		for _, v := range vias {
+			via, _ := v.(map[string]any)
+			if nh, _ := via["nexthopAddr"].(string); nh != "" {
+				nextHops = append(nextHops, nh)
+			}
+		}
+	}
+	return nextHops, found, covering
+}
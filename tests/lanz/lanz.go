@@ -0,0 +1,78 @@
+package lanz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyLANZEnabled verifies LANZ (Latency Analyzer) congestion monitoring is enabled.
+//
+// Expected Results:
+//   - Success: LANZ is enabled.
+//   - Failure: LANZ is disabled.
+//   - Error: Unable to retrieve queue-monitor status from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyLANZEnabled"
+//     module: "lanz"
+//     inputs: {}
+type VerifyLANZEnabled struct {
+	test.BaseTest
+}
+
+func NewVerifyLANZEnabled(inputs map[string]any) (test.Test, error) {
+	t := &VerifyLANZEnabled{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyLANZEnabled",
+			TestDescription: "Verify LANZ congestion monitoring is enabled",
+			TestCategories:  []string{"lanz"},
+		},
+	}
+
+	return t, nil
+}
+
+func (t *VerifyLANZEnabled) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show queue-monitor length status",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get LANZ status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected LANZ output: %v", err)
+		return result, nil
+	}
+
+	if enabled, _ := data["lanzEnabled"].(bool); !enabled {
+		result.Status = test.TestFailure
+		result.Message = "LANZ is disabled"
+	} else {
+		result.Message = "LANZ is enabled"
+	}
+
+	return result, nil
+}
+
+func (t *VerifyLANZEnabled) ValidateInput(input any) error {
+	return nil
+}
@@ -0,0 +1,37 @@
+package lanz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyLANZEnabled(t *testing.T) {
+	cases := []struct {
+		name    string
+		output  string
+		status  test.TestStatus
+		message string
+	}{
+		{"enabled", `{"lanzEnabled": true}`, test.TestSuccess, "LANZ is enabled"},
+		{"disabled", `{"lanzEnabled": false}`, test.TestFailure, "LANZ is disabled"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyLANZEnabled(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show queue-monitor length status", tc.output)
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+			if res.Status != tc.status || res.Message != tc.message {
+				t.Errorf("got %v %q, want %v %q", res.Status, res.Message, tc.status, tc.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,392 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyLoggingBuffer(t *testing.T) {
+	const nested = `{"syslogEnabled": true,
+  "buffer": {"size": 16000, "severity": "debugging"},
+  "console": {"severity": "errors"},
+  "monitor": {"severity": "errors"}}`
+	const flat = `{"bufferSize": 64000, "bufferSeverity": "informational", "consoleSeverity": "critical"}`
+
+	cases := []struct {
+		name   string
+		body   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"buffer too small", nested, map[string]any{"buffer_size": 32000}, test.TestFailure,
+			"Logging buffer size is 16000, expected at least 32000"},
+		{"levels match", nested, map[string]any{"buffer_size": 16000, "buffer_severity": "debug", "console_severity": "3"},
+			test.TestSuccess, ""},
+		{"console level mismatch", nested, map[string]any{"console_severity": "warnings"}, test.TestFailure,
+			"console logging severity is 'errors', expected 'warnings'"},
+		{"flat shape", flat, map[string]any{"buffer_size": 32000, "buffer_severity": "informational", "console_severity": "critical"},
+			test.TestSuccess, ""},
+		{"monitor not reported", flat, map[string]any{"monitor_severity": "errors"}, test.TestFailure,
+			"monitor logging severity not reported"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyLoggingBuffer(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show logging", tc.body))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyLoggingBufferValidateInput(t *testing.T) {
+	for _, inputs := range []map[string]any{
+		nil,
+		{"buffer_size": -1},
+		{"console_severity": "loud"},
+	} {
+		tst, _ := NewVerifyLoggingBuffer(inputs)
+		if err := tst.ValidateInput(nil); err == nil {
+			t.Errorf("ValidateInput(%v) = nil, want error", inputs)
+		}
+	}
+}
+
+func TestVerifyLoggingHostsIPv6AndHostname(t *testing.T) {
+	const body = `{"syslogServers": [
+  {"ipAddress": "2001:0db8:0000:0000:0000:0000:0000:0100", "vrf": "MGMT"},
+  {"host": "Syslog.Example.com", "vrf": "MGMT"},
+  {"ipAddress": "10.1.1.1"}
+]}`
+
+	cases := []struct {
+		name   string
+		hosts  []any
+		status test.TestStatus
+		want   string
+	}{
+		{"ipv6 short form", []any{"2001:db8::100"}, test.TestSuccess, ""},
+		{"hostname case-insensitive", []any{"syslog.example.com"}, test.TestSuccess, ""},
+		{"missing ipv6 host", []any{"2001:db8::200"}, test.TestFailure, "Logging host 2001:db8::200 not configured for VRF MGMT"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, _ := NewVerifyLoggingHosts(map[string]any{"hosts": tc.hosts, "vrf": "MGMT"})
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show logging", body))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyLoggingHostsValidateInput(t *testing.T) {
+	for host, valid := range map[string]bool{
+		"10.1.1.1":           true,
+		"2001:db8::1":        true,
+		"syslog.example.com": true,
+		"syslog01":           true,
+		"10.1.1":             false,
+		"bad_host!":          false,
+		"-lead.example.com":  false,
+		"":                   false,
+	} {
+		tst, _ := NewVerifyLoggingHosts(map[string]any{"hosts": []any{host}})
+		if err := tst.ValidateInput(nil); (err == nil) != valid {
+			t.Errorf("ValidateInput(%q) = %v, want valid=%v", host, err, valid)
+		}
+	}
+}
+
+// pingDevice answers Ping from a table of results keyed by destination and
+// records the options it was called with.
+type pingDevice struct {
+	*device.FakeDevice
+	results map[string]*device.PingResult
+	pings   []device.PingOpts
+}
+
+func (p *pingDevice) Ping(_ context.Context, opts device.PingOpts) (*device.PingResult, error) {
+	p.pings = append(p.pings, opts)
+	if res, ok := p.results[opts.Destination]; ok {
+		return res, nil
+	}
+	return &device.PingResult{Destination: opts.Destination, Stats: device.PingStats{Sent: opts.Count, Loss: 1}}, nil
+}
+
+func TestVerifyLoggingHostsReachable(t *testing.T) {
+	const logging = `{"syslogServers": [
+  {"ipAddress": "10.1.1.1", "vrf": "MGMT"},
+  {"ipAddress": "10.1.1.2", "vrf": "MGMT"}
+], "sourceInterface": {"MGMT": {"interface": "Management1"}}}`
+	const mgmt = `{"interfaces": {"Management1": {
+  "interfaceAddress": {"primaryIp": {"address": "10.0.0.5", "maskLen": 24}}
+}}}`
+	reply := &device.PingResult{Stats: device.PingStats{Sent: 3, Received: 3}}
+
+	fake := device.NewFakeDevice().
+		OnJSON(t, "show logging", logging).
+		OnJSON(t, "show ip interface Management1", mgmt)
+	dev := &pingDevice{FakeDevice: fake, results: map[string]*device.PingResult{"10.1.1.1": reply}}
+
+	tst, _ := NewVerifyLoggingHostsReachable(map[string]any{"vrf": "MGMT"})
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatal(err)
+	}
+	res, err := tst.Execute(context.Background(), dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if want := "10.1.1.2 unreachable (100% packet loss, 0/3 replies)"; !strings.Contains(res.Message, want) {
+		t.Errorf("message %q should contain %q", res.Message, want)
+	}
+	if strings.Contains(res.Message, "10.1.1.1 ") {
+		t.Errorf("reachable host reported: %q", res.Message)
+	}
+	for _, opts := range dev.pings {
+		if opts.Source != "10.0.0.5" || opts.VRF != "MGMT" {
+			t.Errorf("ping opts = %+v, want source 10.0.0.5 in VRF MGMT", opts)
+		}
+	}
+}
+
+func TestVerifyLoggingHostsReachableRequiresGNMI(t *testing.T) {
+	tst, _ := NewVerifyLoggingHostsReachable(map[string]any{"hosts": []any{"10.1.1.1"}})
+	res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show logging", `{}`))
+	if res.Status != test.TestError || !strings.Contains(res.Message, "requires transport: gnmi") {
+		t.Errorf("got %v %q, want gnmi transport error", res.Status, res.Message)
+	}
+}
+
+func TestVerifyLoggingErrors(t *testing.T) {
+	const cmd = "show logging last 1 hours threshold errors"
+	stamp := func(ago time.Duration) string {
+		return time.Now().Add(-ago).Format(time.RFC3339Nano)
+	}
+
+	cases := []struct {
+		name   string
+		lines  []string
+		status test.TestStatus
+		want   string
+	}{
+		{"clean", nil, test.TestSuccess, ""},
+		{"error inside window", []string{
+			stamp(59*time.Minute) + " leaf1 Bgp: %BGP-3-NOTIFICATION: sent to neighbor 10.0.0.1 (VRF default AS 65001) 6/2",
+		}, test.TestFailure, "Error messages: 1"},
+		{"error just outside window", []string{
+			stamp(61*time.Minute) + " leaf1 Bgp: %BGP-3-NOTIFICATION: sent to neighbor 10.0.0.1 (VRF default AS 65001) 6/2",
+		}, test.TestSuccess, ""},
+		{"numeric severities counted by level", []string{
+			stamp(time.Minute) + " leaf1 Stp: %SPANTREE-2-ROOTGUARD_BLOCK: Root guard blocking port Ethernet1",
+			stamp(time.Minute) + " leaf1 Lldp: %LLDP-5-NEIGHBOR_NEW: LLDP neighbor added on Ethernet2",
+			stamp(time.Minute) + " leaf1 Ebra: %LINEPROTO-5-UPDOWN: Line protocol on Interface Ethernet3, changed state to down",
+		}, test.TestFailure, "Critical messages: 1"},
+		{"keywords without tag ignored", []string{
+			stamp(time.Minute) + " leaf1 Cli: user typed 'show error counters'",
+		}, test.TestSuccess, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dev := device.NewFakeDevice()
+			dev.Outputs[cmd] = map[string]any{"output": strings.Join(tc.lines, "\n")}
+			tst, _ := NewVerifyLoggingErrors(nil)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+				t.Fatalf("got %v %q, want %v containing %q", res.Status, res.Message, tc.status, tc.want)
+			}
+			if tc.status == test.TestFailure && !strings.Contains(res.Message, "Found 1 error-level") {
+				t.Errorf("message %q miscounts error-level logs", res.Message)
+			}
+		})
+	}
+}
+
+func TestVerifyLoggingErrorsTimeUnit(t *testing.T) {
+	dev := device.NewFakeDevice()
+	dev.Outputs["show logging last 2 days threshold errors"] = map[string]any{"output": ""}
+	tst, _ := NewVerifyLoggingErrors(map[string]any{"last_number_time_units": 2, "time_unit": "day"})
+	if res := test.RunWithFake(t, tst, dev); res.Status != test.TestSuccess {
+		t.Fatalf("got %v %q", res.Status, res.Message)
+	}
+}
+
+func TestParseLogTimestamp(t *testing.T) {
+	got, ok := parseLogTimestamp("2026-01-02T09:30:00.123456+00:00 leaf1 Bgp: %BGP-3-NOTIFICATION: x")
+	if want := time.Date(2026, time.January, 2, 9, 30, 0, 123456000, time.UTC); !ok || !got.Equal(want) {
+		t.Errorf("parseLogTimestamp = %v, %v; want %v", got, ok, want)
+	}
+	for _, line := range []string{
+		"Jan  2 09:30:00 leaf1 Bgp: %BGP-3-NOTIFICATION: x",
+		"%BGP-3-NOTIFICATION: no stamp",
+	} {
+		if _, ok := parseLogTimestamp(line); ok {
+			t.Errorf("parseLogTimestamp(%q): expected no timestamp", line)
+		}
+	}
+}
+
+func TestVerifyLoggingErrorsRunnerTimezone(t *testing.T) {
+	// The runner is nine hours ahead of a UTC device.
+	orig := time.Local
+	time.Local = time.FixedZone("JST", 9*60*60)
+	t.Cleanup(func() { time.Local = orig })
+
+	deviceNow := time.Now().UTC()
+	dev := device.NewFakeDevice()
+	dev.Outputs["show logging last 1 hours threshold errors"] = map[string]any{"output": strings.Join([]string{
+		deviceNow.Add(-10*time.Minute).Format(time.Stamp) + " leaf1 Bgp: %BGP-3-NOTIFICATION: sent to neighbor 10.0.0.1",
+		deviceNow.Add(-20*time.Minute).Format("2006-01-02T15:04:05.000000-07:00") + " leaf1 Bgp: %BGP-3-NOTIFICATION: sent to neighbor 10.0.0.2",
+		deviceNow.Add(-2*time.Hour).Format("2006-01-02T15:04:05.000000-07:00") + " leaf1 Bgp: %BGP-3-NOTIFICATION: sent to neighbor 10.0.0.3",
+	}, "\n")}
+	tst, _ := NewVerifyLoggingErrors(nil)
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, "Error messages: 2") {
+		t.Errorf("got %v %q, want both in-window errors counted", res.Status, res.Message)
+	}
+}
+
+const multiVRFLogging = `{"sourceInterface": {
+  "MGMT": {"interface": "Management1"},
+  "PROD": {"interface": "Loopback10"}
+}, "globalSourceInterface": "Loopback0"}`
+
+// ipInterface is a `show ip interface <intf>` body with the given primary
+// address; "0.0.0.0" is how EOS reports an unaddressed interface.
+func ipInterface(intf, addr string) string {
+	return `{"interfaces": {"` + intf + `": {"interfaceAddress": {"primaryIp": {"address": "` + addr + `", "maskLen": 32}}}}}`
+}
+
+func TestVerifyLoggingSourceIntfSources(t *testing.T) {
+	cases := []struct {
+		name    string
+		sources []any
+		status  test.TestStatus
+		want    string
+	}{
+		{"all match", []any{
+			map[string]any{"interface": "Management1", "vrf": "MGMT"},
+			map[string]any{"interface": "Loopback10", "vrf": "PROD"},
+			map[string]any{"interface": "Loopback0"},
+		}, test.TestSuccess, ""},
+		{"one VRF wrong", []any{
+			map[string]any{"interface": "Management1", "vrf": "MGMT"},
+			map[string]any{"interface": "Loopback11", "vrf": "PROD"},
+		}, test.TestFailure, "Logging source interface for VRF PROD is 'Loopback10', expected 'Loopback11'"},
+		{"VRF missing", []any{
+			map[string]any{"interface": "Management1", "vrf": "DEV"},
+		}, test.TestFailure, "Logging source interface not configured for VRF DEV"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyLoggingSourceIntf(map[string]any{"sources": tc.sources})
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show logging", multiVRFLogging).
+				OnJSON(t, "show ip interface Management1", ipInterface("Management1", "10.0.0.5")).
+				OnJSON(t, "show ip interface Loopback10", ipInterface("Loopback10", "10.10.0.1")).
+				OnJSON(t, "show ip interface Loopback0", ipInterface("Loopback0", "192.0.2.1"))
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+				t.Fatalf("got %v %q, want %v containing %q", res.Status, res.Message, tc.status, tc.want)
+			}
+			details := res.Details
+			vrfs, _ := details["vrfs"].(map[string]any)
+			if len(vrfs) != len(tc.sources) {
+				t.Errorf("per-VRF details = %v, want %d entries", vrfs, len(tc.sources))
+			}
+		})
+	}
+}
+
+func TestVerifyLoggingSourceIntfValidateInput(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		want   string
+	}{
+		{"nothing", nil, "interface or sources must be specified"},
+		{"both", map[string]any{"interface": "Loopback0", "sources": []any{map[string]any{"interface": "Loopback0"}}},
+			"not both"},
+		{"duplicate VRF", map[string]any{"sources": []any{
+			map[string]any{"interface": "Loopback0"},
+			map[string]any{"interface": "Loopback1", "vrf": "default"},
+		}}, "sources[1]: duplicate VRF default"},
+	}
+	for _, tc := range cases {
+		tst, err := NewVerifyLoggingSourceIntf(tc.inputs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: ValidateInput = %v, want %q", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestVerifyLoggingSourceIntfNoAddress(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show logging", multiVRFLogging).
+		OnJSON(t, "show ip interface Management1", ipInterface("Management1", "10.0.0.5")).
+		OnJSON(t, "show ip interface Loopback10", ipInterface("Loopback10", "0.0.0.0"))
+	tst, err := NewVerifyLoggingSourceIntf(map[string]any{"sources": []any{
+		map[string]any{"interface": "Management1", "vrf": "MGMT"},
+		map[string]any{"interface": "Loopback10", "vrf": "PROD"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if want := "Logging source interface Loopback10 (VRF PROD) has no IP address"; !strings.Contains(res.Message, want) {
+		t.Errorf("message %q should contain %q", res.Message, want)
+	}
+	if strings.Contains(res.Message, "MGMT") {
+		t.Errorf("addressed source interface reported: %q", res.Message)
+	}
+	vrfs, _ := res.Details["vrfs"].(map[string]any)
+	if mgmt, _ := vrfs["MGMT"].(map[string]any); mgmt["address"] != "10.0.0.5" {
+		t.Errorf("MGMT details = %v, want address 10.0.0.5", vrfs["MGMT"])
+	}
+	if prod, _ := vrfs["PROD"].(string); !strings.Contains(prod, "no IP address") {
+		t.Errorf("PROD details = %v, want the missing address reported", vrfs["PROD"])
+	}
+}
+
+func TestVerifyLoggingSourceIntfSingleNoAddress(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show logging", multiVRFLogging).
+		OnError("show ip interface Loopback0", errors.New("Interface does not exist"))
+	tst, err := NewVerifyLoggingSourceIntf(map[string]any{"interface": "Loopback0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, "Loopback0 (VRF default) has no IP address") {
+		t.Fatalf("got %v %q, want the unaddressed Loopback0 reported", res.Status, res.Message)
+	}
+}
@@ -2,6 +2,7 @@ package logging
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"regexp"
@@ -187,11 +188,160 @@ func (t *VerifyLoggingPersistent) ValidateInput(input any) error {
 	return nil
 }
 
-// VerifyLoggingSourceIntf verifies logging source-interface for a specified VRF.
+// VerifyLoggingBuffer verifies the logging buffer size and per-destination severity levels.
 //
 // Expected Results:
-//   - Success: The test will pass if the logging source interface matches the expected interface for the specified VRF.
-//   - Failure: The test will fail if the logging source interface does not match or is not configured.
+//   - Success: The test will pass if the buffer is at least `buffer_size` messages and every
+//     specified severity (buffer, console, monitor) matches.
+//   - Failure: The test will fail if the buffer is too small or a severity level differs.
+//   - Error: The test will report an error if logging configuration cannot be retrieved.
+//
+// Severities accept EOS keywords (e.g. "errors", "debugging") or their syslog names and numbers.
+//
+// Examples:
+//   - name: VerifyLoggingBuffer
+//     VerifyLoggingBuffer:
+//     buffer_size: 32000
+//     buffer_severity: "debugging"
+//     console_severity: "errors"
+type VerifyLoggingBuffer struct {
+	test.BaseTest
+	BufferSize      int    `yaml:"buffer_size,omitempty" json:"buffer_size,omitempty"`
+	BufferSeverity  string `yaml:"buffer_severity,omitempty" json:"buffer_severity,omitempty"`
+	ConsoleSeverity string `yaml:"console_severity,omitempty" json:"console_severity,omitempty"`
+	MonitorSeverity string `yaml:"monitor_severity,omitempty" json:"monitor_severity,omitempty"`
+}
+
+func NewVerifyLoggingBuffer(inputs map[string]any) (test.Test, error) {
+	t := &VerifyLoggingBuffer{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyLoggingBuffer",
+			TestDescription: "Verify logging buffer size and severity levels",
+			TestCategories:  []string{"logging", "buffer"},
+		},
+	}
+
+	if err := test.GetInt(inputs, "buffer_size", &t.BufferSize); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "buffer_severity", &t.BufferSeverity); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "console_severity", &t.ConsoleSeverity); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "monitor_severity", &t.MonitorSeverity); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyLoggingBuffer) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show logging",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get logging configuration: %v", err)
+		return result, nil
+	}
+
+	loggingData, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected logging output: %v", err)
+		return result, nil
+	}
+
+	issues := []string{}
+	if t.BufferSize > 0 {
+		size, ok := loggingSetting(loggingData, "buffer", "size").(float64)
+		if !ok {
+			issues = append(issues, "Logging buffer size not reported")
+		} else if int(size) < t.BufferSize {
+			issues = append(issues, fmt.Sprintf("Logging buffer size is %d, expected at least %d", int(size), t.BufferSize))
+		}
+	}
+
+	for _, check := range []struct {
+		target   string
+		expected string
+	}{
+		{"buffer", t.BufferSeverity},
+		{"console", t.ConsoleSeverity},
+		{"monitor", t.MonitorSeverity},
+	} {
+		if check.expected == "" {
+			continue
+		}
+		actual, _ := loggingSetting(loggingData, check.target, "severity").(string)
+		if actual == "" {
+			issues = append(issues, fmt.Sprintf("%s logging severity not reported", check.target))
+			continue
+		}
+		if parseSeverityLevel(actual) != parseSeverityLevel(check.expected) {
+			issues = append(issues, fmt.Sprintf("%s logging severity is '%s', expected '%s'", check.target, actual, check.expected))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Logging buffer issues: %v", issues)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyLoggingBuffer) ValidateInput(input any) error {
+	if t.BufferSize < 0 {
+		return fmt.Errorf("buffer_size must not be negative")
+	}
+	if t.BufferSize == 0 && t.BufferSeverity == "" && t.ConsoleSeverity == "" && t.MonitorSeverity == "" {
+		return fmt.Errorf("at least one of buffer_size, buffer_severity, console_severity or monitor_severity must be specified")
+	}
+	for _, severity := range []string{t.BufferSeverity, t.ConsoleSeverity, t.MonitorSeverity} {
+		if severity != "" {
+			if _, ok := severityLevel(severity); !ok {
+				return fmt.Errorf("invalid severity '%s'", severity)
+			}
+		}
+	}
+	return nil
+}
+
+// loggingSetting reads a per-destination setting from `show logging`,
+// accepting both the nested form ({"buffer": {"size": ...}}) and the flat
+// form ({"bufferSize": ...}).
+func loggingSetting(data map[string]any, target, field string) any {
+	if nested, ok := data[target].(map[string]any); ok {
+		if v, ok := nested[field]; ok {
+			return v
+		}
+	}
+	return data[target+strings.ToUpper(field[:1])+field[1:]]
+}
+
+// VerifyLoggingSourceIntf verifies logging source-interface for one or more VRFs.
+//
+// A source interface without an address silently stops syslog export, so
+// each matching source interface must also have a primary IPv4 address in
+// `show ip interface`.
+//
+// Expected Results:
+//   - Success: The test will pass if the logging source interface matches the expected interface and has an IP address for every specified VRF.
+//   - Failure: The test will fail if the logging source interface does not match, is not configured, or has no IP address for any VRF.
 //   - Error: The test will report an error if logging configuration cannot be retrieved.
 //
 // Examples:
@@ -204,8 +354,24 @@ func (t *VerifyLoggingPersistent) ValidateInput(input any) error {
 //   - name: VerifyLoggingSourceIntf default VRF
 //     VerifyLoggingSourceIntf:
 //     interface: "Loopback0"
+//
+//   - name: VerifyLoggingSourceIntf across VRFs
+//     VerifyLoggingSourceIntf:
+//     sources:
+//
+//   - interface: "Management1"
+//     vrf: "MGMT"
+//
+//   - interface: "Loopback0"
 type VerifyLoggingSourceIntf struct {
 	test.BaseTest
+	Interface string          `yaml:"interface,omitempty" json:"interface,omitempty"`
+	VRF       string          `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+	Sources   []LoggingSource `yaml:"sources,omitempty" json:"sources,omitempty"`
+}
+
+// LoggingSource is an expected logging source interface for a VRF.
+type LoggingSource struct {
 	Interface string `yaml:"interface" json:"interface"`
 	VRF       string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
 }
@@ -214,7 +380,7 @@ func NewVerifyLoggingSourceIntf(inputs map[string]any) (test.Test, error) {
 	t := &VerifyLoggingSourceIntf{
 		BaseTest: test.BaseTest{
 			TestName:        "VerifyLoggingSourceIntf",
-			TestDescription: "Verify logging source-interface for a specified VRF",
+			TestDescription: "Verify logging source-interface for one or more VRFs",
 			TestCategories:  []string{"logging", "source-interface"},
 		},
 		VRF: "default", // Default VRF
@@ -227,11 +393,50 @@ func NewVerifyLoggingSourceIntf(inputs map[string]any) (test.Test, error) {
 		if vrf, ok := inputs["vrf"].(string); ok {
 			t.VRF = vrf
 		}
+		sources, err := parseLoggingSources(inputs)
+		if err != nil {
+			return nil, err
+		}
+		t.Sources = sources
 	}
 
 	return t, nil
 }
 
+// parseLoggingSources reads the optional sources list shared by the
+// source-interface tests.
+func parseLoggingSources(inputs map[string]any) ([]LoggingSource, error) {
+	raw, ok := inputs["sources"].([]any)
+	if !ok {
+		return nil, nil
+	}
+	sources := make([]LoggingSource, 0, len(raw))
+	for i, item := range raw {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("sources[%d]: expected map, got %T", i, item)
+		}
+		src := LoggingSource{VRF: "default"}
+		if err := test.GetString(m, "interface", &src.Interface); err != nil {
+			return nil, fmt.Errorf("sources[%d]: %w", i, err)
+		}
+		if err := test.GetString(m, "vrf", &src.VRF); err != nil {
+			return nil, fmt.Errorf("sources[%d]: %w", i, err)
+		}
+		sources = append(sources, src)
+	}
+	return sources, nil
+}
+
+// sources returns the configured sources list, or the single
+// interface/vrf pair when no list was given.
+func (t *VerifyLoggingSourceIntf) sources() []LoggingSource {
+	if len(t.Sources) > 0 {
+		return t.Sources
+	}
+	return []LoggingSource{{Interface: t.Interface, VRF: t.VRF}}
+}
+
 func (t *VerifyLoggingSourceIntf) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
 	result := &test.TestResult{
 		TestName:   t.Name(),
@@ -262,64 +467,91 @@ func (t *VerifyLoggingSourceIntf) Execute(ctx context.Context, dev device.Device
 		return result, nil
 	}
 
-	found := false
-	if sourceIntf, ok := loggingData["sourceInterface"].(map[string]any); ok {
-		if vrfData, ok := sourceIntf[t.VRF].(map[string]any); ok {
-			if configuredIntf, ok := vrfData["interface"].(string); ok {
-				found = true
-				if configuredIntf != t.Interface {
-					issues = append(issues, fmt.Sprintf("Logging source interface for VRF %s is '%s', expected '%s'",
-						t.VRF, configuredIntf, t.Interface))
-				}
+	vrfs := map[string]any{}
+	for _, src := range t.sources() {
+		configuredIntf, global := loggingSourceInterface(loggingData, src.VRF)
+		var issue, addr string
+		switch {
+		case configuredIntf == "":
+			issue = fmt.Sprintf("Logging source interface not configured for VRF %s", src.VRF)
+		case configuredIntf != src.Interface && global:
+			issue = fmt.Sprintf("Global logging source interface is '%s', expected '%s'",
+				configuredIntf, src.Interface)
+		case configuredIntf != src.Interface:
+			issue = fmt.Sprintf("Logging source interface for VRF %s is '%s', expected '%s'",
+				src.VRF, configuredIntf, src.Interface)
+		default:
+			if addr = interfacePrimaryIP(ctx, dev, src.Interface); addr == "" {
+				issue = fmt.Sprintf("Logging source interface %s (VRF %s) has no IP address", src.Interface, src.VRF)
 			}
 		}
-	}
-	if !found {
-		if vrfConfig, ok := loggingData["vrfs"].(map[string]any); ok {
-			if vrfData, ok := vrfConfig[t.VRF].(map[string]any); ok {
-				if configuredIntf, ok := vrfData["sourceInterface"].(string); ok {
-					found = true
-					if configuredIntf != t.Interface {
-						issues = append(issues, fmt.Sprintf("Logging source interface for VRF %s is '%s', expected '%s'",
-							t.VRF, configuredIntf, t.Interface))
-					}
-				}
-			}
+		if issue != "" {
+			issues = append(issues, issue)
+			vrfs[src.VRF] = issue
+		} else {
+			vrfs[src.VRF] = map[string]any{"interface": src.Interface, "address": addr}
 		}
 	}
-	if !found && t.VRF == "default" {
-		if globalSrcIntf, ok := loggingData["globalSourceInterface"].(string); ok {
-			found = true
-			if globalSrcIntf != t.Interface {
-				issues = append(issues, fmt.Sprintf("Global logging source interface is '%s', expected '%s'",
-					globalSrcIntf, t.Interface))
-			}
-		}
-	}
-	if !found {
-		issues = append(issues, fmt.Sprintf("Logging source interface not configured for VRF %s", t.VRF))
-	}
 
+	result.Details = map[string]any{"vrfs": vrfs}
+	if len(t.Sources) == 0 {
+		result.Details["interface"] = t.Interface
+		result.Details["vrf"] = t.VRF
+	}
 	if len(issues) > 0 {
 		result.Status = test.TestFailure
 		result.Message = fmt.Sprintf("Logging source interface issues: %v", issues)
-	} else {
-		result.Details = map[string]any{
-			"interface": t.Interface,
-			"vrf":       t.VRF,
-		}
 	}
 
 	return result, nil
 }
 
 func (t *VerifyLoggingSourceIntf) ValidateInput(input any) error {
-	if t.Interface == "" {
-		return fmt.Errorf("interface must be specified")
+	if len(t.Sources) > 0 && t.Interface != "" {
+		return fmt.Errorf("specify either interface or sources, not both")
+	}
+	if len(t.Sources) == 0 && t.Interface == "" {
+		return fmt.Errorf("interface or sources must be specified")
+	}
+	seen := map[string]bool{}
+	for i, src := range t.Sources {
+		if src.Interface == "" {
+			return fmt.Errorf("sources[%d]: interface must be specified", i)
+		}
+		if seen[src.VRF] {
+			return fmt.Errorf("sources[%d]: duplicate VRF %s", i, src.VRF)
+		}
+		seen[src.VRF] = true
 	}
 	return nil
 }
 
+// loggingSourceInterface returns the logging source interface configured for
+// vrf, or "" if none. global is true when the value came from the global
+// (non-VRF) setting, which only applies to the default VRF.
+func loggingSourceInterface(loggingData map[string]any, vrf string) (intf string, global bool) {
+	if sourceIntf, ok := loggingData["sourceInterface"].(map[string]any); ok {
+		if vrfData, ok := sourceIntf[vrf].(map[string]any); ok {
+			if configuredIntf, ok := vrfData["interface"].(string); ok {
+				return configuredIntf, false
+			}
+		}
+	}
+	if vrfConfig, ok := loggingData["vrfs"].(map[string]any); ok {
+		if vrfData, ok := vrfConfig[vrf].(map[string]any); ok {
+			if configuredIntf, ok := vrfData["sourceInterface"].(string); ok {
+				return configuredIntf, false
+			}
+		}
+	}
+	if vrf == "default" {
+		if globalSrcIntf, ok := loggingData["globalSourceInterface"].(string); ok {
+			return globalSrcIntf, true
+		}
+	}
+	return "", false
+}
+
 // VerifyLoggingHosts verifies logging hosts (syslog servers) for a specified VRF.
 //
 // Expected Results:
@@ -343,6 +575,10 @@ func (t *VerifyLoggingSourceIntf) ValidateInput(input any) error {
 //     hosts:
 //
 //   - "192.168.1.100"
+//
+//   - "2001:db8::100"
+//
+//   - "syslog.example.com"
 //     vrf: "MGMT"
 type VerifyLoggingHosts struct {
 	test.BaseTest
@@ -406,6 +642,217 @@ func (t *VerifyLoggingHosts) Execute(ctx context.Context, dev device.Device) (*t
 		result.Message = fmt.Sprintf("Unexpected logging hosts output: %v", err)
 		return result, nil
 	}
+	for _, host := range configuredLoggingHosts(loggingData, t.VRF) {
+		configuredHosts[loggingHostKey(host)] = true
+	}
+
+	// Check each expected host
+	for _, expectedHost := range t.Hosts {
+		if !configuredHosts[loggingHostKey(expectedHost)] {
+			issues = append(issues, fmt.Sprintf("Logging host %s not configured for VRF %s", expectedHost, t.VRF))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Logging hosts issues: %v", issues)
+	} else {
+		result.Details = map[string]any{
+			"expected_hosts":   t.Hosts,
+			"configured_hosts": configuredHosts,
+			"vrf":              t.VRF,
+		}
+	}
+
+	return result, nil
+}
+
+func (t *VerifyLoggingHosts) ValidateInput(input any) error {
+	if len(t.Hosts) == 0 {
+		return fmt.Errorf("at least one logging host must be specified")
+	}
+
+	for i, host := range t.Hosts {
+		// EOS accepts IPv4, IPv6 and DNS hostname syslog targets
+		if net.ParseIP(host) == nil && !isValidHostname(host) {
+			return fmt.Errorf("host at index %d is not a valid IP address or hostname: %s", i, host)
+		}
+	}
+
+	return nil
+}
+
+// VerifyLoggingHostsReachable verifies syslog hosts are reachable from the device.
+//
+// Unlike VerifyLoggingHosts, which only checks configuration, this test pings
+// each host in the logging VRF. When a logging source interface is configured
+// for the VRF, its primary IPv4 address is used as the ping source so the
+// probe follows the same path as the log traffic.
+//
+// Expected Results:
+//   - Success: The test will pass if every host answers at least one echo.
+//   - Failure: The test will fail if any host is unreachable (100% packet loss) or the ping fails.
+//   - Error: The test will report an error if logging configuration cannot be retrieved,
+//     or if the transport cannot run ping (requires transport: gnmi).
+//
+// Examples:
+//
+//   - name: VerifyLoggingHostsReachable configured hosts
+//     VerifyLoggingHostsReachable:
+//     vrf: "MGMT"
+//
+//   - name: VerifyLoggingHostsReachable explicit hosts
+//     VerifyLoggingHostsReachable:
+//     hosts:
+//
+//   - "10.1.1.1"
+//     vrf: "default"
+type VerifyLoggingHostsReachable struct {
+	test.BaseTest
+	Hosts []string `yaml:"hosts,omitempty" json:"hosts,omitempty"`
+	VRF   string   `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyLoggingHostsReachable(inputs map[string]any) (test.Test, error) {
+	t := &VerifyLoggingHostsReachable{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyLoggingHostsReachable",
+			TestDescription: "Verify syslog hosts are reachable",
+			TestCategories:  []string{"logging", "hosts"},
+		},
+		VRF: "default", // Default VRF
+	}
+
+	if err := test.GetStringSlice(inputs, "hosts", &t.Hosts); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "vrf", &t.VRF); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyLoggingHostsReachable) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show logging",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get logging configuration: %v", err)
+		return result, nil
+	}
+
+	loggingData, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected logging output: %v", err)
+		return result, nil
+	}
+
+	hosts := t.Hosts
+	if len(hosts) == 0 {
+		hosts = configuredLoggingHosts(loggingData, t.VRF)
+	}
+	if len(hosts) == 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("No logging hosts configured for VRF %s", t.VRF)
+		return result, nil
+	}
+
+	source := ""
+	if intf, _ := loggingSourceInterface(loggingData, t.VRF); intf != "" {
+		source = interfacePrimaryIP(ctx, dev, intf)
+	}
+
+	issues := []string{}
+	for _, host := range hosts {
+		opts := device.PingOpts{Destination: host, VRF: t.VRF, Count: 3}
+		// The source address must be of the same family as the destination.
+		if ip := net.ParseIP(host); ip == nil || ip.To4() != nil {
+			opts.Source = source
+		}
+
+		res, err := dev.Ping(ctx, opts)
+		switch {
+		case errors.Is(err, device.ErrDiagUnsupported):
+			result.Status = test.TestError
+			result.Message = "VerifyLoggingHostsReachable requires transport: gnmi (eAPI cannot serve gNOI Ping)"
+			return result, nil
+		case err != nil:
+			issues = append(issues, fmt.Sprintf("%s: ping error - %v", host, err))
+		case res.Stats.Received == 0:
+			issues = append(issues, fmt.Sprintf("%s unreachable (%.0f%% packet loss, 0/%d replies)", host, res.Stats.Loss*100, res.Stats.Sent))
+		}
+	}
+
+	result.Details = map[string]any{
+		"hosts":  hosts,
+		"vrf":    t.VRF,
+		"source": source,
+	}
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Logging hosts unreachable in VRF %s: %s", t.VRF, strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d logging hosts reachable in VRF %s", len(hosts), t.VRF)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyLoggingHostsReachable) ValidateInput(input any) error {
+	for i, host := range t.Hosts {
+		if net.ParseIP(host) == nil && !isValidHostname(host) {
+			return fmt.Errorf("host at index %d is not a valid IP address or hostname: %s", i, host)
+		}
+	}
+	return nil
+}
+
+// interfacePrimaryIP returns the primary IPv4 address of intf from
+// `show ip interface`, or "" if it has none or the lookup fails.
+func interfacePrimaryIP(ctx context.Context, dev device.Device, intf string) string {
+	cmd := device.Command{
+		Template: fmt.Sprintf("show ip interface %s", intf),
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		return ""
+	}
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		return ""
+	}
+	interfaces, _ := data["interfaces"].(map[string]any)
+	intfData, _ := interfaces[intf].(map[string]any)
+	addr, _ := intfData["interfaceAddress"].(map[string]any)
+	primary, _ := addr["primaryIp"].(map[string]any)
+	ip, _ := primary["address"].(string)
+	if ip == "0.0.0.0" {
+		return ""
+	}
+	return ip
+}
+
+// configuredLoggingHosts returns the syslog hosts configured for vrf, as
+// reported by `show logging`.
+func configuredLoggingHosts(loggingData map[string]any, vrf string) []string {
+	configured := []string{}
 	if syslogServers, ok := loggingData["syslogServers"].([]any); ok {
 		for _, serverData := range syslogServers {
 			server, ok := serverData.(map[string]any)
@@ -423,77 +870,73 @@ func (t *VerifyLoggingHosts) Execute(ctx context.Context, dev device.Device) (*t
 			} else {
 				serverVRF = "default"
 			}
-			if serverVRF == t.VRF && serverIP != "" {
-				configuredHosts[serverIP] = true
+			if serverVRF == vrf && serverIP != "" {
+				configured = append(configured, serverIP)
 			}
 		}
 	}
 	if hosts, ok := loggingData["hosts"].(map[string]any); ok {
-		if vrfHosts, ok := hosts[t.VRF].([]any); ok {
+		if vrfHosts, ok := hosts[vrf].([]any); ok {
 			for _, hostData := range vrfHosts {
 				if host, ok := hostData.(map[string]any); ok {
 					if ip, ok := host["ipAddress"].(string); ok {
-						configuredHosts[ip] = true
+						configured = append(configured, ip)
 					} else if hostAddr, ok := host["host"].(string); ok {
-						configuredHosts[hostAddr] = true
+						configured = append(configured, hostAddr)
 					}
 				}
 			}
 		}
 	}
-	if t.VRF == "default" {
+	if vrf == "default" {
 		if globalHosts, ok := loggingData["globalHosts"].([]any); ok {
 			for _, hostData := range globalHosts {
 				if host, ok := hostData.(string); ok {
-					configuredHosts[host] = true
+					configured = append(configured, host)
 				} else if hostMap, ok := hostData.(map[string]any); ok {
 					if ip, ok := hostMap["ipAddress"].(string); ok {
-						configuredHosts[ip] = true
+						configured = append(configured, ip)
 					}
 				}
 			}
 		}
 	}
-
-	// Check each expected host
-	for _, expectedHost := range t.Hosts {
-		if !configuredHosts[expectedHost] {
-			issues = append(issues, fmt.Sprintf("Logging host %s not configured for VRF %s", expectedHost, t.VRF))
-		}
-	}
-
-	if len(issues) > 0 {
-		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("Logging hosts issues: %v", issues)
-	} else {
-		result.Details = map[string]any{
-			"expected_hosts":   t.Hosts,
-			"configured_hosts": configuredHosts,
-			"vrf":              t.VRF,
-		}
-	}
-
-	return result, nil
+	return configured
 }
 
-func (t *VerifyLoggingHosts) ValidateInput(input any) error {
-	if len(t.Hosts) == 0 {
-		return fmt.Errorf("at least one logging host must be specified")
+// loggingHostKey normalizes a syslog host for comparison: IP addresses use
+// their canonical form (so IPv6 spellings match), hostnames are compared
+// case-insensitively without a trailing dot.
+func loggingHostKey(host string) string {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String()
 	}
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
 
-	for i, host := range t.Hosts {
-		// Validate IP address format
-		if ip := net.ParseIP(host); ip == nil {
-			return fmt.Errorf("host at index %d has invalid IP address: %s", i, host)
+// isValidHostname reports whether host is a syntactically valid DNS name
+// (RFC 1123). A purely numeric final label is rejected so that malformed
+// IPv4 addresses such as "10.1.1" are not mistaken for hostnames.
+func isValidHostname(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	if host == "" || len(host) > 253 {
+		return false
+	}
+	labels := strings.Split(host, ".")
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
 		}
-
-		// Ensure it's IPv4 (syslog typically uses IPv4)
-		if net.ParseIP(host).To4() == nil {
-			return fmt.Errorf("host at index %d must be an IPv4 address: %s", i, host)
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
 		}
 	}
-
-	return nil
+	if _, err := strconv.Atoi(labels[len(labels)-1]); err == nil {
+		return false
+	}
+	return true
 }
 
 // VerifyLoggingLogsGeneration verifies if logs are generated.
@@ -669,6 +1112,7 @@ func NewVerifyLoggingHostname(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyLoggingHostname",
 			TestDescription: "Verify if logs are generated with the device FQDN",
 			TestCategories:  []string{"logging", "hostname"},
+			TestSeverity:    test.SeverityMinor,
 		},
 		SeverityLevel: "informational",
 	}
@@ -834,6 +1278,7 @@ func NewVerifyLoggingTimestamp(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyLoggingTimestamp",
 			TestDescription: "Verify if logs are generated with the appropriate timestamp",
 			TestCategories:  []string{"logging", "timestamp"},
+			TestSeverity:    test.SeverityMinor,
 		},
 		SeverityLevel: "informational",
 	}
@@ -979,6 +1424,7 @@ func NewVerifyLoggingAccounting(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyLoggingAccounting",
 			TestDescription: "Verify if AAA accounting logs are generated",
 			TestCategories:  []string{"logging", "accounting"},
+			TestSeverity:    test.SeverityMinor,
 		},
 	}
 
@@ -1108,6 +1554,9 @@ func (t *VerifyLoggingAccounting) ValidateInput(input any) error {
 
 // VerifyLoggingErrors verifies there are no syslog messages with a severity of ERRORS or higher.
 //
+// Severity is read from each message's %FACILITY-N-MNEMONIC tag, and messages
+// whose timestamp falls outside the time window are ignored.
+//
 // Expected Results:
 //   - Success: The test will pass if no error-level or higher severity log messages are found in the specified time window.
 //   - Failure: The test will fail if error-level or higher severity log messages are found.
@@ -1167,29 +1616,14 @@ func (t *VerifyLoggingErrors) Execute(ctx context.Context, dev device.Device) (*
 		Categories: t.Categories(),
 	}
 
-	// Calculate the time range to check
-	var logCount int
-	switch strings.ToLower(t.TimeUnit) {
-	case "second", "seconds":
-		logCount = t.LastNumberTimeUnits * 10 // Rough estimate: 10 logs per second
-	case "minute", "minutes":
-		logCount = t.LastNumberTimeUnits * 60 // Rough estimate: 60 logs per minute
-	case "hour", "hours":
-		logCount = t.LastNumberTimeUnits * 100 // Rough estimate: 100 logs per hour
-	case "day", "days":
-		logCount = t.LastNumberTimeUnits * 1000 // Rough estimate: 1000 logs per day
-	default:
-		logCount = 100 // Default fallback
-	}
-
-	// Cap the log count to a reasonable maximum
-	if logCount > 1000 {
-		logCount = 1000
-	}
-
+	// EOS filters by time window and severity natively. Lines with an
+	// RFC 3339 stamp are also checked against the window so it is honored
+	// exactly; yearless stamps are left to the device's own filter.
+	window := t.window()
+	unit := eosTimeUnit(t.TimeUnit)
 	showLogCmd := device.Command{
-		Template: fmt.Sprintf("show logging last %d", logCount),
-		Format:   "json",
+		Template: fmt.Sprintf("show logging last %d %s threshold errors", t.LastNumberTimeUnits, unit),
+		Format:   "text",
 		UseCache: false,
 	}
 
@@ -1201,91 +1635,36 @@ func (t *VerifyLoggingErrors) Execute(ctx context.Context, dev device.Device) (*
 	}
 
 	issues := []string{}
-	errorCount := 0
-	criticalCount := 0
-	alertCount := 0
-	emergencyCount := 0
-
-	// Calculate cutoff time
-	var cutoffTime time.Time
-	now := time.Now()
-	switch strings.ToLower(t.TimeUnit) {
-	case "second", "seconds":
-		cutoffTime = now.Add(-time.Duration(t.LastNumberTimeUnits) * time.Second)
-	case "minute", "minutes":
-		cutoffTime = now.Add(-time.Duration(t.LastNumberTimeUnits) * time.Minute)
-	case "hour", "hours":
-		cutoffTime = now.Add(-time.Duration(t.LastNumberTimeUnits) * time.Hour)
-	case "day", "days":
-		cutoffTime = now.Add(-time.Duration(t.LastNumberTimeUnits) * 24 * time.Hour)
-	default:
-		cutoffTime = now.Add(-1 * time.Hour) // Default: 1 hour
-	}
-
-	logData, err := test.AsMap(cmdResult.Output)
-	if err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Unexpected log output: %v", err)
-		return result, nil
-	}
-	if logEntries, ok := logData["logs"].([]any); ok {
-		for _, logEntry := range logEntries {
-			log, ok := logEntry.(map[string]any)
-			if !ok {
-				continue
-			}
-			if timestampStr, ok := log["timestamp"].(string); ok {
-				if logTime, err := time.Parse(time.RFC3339, timestampStr); err == nil {
-					if logTime.Before(cutoffTime) {
-						continue
-					}
-				}
-			}
-			var logMessage, severityStr string
-			if message, ok := log["message"].(string); ok {
-				logMessage = message
-			} else if text, ok := log["text"].(string); ok {
-				logMessage = text
-			}
-			if severity, ok := log["severity"].(string); ok {
-				severityStr = severity
-			} else {
-				severityStr = extractSeverityFromMessage(logMessage)
-			}
-			severityLevel := parseSeverityLevel(severityStr)
-			if severityLevel <= 3 {
-				switch severityLevel {
-				case 0:
-					emergencyCount++
-				case 1:
-					alertCount++
-				case 2:
-					criticalCount++
-				case 3:
-					errorCount++
-				}
-			}
+	counts := make([]int, 4) // emergency, alert, critical, error
+	cutoffTime := time.Now().Add(-window)
+	checked := 0
+
+	logs, _ := device.TextOutput(cmdResult.Output)
+	for _, line := range strings.Split(logs, "\n") {
+		level, ok := syslogSeverity(line)
+		if !ok {
+			continue
+		}
+		if logTime, ok := parseLogTimestamp(line); ok && logTime.Before(cutoffTime) {
+			continue
+		}
+		checked++
+		if level <= 3 {
+			counts[level]++
 		}
 	}
 
 	// Report findings
-	totalErrorLevelLogs := emergencyCount + alertCount + criticalCount + errorCount
+	totalErrorLevelLogs := counts[0] + counts[1] + counts[2] + counts[3]
 
 	if totalErrorLevelLogs > 0 {
-		issues = append(issues, fmt.Sprintf("Found %d error-level or higher log messages in the last %d %s(s)",
-			totalErrorLevelLogs, t.LastNumberTimeUnits, t.TimeUnit))
+		issues = append(issues, fmt.Sprintf("Found %d error-level or higher log messages in the last %d %s",
+			totalErrorLevelLogs, t.LastNumberTimeUnits, unit))
 
-		if emergencyCount > 0 {
-			issues = append(issues, fmt.Sprintf("Emergency messages: %d", emergencyCount))
-		}
-		if alertCount > 0 {
-			issues = append(issues, fmt.Sprintf("Alert messages: %d", alertCount))
-		}
-		if criticalCount > 0 {
-			issues = append(issues, fmt.Sprintf("Critical messages: %d", criticalCount))
-		}
-		if errorCount > 0 {
-			issues = append(issues, fmt.Sprintf("Error messages: %d", errorCount))
+		for level, name := range []string{"Emergency", "Alert", "Critical", "Error"} {
+			if counts[level] > 0 {
+				issues = append(issues, fmt.Sprintf("%s messages: %d", name, counts[level]))
+			}
 		}
 	}
 
@@ -1294,8 +1673,8 @@ func (t *VerifyLoggingErrors) Execute(ctx context.Context, dev device.Device) (*
 		result.Message = fmt.Sprintf("Logging errors found: %v", issues)
 	} else {
 		result.Details = map[string]any{
-			"time_range":             fmt.Sprintf("%d %s(s)", t.LastNumberTimeUnits, t.TimeUnit),
-			"total_logs_checked":     logCount,
+			"time_range":             fmt.Sprintf("%d %s", t.LastNumberTimeUnits, unit),
+			"total_logs_checked":     checked,
 			"error_level_logs_found": totalErrorLevelLogs,
 		}
 	}
@@ -1303,6 +1682,21 @@ func (t *VerifyLoggingErrors) Execute(ctx context.Context, dev device.Device) (*
 	return result, nil
 }
 
+// window returns the configured time window as a duration.
+func (t *VerifyLoggingErrors) window() time.Duration {
+	n := time.Duration(t.LastNumberTimeUnits)
+	switch eosTimeUnit(t.TimeUnit) {
+	case "seconds":
+		return n * time.Second
+	case "minutes":
+		return n * time.Minute
+	case "days":
+		return n * 24 * time.Hour
+	default:
+		return n * time.Hour
+	}
+}
+
 func (t *VerifyLoggingErrors) ValidateInput(input any) error {
 	if t.LastNumberTimeUnits <= 0 {
 		return fmt.Errorf("last_number_time_units must be greater than 0")
@@ -1321,60 +1715,85 @@ func (t *VerifyLoggingErrors) ValidateInput(input any) error {
 
 // Helper function to parse severity level from string to numeric value
 func parseSeverityLevel(severity string) int {
-	severity = strings.ToLower(severity)
+	if level, ok := severityLevel(severity); ok {
+		return level
+	}
+	return 7 // Default to debug level if unknown
+}
+
+// severityLevel maps a syslog severity name, its EOS keyword (e.g. "errors",
+// "debugging") or its number to the numeric level.
+func severityLevel(severity string) (int, bool) {
+	severity = strings.ToLower(strings.TrimSpace(severity))
 	switch severity {
-	case "emergency", "emerg":
-		return 0
-	case "alert":
-		return 1
+	case "emergency", "emerg", "emergencies":
+		return 0, true
+	case "alert", "alerts":
+		return 1, true
 	case "critical", "crit":
-		return 2
-	case "error", "err":
-		return 3
-	case "warning", "warn":
-		return 4
-	case "notice":
-		return 5
+		return 2, true
+	case "error", "err", "errors":
+		return 3, true
+	case "warning", "warn", "warnings":
+		return 4, true
+	case "notice", "notifications":
+		return 5, true
 	case "informational", "info":
-		return 6
-	case "debug":
-		return 7
+		return 6, true
+	case "debug", "debugging":
+		return 7, true
+	}
+	if level, err := strconv.Atoi(severity); err == nil && level >= 0 && level <= 7 {
+		return level, true
+	}
+	return 0, false
+}
+
+// eosTimeUnit maps the accepted time_unit spellings to the plural keyword
+// `show logging last N <unit>` expects.
+func eosTimeUnit(unit string) string {
+	switch strings.ToLower(unit) {
+	case "second", "seconds":
+		return "seconds"
+	case "minute", "minutes":
+		return "minutes"
+	case "day", "days":
+		return "days"
 	default:
-		return 7 // Default to debug level if unknown
+		return "hours"
 	}
 }
 
-// Helper function to extract severity from log message
-func extractSeverityFromMessage(message string) string {
-	message = strings.ToUpper(message)
-	severityKeywords := []string{"EMERGENCY", "ALERT", "CRITICAL", "ERROR", "WARNING", "NOTICE", "INFO", "DEBUG"}
+// syslogSeverityPattern matches the EOS message tag "%FACILITY-N-MNEMONIC",
+// where N is the numeric syslog severity.
+var syslogSeverityPattern = regexp.MustCompile(`%[A-Z0-9_]+-([0-7])-[A-Z0-9_]+`)
 
-	for _, severity := range severityKeywords {
-		if strings.Contains(message, severity) {
-			return severity
-		}
+// syslogSeverity returns the numeric severity from a log line's message tag.
+// Lines without a tag (continuations, banners) report ok=false.
+func syslogSeverity(line string) (int, bool) {
+	m := syslogSeverityPattern.FindStringSubmatch(line)
+	if m == nil {
+		return 0, false
 	}
-
-	// Try to find numeric severity in message
-	if strings.Contains(message, "%") {
-		parts := strings.Split(message, "%")
-		if len(parts) > 0 {
-			// Look for pattern like "%DAEMON-3-ERROR"
-			for _, part := range parts {
-				if strings.Contains(part, "-") {
-					subParts := strings.Split(part, "-")
-					if len(subParts) >= 2 {
-						if level, err := strconv.Atoi(subParts[len(subParts)-2]); err == nil {
-							if level >= 0 && level <= 7 {
-								severityNames := []string{"EMERGENCY", "ALERT", "CRITICAL", "ERROR", "WARNING", "NOTICE", "INFO", "DEBUG"}
-								return severityNames[level]
-							}
-						}
-					}
-				}
-			}
-		}
+	level, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
 	}
+	return level, true
+}
 
-	return "INFO" // Default to info level
+// parseLogTimestamp reads a leading RFC 3339 timestamp (high-resolution
+// logging format). The traditional "Jan _2 15:04:05" stamp is not parsed:
+// it carries neither year nor offset, and reading it in the runner's
+// timezone rather than the device's would misplace it by hours.
+func parseLogTimestamp(line string) (time.Time, bool) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return time.Time{}, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, fields[0])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ts, true
 }
@@ -0,0 +1,242 @@
+package multicast
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyIGMPSnoopingVlans verifies the IGMP snooping state of specific VLANs.
+//
+// Expected Results:
+//   - Success: Every listed VLAN has the expected IGMP snooping state.
+//   - Failure: A VLAN is missing or its snooping state differs from the expected value.
+//   - Error: Unable to retrieve IGMP snooping information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyIGMPSnoopingVlans"
+//     module: "multicast"
+//     inputs:
+//     vlans:
+//     10: true
+//     20: false
+type VerifyIGMPSnoopingVlans struct {
+	test.BaseTest
+	VLANs map[int]bool `yaml:"vlans" json:"vlans"`
+}
+
+func NewVerifyIGMPSnoopingVlans(inputs map[string]any) (test.Test, error) {
+	t := &VerifyIGMPSnoopingVlans{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyIGMPSnoopingVlans",
+			TestDescription: "Verify IGMP snooping state per VLAN",
+			TestCategories:  []string{"multicast", "igmp"},
+		},
+		VLANs: map[int]bool{},
+	}
+
+	if inputs != nil {
+		if raw, ok := inputs["vlans"]; ok {
+			vlans, err := parseSnoopingVlans(raw)
+			if err != nil {
+				return nil, fmt.Errorf("vlans: %w", err)
+			}
+			t.VLANs = vlans
+		}
+	}
+
+	return t, nil
+}
+
+// parseSnoopingVlans accepts the `vlans` map with either integer keys
+// (YAML decoding) or string keys (JSON decoding).
+func parseSnoopingVlans(raw any) (map[int]bool, error) {
+	vlans := map[int]bool{}
+	switch v := raw.(type) {
+	case map[string]any:
+		for key, val := range v {
+			id, err := strconv.Atoi(key)
+			if err != nil {
+				return nil, fmt.Errorf("invalid VLAN ID %q", key)
+			}
+			enabled, ok := val.(bool)
+			if !ok {
+				return nil, fmt.Errorf("VLAN %d: expected bool, got %T", id, val)
+			}
+			vlans[id] = enabled
+		}
+	case map[any]any:
+		for key, val := range v {
+			id, ok := key.(int)
+			if !ok {
+				parsed, err := strconv.Atoi(fmt.Sprint(key))
+				if err != nil {
+					return nil, fmt.Errorf("invalid VLAN ID %v", key)
+				}
+				id = parsed
+			}
+			enabled, ok := val.(bool)
+			if !ok {
+				return nil, fmt.Errorf("VLAN %d: expected bool, got %T", id, val)
+			}
+			vlans[id] = enabled
+		}
+	default:
+		return nil, fmt.Errorf("expected map of VLAN ID to bool, got %T", raw)
+	}
+	return vlans, nil
+}
+
+func (t *VerifyIGMPSnoopingVlans) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	data, err := showIGMPSnooping(ctx, dev)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get IGMP snooping status: %v", err)
+		return result, nil
+	}
+
+	vlanData, _ := data["vlans"].(map[string]any)
+
+	ids := make([]int, 0, len(t.VLANs))
+	for id := range t.VLANs {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	issues := []string{}
+	for _, id := range ids {
+		expected := t.VLANs[id]
+		vlan, ok := vlanData[strconv.Itoa(id)].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("VLAN %d not found", id))
+			continue
+		}
+		state, _ := vlan["igmpSnoopingState"].(string)
+		if enabled := state == "enabled"; enabled != expected {
+			issues = append(issues, fmt.Sprintf("VLAN %d: IGMP snooping is %s, expected %s", id, state, snoopingState(expected)))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("IGMP snooping VLAN issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("IGMP snooping state correct for %d VLANs", len(ids))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyIGMPSnoopingVlans) ValidateInput(input any) error {
+	if len(t.VLANs) == 0 {
+		return fmt.Errorf("at least one VLAN must be specified")
+	}
+	for id := range t.VLANs {
+		if id < 1 || id > 4094 {
+			return fmt.Errorf("invalid VLAN ID %d", id)
+		}
+	}
+	return nil
+}
+
+// VerifyIGMPSnoopingGlobal verifies the global IGMP snooping state.
+//
+// Expected Results:
+//   - Success: Global IGMP snooping matches `enabled` (default: true).
+//   - Failure: Global IGMP snooping state differs from the expected value.
+//   - Error: Unable to retrieve IGMP snooping information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyIGMPSnoopingGlobal"
+//     module: "multicast"
+//     inputs:
+//     enabled: true
+type VerifyIGMPSnoopingGlobal struct {
+	test.BaseTest
+	Enabled bool `yaml:"enabled" json:"enabled"`
+}
+
+func NewVerifyIGMPSnoopingGlobal(inputs map[string]any) (test.Test, error) {
+	t := &VerifyIGMPSnoopingGlobal{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyIGMPSnoopingGlobal",
+			TestDescription: "Verify global IGMP snooping state",
+			TestCategories:  []string{"multicast", "igmp"},
+		},
+		Enabled: true,
+	}
+
+	if err := test.GetBool(inputs, "enabled", &t.Enabled); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyIGMPSnoopingGlobal) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	data, err := showIGMPSnooping(ctx, dev)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get IGMP snooping status: %v", err)
+		return result, nil
+	}
+
+	state, _ := data["igmpSnoopingState"].(string)
+	if enabled := state == "enabled"; enabled != t.Enabled {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("IGMP snooping is globally %s, expected %s", state, snoopingState(t.Enabled))
+	} else {
+		result.Message = fmt.Sprintf("IGMP snooping is globally %s", state)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyIGMPSnoopingGlobal) ValidateInput(input any) error {
+	return nil
+}
+
+func showIGMPSnooping(ctx context.Context, dev device.Device) (map[string]any, error) {
+	cmd := device.Command{
+		Template: "show ip igmp snooping",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected output: %w", err)
+	}
+	return data, nil
+}
+
+func snoopingState(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
@@ -0,0 +1,206 @@
+package multicast
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// mrouteAnySource is the source key EOS uses for (*,G) entries.
+const mrouteAnySource = "0.0.0.0"
+
+// VerifyMroutes verifies specific multicast routing entries.
+//
+// This test performs the following checks for each specified entry:
+//  1. Confirms the (S,G) entry exists, or the (*,G) entry when `source` is omitted.
+//  2. If `iif` is set, validates the incoming (RPF) interface.
+//  3. If `oil` is set, validates the outgoing interface list matches exactly.
+//
+// Expected Results:
+//   - Success: Every entry exists with the expected incoming and outgoing interfaces.
+//   - Failure: An entry is missing, has the wrong RPF interface, or a different outgoing interface list.
+//   - Error: Unable to retrieve the multicast routing table from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyMroutes"
+//     module: "multicast"
+//     inputs:
+//     routes:
+//   - group: "239.1.1.1"
+//     source: "10.1.1.10"
+//     iif: "Ethernet1"
+//     oil: ["Vlan100", "Vlan200"]
+//   - group: "239.2.2.2"
+//     iif: "Ethernet2"
+type VerifyMroutes struct {
+	test.BaseTest
+	Routes []Mroute `yaml:"routes" json:"routes"`
+}
+
+type Mroute struct {
+	Group  string   `yaml:"group" json:"group"`
+	Source string   `yaml:"source,omitempty" json:"source,omitempty"`
+	IIF    string   `yaml:"iif,omitempty" json:"iif,omitempty"`
+	OIL    []string `yaml:"oil,omitempty" json:"oil,omitempty"`
+}
+
+// Label returns the entry in (S,G) notation.
+func (m Mroute) Label() string {
+	if m.Source == "" {
+		return fmt.Sprintf("(*, %s)", m.Group)
+	}
+	return fmt.Sprintf("(%s, %s)", m.Source, m.Group)
+}
+
+func NewVerifyMroutes(inputs map[string]any) (test.Test, error) {
+	t := &VerifyMroutes{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyMroutes",
+			TestDescription: "Verify multicast routing entries",
+			TestCategories:  []string{"multicast", "pim"},
+		},
+	}
+
+	if inputs != nil {
+		if routes, ok := inputs["routes"].([]any); ok {
+			for i, item := range routes {
+				routeMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("routes[%d]: expected map, got %T", i, item)
+				}
+				route := Mroute{}
+				if err := test.GetString(routeMap, "group", &route.Group); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "source", &route.Source); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "iif", &route.IIF); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetStringSlice(routeMap, "oil", &route.OIL); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				t.Routes = append(t.Routes, route)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyMroutes) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show ip mroute",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get multicast routes: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected multicast route output: %v", err)
+		return result, nil
+	}
+
+	groups, _ := data["groups"].(map[string]any)
+	issues := []string{}
+
+	for _, route := range t.Routes {
+		source := route.Source
+		if source == "" {
+			source = mrouteAnySource
+		}
+
+		group, _ := groups[route.Group].(map[string]any)
+		sources, _ := group["groupSources"].(map[string]any)
+		entry, ok := sources[source].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s not found", route.Label()))
+			continue
+		}
+
+		if route.IIF != "" {
+			if rpf, _ := entry["rpfInterface"].(string); rpf != route.IIF {
+				issues = append(issues, fmt.Sprintf("%s RPF interface is %s, expected %s", route.Label(), rpf, route.IIF))
+			}
+		}
+
+		if len(route.OIL) > 0 {
+			missing, unexpected := oilDiff(route.OIL, entry["oifList"])
+			if len(missing) > 0 {
+				issues = append(issues, fmt.Sprintf("%s missing outgoing interfaces: %s", route.Label(), strings.Join(missing, ", ")))
+			}
+			if len(unexpected) > 0 {
+				issues = append(issues, fmt.Sprintf("%s unexpected outgoing interfaces: %s", route.Label(), strings.Join(unexpected, ", ")))
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Multicast route issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d multicast routes verified", len(t.Routes))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyMroutes) ValidateInput(input any) error {
+	if len(t.Routes) == 0 {
+		return fmt.Errorf("at least one route must be specified")
+	}
+	for i, route := range t.Routes {
+		if route.Group == "" {
+			return fmt.Errorf("routes[%d]: group is required", i)
+		}
+	}
+	return nil
+}
+
+// oilDiff compares the expected outgoing interface list against the
+// device's `oifList` and returns sorted missing and unexpected interfaces.
+func oilDiff(expected []string, raw any) (missing, unexpected []string) {
+	actual := map[string]bool{}
+	list, _ := raw.([]any)
+	for _, item := range list {
+		if intf, ok := item.(string); ok {
+			actual[intf] = true
+		}
+	}
+
+	want := map[string]bool{}
+	for _, intf := range expected {
+		want[intf] = true
+		if !actual[intf] {
+			missing = append(missing, intf)
+		}
+	}
+	for intf := range actual {
+		if !want[intf] {
+			unexpected = append(unexpected, intf)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	return missing, unexpected
+}
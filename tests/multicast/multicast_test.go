@@ -0,0 +1,146 @@
+package multicast
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const igmpSnooping = `{
+  "igmpSnoopingState": "enabled",
+  "robustness": 2,
+  "vlans": {
+    "10": {"igmpSnoopingState": "enabled"},
+    "20": {"igmpSnoopingState": "disabled"}
+  }
+}`
+
+func TestVerifyIGMPSnoopingVlans(t *testing.T) {
+	cases := []struct {
+		name   string
+		vlans  any
+		status test.TestStatus
+		want   string
+	}{
+		{"expected states", map[string]any{"10": true, "20": false}, test.TestSuccess, "2 VLANs"},
+		{"unexpectedly disabled", map[any]any{10: true, 20: true}, test.TestFailure,
+			"VLAN 20: IGMP snooping is disabled, expected enabled"},
+		{"missing vlan", map[string]any{"30": true}, test.TestFailure, "VLAN 30 not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyIGMPSnoopingVlans(map[string]any{"vlans": tc.vlans})
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show ip igmp snooping", igmpSnooping))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewVerifyIGMPSnoopingVlans_BadInput(t *testing.T) {
+	if _, err := NewVerifyIGMPSnoopingVlans(map[string]any{"vlans": map[string]any{"ten": true}}); err == nil {
+		t.Error("expected non-numeric VLAN key to be rejected")
+	}
+	if _, err := NewVerifyIGMPSnoopingVlans(map[string]any{"vlans": map[string]any{"10": "yes"}}); err == nil {
+		t.Error("expected non-bool state to be rejected")
+	}
+}
+
+func TestVerifyIGMPSnoopingGlobal(t *testing.T) {
+	tst, err := NewVerifyIGMPSnoopingGlobal(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show ip igmp snooping", `{"igmpSnoopingState": "disabled", "vlans": {}}`))
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, "globally disabled, expected enabled") {
+		t.Errorf("unexpected result: %v %s", res.Status, res.Message)
+	}
+}
+
+func TestVerifyPIMNeighbors(t *testing.T) {
+	dev := device.NewFakeDevice().OnJSON(t, "show ip pim neighbor", `{"neighbors": {
+  "10.0.0.1": {"interface": "Ethernet1", "creationTime": 1700000000.0, "holdTime": 105},
+  "10.0.0.5": {"interface": "Ethernet3", "creationTime": 1700000000.0, "holdTime": 105}
+}}`)
+
+	tst, err := NewVerifyPIMNeighbors(map[string]any{"neighbors": []any{
+		map[string]any{"interface": "Ethernet1", "neighbor": "10.0.0.1"},
+		map[string]any{"interface": "Ethernet2", "neighbor": "10.0.0.3"},
+		map[string]any{"interface": "Ethernet2", "neighbor": "10.0.0.5"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	for _, want := range []string{
+		"PIM neighbor 10.0.0.3 on Ethernet2 (VRF default) not found",
+		"PIM neighbor 10.0.0.5 (VRF default) is on Ethernet3, expected Ethernet2",
+	} {
+		if !strings.Contains(res.Message, want) {
+			t.Errorf("message %q should contain %q", res.Message, want)
+		}
+	}
+	if strings.Contains(res.Message, "10.0.0.1") {
+		t.Errorf("10.0.0.1 should pass: %s", res.Message)
+	}
+}
+
+const mrouteTable = `{"groups": {
+  "239.1.1.1": {"groupSources": {
+    "10.1.1.10": {"rpfInterface": "Ethernet1", "oifList": ["Vlan100", "Vlan200"]},
+    "0.0.0.0": {"rpfInterface": "Ethernet2", "oifList": ["Vlan100"]}
+  }}
+}}`
+
+func TestVerifyMroutes(t *testing.T) {
+	route := func(m map[string]any) map[string]any {
+		return map[string]any{"routes": []any{m}}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"S,G entry", route(map[string]any{"group": "239.1.1.1", "source": "10.1.1.10", "iif": "Ethernet1",
+			"oil": []any{"Vlan200", "Vlan100"}}), test.TestSuccess, "1 multicast routes"},
+		{"*,G entry", route(map[string]any{"group": "239.1.1.1", "iif": "Ethernet2"}), test.TestSuccess, "verified"},
+		{"missing S,G", route(map[string]any{"group": "239.1.1.1", "source": "10.9.9.9"}), test.TestFailure,
+			"(10.9.9.9, 239.1.1.1) not found"},
+		{"missing group", route(map[string]any{"group": "239.5.5.5"}), test.TestFailure, "(*, 239.5.5.5) not found"},
+		{"wrong rpf", route(map[string]any{"group": "239.1.1.1", "source": "10.1.1.10", "iif": "Ethernet3"}),
+			test.TestFailure, "RPF interface is Ethernet1, expected Ethernet3"},
+		{"oil mismatch", route(map[string]any{"group": "239.1.1.1", "source": "10.1.1.10",
+			"oil": []any{"Vlan100", "Vlan300"}}), test.TestFailure,
+			"missing outgoing interfaces: Vlan300; (10.1.1.10, 239.1.1.1) unexpected outgoing interfaces: Vlan200"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyMroutes(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show ip mroute", mrouteTable))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
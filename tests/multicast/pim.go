@@ -0,0 +1,158 @@
+package multicast
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyPIMNeighbors verifies expected PIM neighbors are up on their interfaces.
+//
+// This test performs the following checks for each specified neighbor:
+//  1. Confirms the neighbor address is present in `show ip pim neighbor`.
+//  2. Validates the neighbor was learned on the expected interface.
+//
+// Expected Results:
+//   - Success: Every expected PIM neighbor is up on its interface.
+//   - Failure: A neighbor is missing or was learned on a different interface.
+//   - Error: Unable to retrieve PIM neighbor information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyPIMNeighbors"
+//     module: "multicast"
+//     inputs:
+//     neighbors:
+//   - interface: "Ethernet1"
+//     neighbor: "10.0.0.1"
+//   - interface: "Vlan100"
+//     neighbor: "10.100.0.2"
+//     vrf: "PROD"
+type VerifyPIMNeighbors struct {
+	test.BaseTest
+	Neighbors []PIMNeighbor `yaml:"neighbors" json:"neighbors"`
+}
+
+type PIMNeighbor struct {
+	Interface string `yaml:"interface" json:"interface"`
+	Neighbor  string `yaml:"neighbor" json:"neighbor"`
+	VRF       string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyPIMNeighbors(inputs map[string]any) (test.Test, error) {
+	t := &VerifyPIMNeighbors{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyPIMNeighbors",
+			TestDescription: "Verify PIM neighbors are up",
+			TestCategories:  []string{"multicast", "pim"},
+		},
+	}
+
+	if inputs != nil {
+		if neighbors, ok := inputs["neighbors"].([]any); ok {
+			for i, item := range neighbors {
+				neighborMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("neighbors[%d]: expected map, got %T", i, item)
+				}
+				neighbor := PIMNeighbor{VRF: "default"}
+				if err := test.GetString(neighborMap, "interface", &neighbor.Interface); err != nil {
+					return nil, fmt.Errorf("neighbors[%d]: %w", i, err)
+				}
+				if err := test.GetString(neighborMap, "neighbor", &neighbor.Neighbor); err != nil {
+					return nil, fmt.Errorf("neighbors[%d]: %w", i, err)
+				}
+				if err := test.GetString(neighborMap, "vrf", &neighbor.VRF); err != nil {
+					return nil, fmt.Errorf("neighbors[%d]: %w", i, err)
+				}
+				t.Neighbors = append(t.Neighbors, neighbor)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyPIMNeighbors) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	// One query per VRF; most deployments only use the default VRF.
+	byVRF := map[string]map[string]any{}
+	issues := []string{}
+
+	for i, expected := range t.Neighbors {
+		if test.Cancelled(ctx, result, i, len(t.Neighbors), "neighbors", issues) {
+			return result, nil
+		}
+		neighbors, ok := byVRF[expected.VRF]
+		if !ok {
+			template := "show ip pim neighbor"
+			if expected.VRF != "default" {
+				template = fmt.Sprintf("show ip pim vrf %s neighbor", expected.VRF)
+			}
+			cmd := device.Command{
+				Template: template,
+				Format:   "json",
+				UseCache: false,
+			}
+
+			cmdResult, err := dev.Execute(ctx, cmd)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Failed to get PIM neighbors for VRF %s: %v", expected.VRF, err)
+				return result, nil
+			}
+
+			data, err := test.AsMap(cmdResult.Output)
+			if err != nil {
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Unexpected PIM neighbor output: %v", err)
+				return result, nil
+			}
+			neighbors, _ = data["neighbors"].(map[string]any)
+			byVRF[expected.VRF] = neighbors
+		}
+
+		info, ok := neighbors[expected.Neighbor].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("PIM neighbor %s on %s (VRF %s) not found",
+				expected.Neighbor, expected.Interface, expected.VRF))
+			continue
+		}
+		if intf, _ := info["interface"].(string); intf != expected.Interface {
+			issues = append(issues, fmt.Sprintf("PIM neighbor %s (VRF %s) is on %s, expected %s",
+				expected.Neighbor, expected.VRF, intf, expected.Interface))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("PIM neighbor issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d PIM neighbors up", len(t.Neighbors))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyPIMNeighbors) ValidateInput(input any) error {
+	if len(t.Neighbors) == 0 {
+		return fmt.Errorf("at least one neighbor must be specified")
+	}
+	for i, neighbor := range t.Neighbors {
+		if neighbor.Interface == "" {
+			return fmt.Errorf("neighbors[%d]: interface is required", i)
+		}
+		if neighbor.Neighbor == "" {
+			return fmt.Errorf("neighbors[%d]: neighbor is required", i)
+		}
+	}
+	return nil
+}
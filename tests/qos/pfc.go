@@ -0,0 +1,187 @@
+package qos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyPriorityFlowControl verifies priority-flow-control (PFC) on interfaces.
+//
+// Lossless RoCE traffic depends on PFC pausing exactly the priorities that
+// carry it; a port with PFC off, or on for the wrong priorities, drops
+// RDMA traffic under congestion. This test performs the following checks
+// for each specified interface:
+//  1. Verifies that the interface is present in the PFC status output.
+//  2. Validates that PFC is enabled on the interface.
+//  3. Validates that the PFC-enabled priorities are exactly `priorities`.
+//
+// Expected Results:
+//   - Success: Every interface has PFC enabled on exactly the expected priorities.
+//   - Failure: An interface is missing, has PFC disabled, or has missing or unexpected priorities.
+//   - Error: Unable to retrieve PFC information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyPriorityFlowControl"
+//     module: "qos"
+//     inputs:
+//     interfaces:
+//   - interface: "Ethernet1/1"
+//     priorities: [3, 4]
+type VerifyPriorityFlowControl struct {
+	test.BaseTest
+	Interfaces []PFCInterface `yaml:"interfaces" json:"interfaces"`
+}
+
+type PFCInterface struct {
+	Interface  string `yaml:"interface" json:"interface"`
+	Priorities []int  `yaml:"priorities" json:"priorities"`
+}
+
+func NewVerifyPriorityFlowControl(inputs map[string]any) (test.Test, error) {
+	t := &VerifyPriorityFlowControl{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyPriorityFlowControl",
+			TestDescription: "Verify priority-flow-control is enabled on expected priorities",
+			TestCategories:  []string{"qos", "pfc"},
+		},
+	}
+
+	if inputs != nil {
+		if intfs, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range intfs {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := PFCInterface{}
+				if err := test.GetString(intfMap, "interface", &intf.Interface); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if prios, ok := intfMap["priorities"].([]any); ok {
+					for j, p := range prios {
+						if v, ok := p.(float64); ok {
+							intf.Priorities = append(intf.Priorities, int(v))
+						} else if v, ok := p.(int); ok {
+							intf.Priorities = append(intf.Priorities, v)
+						} else {
+							return nil, fmt.Errorf("interfaces[%d].priorities[%d]: expected number, got %T", i, j, p)
+						}
+					}
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyPriorityFlowControl) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show priority-flow-control",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get priority-flow-control status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected priority-flow-control output: %v", err)
+		return result, nil
+	}
+	statuses, _ := data["interfaceStatuses"].(map[string]any)
+
+	issues := []string{}
+
+	for _, intf := range t.Interfaces {
+		status, ok := statuses[intf.Interface].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Interface %s not found", intf.Interface))
+			continue
+		}
+		if enabled, _ := status["enabled"].(bool); !enabled {
+			issues = append(issues, fmt.Sprintf("Interface %s: PFC disabled", intf.Interface))
+			continue
+		}
+
+		actual := map[int]bool{}
+		if prios, ok := status["priorities"].([]any); ok {
+			for _, p := range prios {
+				if v, ok := p.(float64); ok {
+					actual[int(v)] = true
+				}
+			}
+		}
+		expected := map[int]bool{}
+		for _, p := range intf.Priorities {
+			expected[p] = true
+		}
+
+		if missing := priorityDiff(expected, actual); len(missing) > 0 {
+			issues = append(issues, fmt.Sprintf("Interface %s: PFC not enabled on priorities %v", intf.Interface, missing))
+		}
+		if extra := priorityDiff(actual, expected); len(extra) > 0 {
+			issues = append(issues, fmt.Sprintf("Interface %s: PFC unexpectedly enabled on priorities %v", intf.Interface, extra))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Priority-flow-control issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("PFC verified on %d interfaces", len(t.Interfaces))
+	}
+
+	return result, nil
+}
+
+// priorityDiff returns the sorted priorities in a that are not in b.
+func priorityDiff(a, b map[int]bool) []int {
+	var diff []int
+	for p := range a {
+		if !b[p] {
+			diff = append(diff, p)
+		}
+	}
+	sort.Ints(diff)
+	return diff
+}
+
+func (t *VerifyPriorityFlowControl) ValidateInput(input any) error {
+	if len(t.Interfaces) == 0 {
+		return fmt.Errorf("at least one interface must be specified")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Interface == "" {
+			return fmt.Errorf("interfaces[%d]: interface is required", i)
+		}
+		if len(intf.Priorities) == 0 {
+			return fmt.Errorf("interface %s: at least one priority must be specified", intf.Interface)
+		}
+		for _, p := range intf.Priorities {
+			if p < 0 || p > 7 {
+				return fmt.Errorf("interface %s: priority %d out of range (0-7)", intf.Interface, p)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package qos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyQosPolicyMapApplied verifies QoS service-policies are applied to interfaces.
+//
+// This test performs the following checks for each specified interface:
+//  1. Retrieves the policy-maps attached to the interface.
+//  2. Validates that `input_policy`, when set, is applied in the input direction.
+//  3. Validates that `output_policy`, when set, is applied in the output direction.
+//
+// Expected Results:
+//   - Success: Every interface has the expected policy-map in each configured direction.
+//   - Failure: A policy-map is missing or a different policy-map is applied.
+//   - Error: Unable to retrieve policy-map information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyQosPolicyMapApplied"
+//     module: "qos"
+//     inputs:
+//     interfaces:
+//   - name: "Ethernet1"
+//     input_policy: "PM-CLASSIFY"
+//   - name: "Ethernet2"
+//     input_policy: "PM-CLASSIFY"
+//     output_policy: "PM-EGRESS"
+type VerifyQosPolicyMapApplied struct {
+	test.BaseTest
+	Interfaces []PolicyMapInterface `yaml:"interfaces" json:"interfaces"`
+}
+
+type PolicyMapInterface struct {
+	Name         string `yaml:"name" json:"name"`
+	InputPolicy  string `yaml:"input_policy,omitempty" json:"input_policy,omitempty"`
+	OutputPolicy string `yaml:"output_policy,omitempty" json:"output_policy,omitempty"`
+}
+
+func NewVerifyQosPolicyMapApplied(inputs map[string]any) (test.Test, error) {
+	t := &VerifyQosPolicyMapApplied{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyQosPolicyMapApplied",
+			TestDescription: "Verify QoS service-policies are applied to interfaces",
+			TestCategories:  []string{"qos"},
+		},
+	}
+
+	if inputs != nil {
+		if intfs, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range intfs {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := PolicyMapInterface{}
+				if err := test.GetString(intfMap, "name", &intf.Name); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if err := test.GetString(intfMap, "input_policy", &intf.InputPolicy); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if err := test.GetString(intfMap, "output_policy", &intf.OutputPolicy); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyQosPolicyMapApplied) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	issues := []string{}
+
+	for i, intf := range t.Interfaces {
+		if test.Cancelled(ctx, result, i, len(t.Interfaces), "interfaces", issues) {
+			return result, nil
+		}
+		cmd := device.Command{
+			Template: fmt.Sprintf("show policy-map interface %s", intf.Name),
+			Format:   "json",
+			UseCache: false,
+		}
+
+		cmdResult, err := dev.Execute(ctx, cmd)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get policy-maps for %s: %v", intf.Name, err)
+			return result, nil
+		}
+
+		applied := appliedPolicyMaps(cmdResult.Output)
+
+		for _, want := range []struct{ direction, policy string }{
+			{"input", intf.InputPolicy},
+			{"output", intf.OutputPolicy},
+		} {
+			if want.policy == "" {
+				continue
+			}
+			got := applied[want.direction]
+			switch {
+			case got == "":
+				issues = append(issues, fmt.Sprintf("Interface %s: no %s service-policy applied, expected %s",
+					intf.Name, want.direction, want.policy))
+			case got != want.policy:
+				issues = append(issues, fmt.Sprintf("Interface %s: %s service-policy is %s, expected %s",
+					intf.Name, want.direction, got, want.policy))
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("QoS policy-map issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Expected service-policies applied on %d interfaces", len(t.Interfaces))
+	}
+
+	return result, nil
+}
+
+// appliedPolicyMaps maps direction ("input"/"output") to the name of the
+// policy-map applied in that direction, from `show policy-map interface`.
+func appliedPolicyMaps(output any) map[string]string {
+	applied := map[string]string{}
+	data, ok := output.(map[string]any)
+	if !ok {
+		return applied
+	}
+	policyMaps, ok := data["policyMaps"].(map[string]any)
+	if !ok {
+		return applied
+	}
+	for name, pm := range policyMaps {
+		pmData, ok := pm.(map[string]any)
+		if !ok {
+			continue
+		}
+		direction, _ := pmData["directionString"].(string)
+		applied[strings.ToLower(direction)] = name
+	}
+	return applied
+}
+
+func (t *VerifyQosPolicyMapApplied) ValidateInput(input any) error {
+	if len(t.Interfaces) == 0 {
+		return fmt.Errorf("at least one interface must be specified")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Name == "" {
+			return fmt.Errorf("interfaces[%d]: name is required", i)
+		}
+		if intf.InputPolicy == "" && intf.OutputPolicy == "" {
+			return fmt.Errorf("interface %s: at least one of input_policy or output_policy must be set", intf.Name)
+		}
+	}
+	return nil
+}
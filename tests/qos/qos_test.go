@@ -0,0 +1,191 @@
+package qos
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyQosPolicyMapApplied(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show policy-map interface Ethernet1", `{"policyMaps": {
+  "PM-CLASSIFY": {"name": "PM-CLASSIFY", "directionString": "input"},
+  "PM-EGRESS": {"name": "PM-EGRESS", "directionString": "output"}
+}}`).
+		OnJSON(t, "show policy-map interface Ethernet2", `{"policyMaps": {
+  "PM-CLASSIFY": {"name": "PM-CLASSIFY", "directionString": "input"}
+}}`)
+
+	tst, err := NewVerifyQosPolicyMapApplied(map[string]any{"interfaces": []any{
+		map[string]any{"name": "Ethernet1", "input_policy": "PM-CLASSIFY", "output_policy": "PM-EGRESS"},
+		map[string]any{"name": "Ethernet2", "input_policy": "PM-CLASSIFY", "output_policy": "PM-EGRESS"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "Interface Ethernet2: no output service-policy applied, expected PM-EGRESS") {
+		t.Errorf("missing output policy not reported: %s", res.Message)
+	}
+	if strings.Contains(res.Message, "Ethernet1") {
+		t.Errorf("correctly configured interface reported: %s", res.Message)
+	}
+}
+
+func TestVerifyQosShapeRate(t *testing.T) {
+	dev := device.NewFakeDevice().OnJSON(t, "show qos interfaces", `{"intfQosInfo": {
+  "Ethernet1": {"shapeRate": {"rate": 10000000, "unit": "kbps"}},
+  "Ethernet2": {"shapeRate": {"rate": 5000000, "unit": "kbps"}},
+  "Ethernet3": {}
+}}`)
+
+	tst, _ := NewVerifyQosShapeRate(map[string]any{"interfaces": []any{
+		map[string]any{"name": "Ethernet1", "rate_kbps": 10000000},
+		map[string]any{"name": "Ethernet2", "rate_kbps": 10000000},
+		map[string]any{"name": "Ethernet3", "rate_kbps": 10000000},
+	}})
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	for _, want := range []string{
+		"Interface Ethernet2: shape rate is 5000000 kbps, expected 10000000 kbps",
+		"Interface Ethernet3: no shaper configured",
+	} {
+		if !strings.Contains(res.Message, want) {
+			t.Errorf("message %q should contain %q", res.Message, want)
+		}
+	}
+}
+
+func TestVerifyPriorityFlowControl(t *testing.T) {
+	dev := device.NewFakeDevice().OnJSON(t, "show priority-flow-control", `{"interfaceStatuses": {
+  "Ethernet1/1": {"enabled": true, "priorities": [3, 4]},
+  "Ethernet2/1": {"enabled": true, "priorities": [3]},
+  "Ethernet3/1": {"enabled": false, "priorities": []}
+}}`)
+
+	tst, err := NewVerifyPriorityFlowControl(map[string]any{"interfaces": []any{
+		map[string]any{"interface": "Ethernet1/1", "priorities": []any{3, 4}},
+		map[string]any{"interface": "Ethernet2/1", "priorities": []any{3, 4}},
+		map[string]any{"interface": "Ethernet3/1", "priorities": []any{3}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	for _, want := range []string{
+		"Interface Ethernet2/1: PFC not enabled on priorities [4]",
+		"Interface Ethernet3/1: PFC disabled",
+	} {
+		if !strings.Contains(res.Message, want) {
+			t.Errorf("message %q should contain %q", res.Message, want)
+		}
+	}
+	if strings.Contains(res.Message, "Ethernet1/1") {
+		t.Errorf("correctly configured interface reported: %s", res.Message)
+	}
+}
+
+const queueCounters = `{"egressQueueCounters": {"interfaces": {
+  "Ethernet1/1": {
+    "ucastQueues": {"trafficClasses": {"TC0": {"droppedPackets": 50}, "TC3": {"droppedPackets": 1500}}},
+    "mcastQueues": {"trafficClasses": {"TC0": {"droppedPackets": 0}}}
+  },
+  "Ethernet2/1": {
+    "ucastQueues": {"trafficClasses": {"TC0": {"droppedPackets": 0}, "TC3": {"droppedPackets": 0}}}
+  }
+}}}`
+
+func TestVerifyQueueDrops(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   []string
+		absent []string
+	}{
+		{"all interfaces default zero", nil, test.TestFailure,
+			[]string{"Interface Ethernet1/1 ucast TC3: 1500 drops (max 0)", "Interface Ethernet1/1 ucast TC0: 50 drops (max 0)"},
+			[]string{"Ethernet2/1"}},
+		{"per-queue threshold", map[string]any{"max_drops": 100, "interfaces": []any{
+			map[string]any{"interface": "Ethernet1/1", "queues": map[string]any{"TC3": 0}},
+		}}, test.TestFailure, []string{"Interface Ethernet1/1 ucast TC3: 1500 drops (max 0)"}, []string{"TC0"}},
+		{"within threshold", map[string]any{"interfaces": []any{
+			map[string]any{"interface": "Ethernet1/1", "max_drops": 2000},
+		}}, test.TestSuccess, []string{"3 queues on 1 interfaces"}, nil},
+		{"unknown queue key", map[string]any{"interfaces": []any{
+			map[string]any{"interface": "Ethernet2/1", "queues": map[string]any{"TC3": 0, "tc7": 0}},
+		}}, test.TestFailure, []string{"Interface Ethernet2/1: no traffic class tc7"}, []string{"TC3"}},
+		{"missing interface", map[string]any{"interfaces": []any{
+			map[string]any{"interface": "Ethernet9/1"},
+		}}, test.TestFailure, []string{"Interface Ethernet9/1 not found"}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyQueueDrops(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show interfaces counters queue", queueCounters)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(res.Message, w) {
+					t.Errorf("message %q should contain %q", res.Message, w)
+				}
+			}
+			for _, a := range tc.absent {
+				if strings.Contains(res.Message, a) {
+					t.Errorf("message %q should not mention %q", res.Message, a)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyQueueDrops_NoCounters(t *testing.T) {
+	cases := []struct {
+		name   string
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{"unknown shape", `{"interfaces": {"Ethernet1/1": {}}}`, test.TestError, "No egress queue counters in output"},
+		{"no interfaces", `{"egressQueueCounters": {"interfaces": {}}}`, test.TestError, "No egress queue counters in output"},
+		{"no traffic classes", `{"egressQueueCounters": {"interfaces": {"Ethernet1/1": {"ucastQueues": {}}}}}`,
+			test.TestFailure, "no queue counters found on the checked interfaces"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyQueueDrops(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show interfaces counters queue", tc.output)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+				t.Errorf("result = %v %q, want %v %q", res.Status, res.Message, tc.status, tc.want)
+			}
+		})
+	}
+}
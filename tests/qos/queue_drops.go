@@ -0,0 +1,241 @@
+package qos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyQueueDrops verifies egress queues are not dropping packets.
+//
+// On GPU/AI training fabrics a handful of drops on a lossless queue stalls
+// a collective for a full retransmit timeout, so any queue drop is worth
+// flagging. This test performs the following checks:
+//  1. Reads the unicast and multicast egress queue counters for every
+//     checked interface.
+//  2. Fails for each queue whose dropped-packet count exceeds its threshold.
+//
+// Thresholds resolve from most to least specific: a per-queue entry under
+// an interface's `queues`, then the interface's `max_drops`, then the
+// top-level `max_drops` (default 0). When `interfaces` is omitted every
+// interface is checked against the top-level threshold. A `queues` key
+// that names no traffic class on its interface is reported, so a typo does
+// not silently fall back to the interface threshold.
+//
+// Expected Results:
+//   - Success: No queue has more drops than its threshold.
+//   - Failure: A listed interface is missing, a queue exceeds its threshold,
+//     a `queues` key matches no traffic class, or no queue counters were
+//     found on the checked interfaces.
+//   - Error: Unable to retrieve queue counters from the device, or the
+//     output holds no egress queue counters.
+//
+// Example YAML configuration:
+//   - name: "VerifyQueueDrops"
+//     module: "qos"
+//     inputs:
+//     max_drops: 100
+//     interfaces:
+//   - interface: "Ethernet1/1"
+//     queues:
+//     TC3: 0
+//     TC4: 0
+type VerifyQueueDrops struct {
+	test.BaseTest
+	MaxDrops   int                   `yaml:"max_drops,omitempty" json:"max_drops,omitempty"`
+	Interfaces []QueueDropsInterface `yaml:"interfaces,omitempty" json:"interfaces,omitempty"`
+}
+
+type QueueDropsInterface struct {
+	Interface string         `yaml:"interface" json:"interface"`
+	MaxDrops  *int           `yaml:"max_drops,omitempty" json:"max_drops,omitempty"`
+	Queues    map[string]int `yaml:"queues,omitempty" json:"queues,omitempty"`
+}
+
+func NewVerifyQueueDrops(inputs map[string]any) (test.Test, error) {
+	t := &VerifyQueueDrops{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyQueueDrops",
+			TestDescription: "Verify egress queues are not dropping packets",
+			TestCategories:  []string{"qos", "interfaces"},
+		},
+	}
+
+	if inputs != nil {
+		if err := test.GetInt(inputs, "max_drops", &t.MaxDrops); err != nil {
+			return nil, err
+		}
+		if intfs, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range intfs {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := QueueDropsInterface{}
+				if err := test.GetString(intfMap, "interface", &intf.Interface); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if _, ok := intfMap["max_drops"]; ok {
+					var maxDrops int
+					if err := test.GetInt(intfMap, "max_drops", &maxDrops); err != nil {
+						return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+					}
+					intf.MaxDrops = &maxDrops
+				}
+				if queues, ok := intfMap["queues"].(map[string]any); ok {
+					intf.Queues = make(map[string]int, len(queues))
+					for queue := range queues {
+						var maxDrops int
+						if err := test.GetInt(queues, queue, &maxDrops); err != nil {
+							return nil, fmt.Errorf("interfaces[%d].queues: %w", i, err)
+						}
+						intf.Queues[queue] = maxDrops
+					}
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyQueueDrops) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show interfaces counters queue",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get queue counters: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected queue counters output: %v", err)
+		return result, nil
+	}
+	egress, _ := data["egressQueueCounters"].(map[string]any)
+	counters, _ := egress["interfaces"].(map[string]any)
+	if len(counters) == 0 {
+		// An unknown output shape would otherwise pass with nothing checked.
+		result.Status = test.TestError
+		result.Message = "No egress queue counters in output"
+		return result, nil
+	}
+
+	targets := t.Interfaces
+	if len(targets) == 0 {
+		for _, name := range sortedKeys(counters) {
+			targets = append(targets, QueueDropsInterface{Interface: name})
+		}
+	}
+
+	issues := []string{}
+	queuesChecked := 0
+
+	for _, intf := range targets {
+		queueTypes, ok := counters[intf.Interface].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Interface %s not found", intf.Interface))
+			continue
+		}
+
+		intfMax := t.MaxDrops
+		if intf.MaxDrops != nil {
+			intfMax = *intf.MaxDrops
+		}
+
+		matched := map[string]bool{}
+		// ucastQueues / mcastQueues; sorted so messages are stable.
+		for _, queueType := range sortedKeys(queueTypes) {
+			queues, _ := queueTypes[queueType].(map[string]any)
+			classes, _ := queues["trafficClasses"].(map[string]any)
+
+			for _, tc := range sortedKeys(classes) {
+				class, _ := classes[tc].(map[string]any)
+				dropped, _ := class["droppedPackets"].(float64)
+				queuesChecked++
+
+				maxDrops := intfMax
+				if v, ok := intf.Queues[tc]; ok {
+					maxDrops = v
+					matched[tc] = true
+				}
+				if drops := int(dropped); drops > maxDrops {
+					issues = append(issues, fmt.Sprintf("Interface %s %s %s: %d drops (max %d)",
+						intf.Interface, strings.TrimSuffix(queueType, "Queues"), tc, drops, maxDrops))
+				}
+			}
+		}
+
+		unknown := []string{}
+		for queue := range intf.Queues {
+			if !matched[queue] {
+				unknown = append(unknown, queue)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			issues = append(issues, fmt.Sprintf("Interface %s: no traffic class %s", intf.Interface, strings.Join(unknown, ", ")))
+		}
+	}
+
+	if len(issues) == 0 && queuesChecked == 0 {
+		issues = append(issues, "no queue counters found on the checked interfaces")
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Queue drop issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("No queue drops above threshold (%d queues on %d interfaces)", queuesChecked, len(targets))
+	}
+
+	return result, nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (t *VerifyQueueDrops) ValidateInput(input any) error {
+	if t.MaxDrops < 0 {
+		return fmt.Errorf("max_drops must be non-negative")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Interface == "" {
+			return fmt.Errorf("interfaces[%d]: interface is required", i)
+		}
+		if intf.MaxDrops != nil && *intf.MaxDrops < 0 {
+			return fmt.Errorf("interface %s: max_drops must be non-negative", intf.Interface)
+		}
+		for queue, v := range intf.Queues {
+			if v < 0 {
+				return fmt.Errorf("interface %s: queues.%s must be non-negative", intf.Interface, queue)
+			}
+		}
+	}
+	return nil
+}
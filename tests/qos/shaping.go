@@ -0,0 +1,144 @@
+package qos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyQosShapeRate verifies the egress shaper rate configured on interfaces.
+//
+// This test performs the following checks for each specified interface:
+//  1. Retrieves the interface's QoS information.
+//  2. Validates that a port shaper is configured.
+//  3. Validates that the shaper rate equals `rate_kbps`.
+//
+// Expected Results:
+//   - Success: Every interface is shaped at the expected rate.
+//   - Failure: An interface is not found, has no shaper, or is shaped at a different rate.
+//   - Error: Unable to retrieve QoS information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyQosShapeRate"
+//     module: "qos"
+//     inputs:
+//     interfaces:
+//   - name: "Ethernet1"
+//     rate_kbps: 10000000
+type VerifyQosShapeRate struct {
+	test.BaseTest
+	Interfaces []ShapeRateInterface `yaml:"interfaces" json:"interfaces"`
+}
+
+type ShapeRateInterface struct {
+	Name     string `yaml:"name" json:"name"`
+	RateKbps int    `yaml:"rate_kbps" json:"rate_kbps"`
+}
+
+func NewVerifyQosShapeRate(inputs map[string]any) (test.Test, error) {
+	t := &VerifyQosShapeRate{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyQosShapeRate",
+			TestDescription: "Verify interface shaper rates",
+			TestCategories:  []string{"qos"},
+		},
+	}
+
+	if inputs != nil {
+		if intfs, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range intfs {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := ShapeRateInterface{}
+				if err := test.GetString(intfMap, "name", &intf.Name); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if err := test.GetInt(intfMap, "rate_kbps", &intf.RateKbps); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyQosShapeRate) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show qos interfaces",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get QoS interfaces: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected QoS interfaces output: %v", err)
+		return result, nil
+	}
+	intfQosInfo, _ := data["intfQosInfo"].(map[string]any)
+
+	issues := []string{}
+
+	for _, intf := range t.Interfaces {
+		info, ok := intfQosInfo[intf.Name].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Interface %s not found", intf.Name))
+			continue
+		}
+		shape, _ := info["shapeRate"].(map[string]any)
+		rate, ok := shape["rate"].(float64)
+		if !ok || rate == 0 {
+			issues = append(issues, fmt.Sprintf("Interface %s: no shaper configured, expected %d kbps", intf.Name, intf.RateKbps))
+			continue
+		}
+		if int(rate) != intf.RateKbps {
+			issues = append(issues, fmt.Sprintf("Interface %s: shape rate is %d kbps, expected %d kbps",
+				intf.Name, int(rate), intf.RateKbps))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("QoS shape rate issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Shape rates verified on %d interfaces", len(t.Interfaces))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyQosShapeRate) ValidateInput(input any) error {
+	if len(t.Interfaces) == 0 {
+		return fmt.Errorf("at least one interface must be specified")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Name == "" {
+			return fmt.Errorf("interfaces[%d]: name is required", i)
+		}
+		if intf.RateKbps <= 0 {
+			return fmt.Errorf("interface %s: rate_kbps must be positive", intf.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package routing
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// parseASN reads a BGP AS number from catalog input or device output.
+// Numbers are taken as asplain; strings may be asplain ("4200000000") or
+// asdot ("65000.1", i.e. 65000*65536+1). Strings avoid the float64
+// round-trip JSON inputs go through, and EOS itself reports asn as a
+// string in `show bgp summary`.
+func parseASN(v any) (uint32, error) {
+	switch n := v.(type) {
+	case int:
+		return asnFromInt(int64(n), v)
+	case int64:
+		return asnFromInt(n, v)
+	case uint64:
+		if n > math.MaxUint32 {
+			return 0, fmt.Errorf("ASN %d out of range", n)
+		}
+		return uint32(n), nil
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, fmt.Errorf("ASN %v is not an integer", n)
+		}
+		return asnFromInt(int64(n), v)
+	case string:
+		s := strings.TrimSpace(n)
+		if high, low, dotted := strings.Cut(s, "."); dotted {
+			h, errH := strconv.ParseUint(high, 10, 16)
+			l, errL := strconv.ParseUint(low, 10, 16)
+			if errH != nil || errL != nil {
+				return 0, fmt.Errorf("invalid asdot ASN %q", n)
+			}
+			return uint32(h)<<16 | uint32(l), nil
+		}
+		asn, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ASN %q", n)
+		}
+		return uint32(asn), nil
+	}
+	return 0, fmt.Errorf("ASN must be a number or string, got %T", v)
+}
+
+func asnFromInt(n int64, raw any) (uint32, error) {
+	if n < 0 || n > math.MaxUint32 {
+		return 0, fmt.Errorf("ASN %v out of range", raw)
+	}
+	return uint32(n), nil
+}
+
+// formatASN renders an ASN as asplain, adding the asdot form for 4-byte
+// ASNs so either notation in a catalog can be matched by eye.
+func formatASN(asn uint32) string {
+	if asn <= math.MaxUint16 {
+		return strconv.FormatUint(uint64(asn), 10)
+	}
+	return fmt.Sprintf("%d (%d.%d)", asn, asn>>16, asn&math.MaxUint16)
+}
+
+// asnSchema is the input schema of an ASN parsed by parseASN.
+func asnSchema() *test.Schema {
+	return &test.Schema{
+		Description: "asplain number or string, or asdot string such as 65000.1",
+		AnyOf:       []*test.Schema{{Type: "integer"}, {Type: "string"}},
+	}
+}
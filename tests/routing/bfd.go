@@ -12,7 +12,9 @@ import (
 // VerifyBFDSpecificPeers verifies specific BFD (Bidirectional Forwarding Detection) peer sessions.
 //
 // This test validates that specified BFD peers are in the "up" state and have non-zero
-// remote discriminators, which indicates healthy BFD session establishment.
+// remote discriminators, which indicates healthy BFD session establishment. When any
+// of tx_interval, rx_interval or multiplier is given for a peer, the negotiated timers
+// are read from "show bfd peers detail" and compared as well.
 //
 // Note: Seamless BFD (S-BFD) is not supported in this test.
 //
@@ -21,10 +23,11 @@ import (
 //  2. Verifies that each specified peer exists in the configuration.
 //  3. Validates that the peer status is "up".
 //  4. Confirms that the remote discriminator is non-zero.
+//  5. Optionally validates the negotiated tx/rx intervals (ms) and detect multiplier.
 //
 // Expected Results:
-//   - Success: The test will pass if all specified BFD peers are up with valid discriminators.
-//   - Failure: The test will fail if any peer is down, missing, or has invalid discriminators.
+//   - Success: The test will pass if all specified BFD peers are up with valid discriminators and expected timers.
+//   - Failure: The test will fail if any peer is down, missing, has invalid discriminators, or negotiated different timers.
 //   - Error: The test will report an error if BFD peer information cannot be retrieved.
 //
 // Examples:
@@ -40,21 +43,33 @@ import (
 //   - peer_address: "192.168.1.2"
 //     vrf: "MGMT"
 //
-//   - name: VerifyBFDSpecificPeers minimal config
+//   - name: VerifyBFDSpecificPeers with timers
 //     VerifyBFDSpecificPeers:
 //     peers:
 //
-//   - peer_address: "10.1.1.1"
+//   - peer: "10.1.1.1"
 //     vrf: "default"
+//     tx_interval: 300
+//     rx_interval: 300
+//     multiplier: 3
 type VerifyBFDSpecificPeers struct {
 	test.BaseTest
 	Peers []BFDPeer `yaml:"peers" json:"peers"`
 }
 
+// BFDPeer is one expected peer. PeerAddress may also be given as "peer".
+// Zero timer fields are not checked.
 type BFDPeer struct {
 	PeerAddress string `yaml:"peer_address" json:"peer_address"`
 	VRF         string `yaml:"vrf" json:"vrf"`
 	Interface   string `yaml:"interface,omitempty" json:"interface,omitempty"`
+	TxInterval  int    `yaml:"tx_interval,omitempty" json:"tx_interval,omitempty"`
+	RxInterval  int    `yaml:"rx_interval,omitempty" json:"rx_interval,omitempty"`
+	Multiplier  int    `yaml:"multiplier,omitempty" json:"multiplier,omitempty"`
+}
+
+func (p BFDPeer) hasTimers() bool {
+	return p.TxInterval > 0 || p.RxInterval > 0 || p.Multiplier > 0
 }
 
 func NewVerifyBFDSpecificPeers(inputs map[string]any) (test.Test, error) {
@@ -68,20 +83,34 @@ func NewVerifyBFDSpecificPeers(inputs map[string]any) (test.Test, error) {
 
 	if inputs != nil {
 		if peers, ok := inputs["peers"].([]any); ok {
-			for _, peer := range peers {
-				if peerMap, ok := peer.(map[string]any); ok {
-					bfdPeer := BFDPeer{}
-					if peerAddr, ok := peerMap["peer_address"].(string); ok {
-						bfdPeer.PeerAddress = peerAddr
-					}
-					if vrf, ok := peerMap["vrf"].(string); ok {
-						bfdPeer.VRF = vrf
-					}
-					if intf, ok := peerMap["interface"].(string); ok {
-						bfdPeer.Interface = intf
-					}
-					t.Peers = append(t.Peers, bfdPeer)
+			for i, peer := range peers {
+				peerMap, ok := peer.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("peers[%d]: expected map, got %T", i, peer)
+				}
+				bfdPeer := BFDPeer{}
+				if err := test.GetString(peerMap, "peer", &bfdPeer.PeerAddress); err != nil {
+					return nil, fmt.Errorf("peers[%d]: %w", i, err)
+				}
+				if err := test.GetString(peerMap, "peer_address", &bfdPeer.PeerAddress); err != nil {
+					return nil, fmt.Errorf("peers[%d]: %w", i, err)
+				}
+				if err := test.GetString(peerMap, "vrf", &bfdPeer.VRF); err != nil {
+					return nil, fmt.Errorf("peers[%d]: %w", i, err)
+				}
+				if err := test.GetString(peerMap, "interface", &bfdPeer.Interface); err != nil {
+					return nil, fmt.Errorf("peers[%d]: %w", i, err)
 				}
+				if err := test.GetInt(peerMap, "tx_interval", &bfdPeer.TxInterval); err != nil {
+					return nil, fmt.Errorf("peers[%d]: %w", i, err)
+				}
+				if err := test.GetInt(peerMap, "rx_interval", &bfdPeer.RxInterval); err != nil {
+					return nil, fmt.Errorf("peers[%d]: %w", i, err)
+				}
+				if err := test.GetInt(peerMap, "multiplier", &bfdPeer.Multiplier); err != nil {
+					return nil, fmt.Errorf("peers[%d]: %w", i, err)
+				}
+				t.Peers = append(t.Peers, bfdPeer)
 			}
 		}
 	}
@@ -97,8 +126,18 @@ func (t *VerifyBFDSpecificPeers) Execute(ctx context.Context, dev device.Device)
 		Categories: t.Categories(),
 	}
 
+	// The detail output is a superset of the summary, so only pay for it
+	// when some peer actually asks for timer validation.
+	template := "show bfd peers"
+	for _, peer := range t.Peers {
+		if peer.hasTimers() {
+			template = "show bfd peers detail"
+			break
+		}
+	}
+
 	cmd := device.Command{
-		Template: "show bfd peers",
+		Template: template,
 		Format:   "json",
 		UseCache: false,
 	}
@@ -110,40 +149,7 @@ func (t *VerifyBFDSpecificPeers) Execute(ctx context.Context, dev device.Device)
 		return result, nil
 	}
 
-	// Parse BFD peers from device
-	devicePeers := make(map[string]BFDPeerInfo)
-	if data, ok := cmdResult.Output.(map[string]any); ok {
-		if vrfs, ok := data["vrfs"].(map[string]any); ok {
-			for vrfName, vrfData := range vrfs {
-				if vrfInfo, ok := vrfData.(map[string]any); ok {
-					if peers, ok := vrfInfo["peers"].(map[string]any); ok {
-						for peerAddr, peerData := range peers {
-							if peerInfo, ok := peerData.(map[string]any); ok {
-								peer := BFDPeerInfo{
-									PeerAddress: peerAddr,
-									VRF:         vrfName,
-								}
-
-								if status, ok := peerInfo["status"].(string); ok {
-									peer.Status = status
-								}
-								if remoteDisc, ok := peerInfo["remoteDiscriminator"].(float64); ok {
-									peer.RemoteDiscriminator = int(remoteDisc)
-								}
-								if intf, ok := peerInfo["interface"].(string); ok {
-									peer.Interface = intf
-								}
-
-								// Create unique key for peer lookup
-								key := fmt.Sprintf("%s-%s", vrfName, peerAddr)
-								devicePeers[key] = peer
-							}
-						}
-					}
-				}
-			}
-		}
-	}
+	devicePeers := parseBFDPeers(cmdResult.Output)
 
 	// Validate each expected peer
 	failures := []string{}
@@ -170,6 +176,17 @@ func (t *VerifyBFDSpecificPeers) Execute(ctx context.Context, dev device.Device)
 		if devicePeer.RemoteDiscriminator == 0 {
 			failures = append(failures, fmt.Sprintf("BFD peer %s has zero remote discriminator", expectedPeer.PeerAddress))
 		}
+
+		// Check negotiated timers if specified
+		if expectedPeer.TxInterval > 0 && devicePeer.TxInterval != expectedPeer.TxInterval {
+			failures = append(failures, fmt.Sprintf("BFD peer %s tx_interval: expected %d, got %d", expectedPeer.PeerAddress, expectedPeer.TxInterval, devicePeer.TxInterval))
+		}
+		if expectedPeer.RxInterval > 0 && devicePeer.RxInterval != expectedPeer.RxInterval {
+			failures = append(failures, fmt.Sprintf("BFD peer %s rx_interval: expected %d, got %d", expectedPeer.PeerAddress, expectedPeer.RxInterval, devicePeer.RxInterval))
+		}
+		if expectedPeer.Multiplier > 0 && devicePeer.Multiplier != expectedPeer.Multiplier {
+			failures = append(failures, fmt.Sprintf("BFD peer %s multiplier: expected %d, got %d", expectedPeer.PeerAddress, expectedPeer.Multiplier, devicePeer.Multiplier))
+		}
 	}
 
 	if len(failures) > 0 {
@@ -192,11 +209,58 @@ func (t *VerifyBFDSpecificPeers) ValidateInput(input any) error {
 		if peer.VRF == "" {
 			return fmt.Errorf("peer at index %d has no vrf", i)
 		}
+		if peer.TxInterval < 0 || peer.RxInterval < 0 || peer.Multiplier < 0 {
+			return fmt.Errorf("peer at index %d has negative timer values", i)
+		}
 	}
 
 	return nil
 }
 
+// parseBFDPeers indexes "show bfd peers [detail]" output by "<vrf>-<peer>".
+// Timer fields are only populated from the detail output.
+func parseBFDPeers(output any) map[string]BFDPeerInfo {
+	devicePeers := make(map[string]BFDPeerInfo)
+	data, ok := output.(map[string]any)
+	if !ok {
+		return devicePeers
+	}
+	vrfs, _ := data["vrfs"].(map[string]any)
+	for vrfName, vrfData := range vrfs {
+		vrfInfo, ok := vrfData.(map[string]any)
+		if !ok {
+			continue
+		}
+		peers, _ := vrfInfo["peers"].(map[string]any)
+		for peerAddr, peerData := range peers {
+			peerInfo, ok := peerData.(map[string]any)
+			if !ok {
+				continue
+			}
+			peer := BFDPeerInfo{
+				PeerAddress: peerAddr,
+				VRF:         vrfName,
+			}
+			peer.Status, _ = peerInfo["status"].(string)
+			peer.Interface, _ = peerInfo["interface"].(string)
+			if remoteDisc, ok := peerInfo["remoteDiscriminator"].(float64); ok {
+				peer.RemoteDiscriminator = int(remoteDisc)
+			}
+			if txInterval, ok := peerInfo["txInterval"].(float64); ok {
+				peer.TxInterval = int(txInterval)
+			}
+			if rxInterval, ok := peerInfo["rxInterval"].(float64); ok {
+				peer.RxInterval = int(rxInterval)
+			}
+			if multiplier, ok := peerInfo["multiplier"].(float64); ok {
+				peer.Multiplier = int(multiplier)
+			}
+			devicePeers[fmt.Sprintf("%s-%s", vrfName, peerAddr)] = peer
+		}
+	}
+	return devicePeers
+}
+
 // VerifyBFDPeersIntervals verifies operational timers of BFD peer sessions.
 //
 // This test validates that BFD peers have the expected transmit intervals,
@@ -447,6 +511,7 @@ func NewVerifyBFDPeersHealth(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyBFDPeersHealth",
 			TestDescription: "Verify overall health of all BFD peers",
 			TestCategories:  []string{"routing", "bfd"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
@@ -763,6 +828,9 @@ type BFDPeerInfo struct {
 	Status              string
 	RemoteDiscriminator int
 	DownTime            int
+	TxInterval          int
+	RxInterval          int
+	Multiplier          int
 }
 
 type BFDPeerDetailInfo struct {
@@ -0,0 +1,70 @@
+package routing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const bfdPeersDetail = `{"vrfs": {
+  "default": {"peers": {
+    "10.1.1.1": {"status": "up", "remoteDiscriminator": 3221225473, "interface": "Ethernet1",
+                 "txInterval": 300, "rxInterval": 300, "multiplier": 3},
+    "10.1.1.2": {"status": "up", "remoteDiscriminator": 3221225474, "interface": "Ethernet2",
+                 "txInterval": 1000, "rxInterval": 1000, "multiplier": 3}
+  }}
+}}`
+
+func TestVerifyBFDSpecificPeersTimers(t *testing.T) {
+	cases := []struct {
+		name   string
+		peer   map[string]any
+		status test.TestStatus
+		want   []string
+	}{
+		{"timers match", map[string]any{"peer": "10.1.1.1", "vrf": "default", "tx_interval": 300, "rx_interval": 300, "multiplier": 3},
+			test.TestSuccess, nil},
+		{"timer mismatch", map[string]any{"peer": "10.1.1.2", "vrf": "default", "tx_interval": 300, "rx_interval": 300, "multiplier": 3},
+			test.TestFailure, []string{
+				"BFD peer 10.1.1.2 tx_interval: expected 300, got 1000",
+				"BFD peer 10.1.1.2 rx_interval: expected 300, got 1000",
+			}},
+		{"multiplier only", map[string]any{"peer_address": "10.1.1.1", "vrf": "default", "multiplier": 5},
+			test.TestFailure, []string{"BFD peer 10.1.1.1 multiplier: expected 5, got 3"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBFDSpecificPeers(map[string]any{"peers": []any{tc.peer}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show bfd peers detail", bfdPeersDetail))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(res.Message, want) {
+					t.Errorf("message %q should contain %q", res.Message, want)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyBFDSpecificPeersWithoutTimersUsesSummary(t *testing.T) {
+	tst, _ := NewVerifyBFDSpecificPeers(map[string]any{"peers": []any{
+		map[string]any{"peer_address": "10.1.1.1", "vrf": "default"},
+	}})
+	dev := device.NewFakeDevice().OnJSON(t, "show bfd peers", bfdPeersDetail)
+	if res := test.RunWithFake(t, tst, dev); res.Status != test.TestSuccess {
+		t.Fatalf("status = %v, want success (%s)", res.Status, res.Message)
+	}
+	if len(dev.Calls()) != 1 || dev.Calls()[0] != "show bfd peers" {
+		t.Errorf("calls = %v, want [show bfd peers]", dev.Calls())
+	}
+}
@@ -4,8 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/fluidstackio/go-anta/internal/rib"
 	"github.com/fluidstackio/go-anta/pkg/device"
 	"github.com/fluidstackio/go-anta/pkg/test"
 )
@@ -30,6 +37,11 @@ func decodeOutput(out interface{}, dst interface{}) error {
 //  2. Validates that the BGP session state matches the expected state.
 //  3. Optionally validates the peer's ASN if specified.
 //
+// A peer's state is read from `show bgp summary`, or with address_family
+// set from that family's summary (e.g. `show bgp evpn summary`), so a
+// peer up for IPv4 but down for EVPN is caught. Messages name the family
+// each peer was evaluated in.
+//
 // Expected Results:
 //   - Success: All specified peers are found with correct session states and ASNs.
 //   - Failure: A peer is not found, session state doesn't match, or ASN doesn't match.
@@ -46,6 +58,8 @@ func decodeOutput(out interface{}, dst interface{}) error {
 //   - peer: "10.0.0.2"
 //     state: "Established"
 //     asn: 65002
+//     address_family:
+//     afi: "evpn"
 
 type VerifyBGPPeers struct {
 	test.BaseTest
@@ -55,8 +69,39 @@ type VerifyBGPPeers struct {
 type BGPPeer struct {
 	Peer  string `yaml:"peer" json:"peer"`
 	State string `yaml:"state" json:"state"`
-	ASN   int    `yaml:"asn,omitempty" json:"asn,omitempty"`
-	VRF   string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+	// ASN accepts asplain numbers or strings and asdot strings; see
+	// parseASN. Zero skips the ASN check.
+	ASN uint32 `yaml:"asn,omitempty" json:"asn,omitempty"`
+	VRF string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+	// AddressFamily scopes the check to one AFI/SAFI; nil uses the
+	// unscoped `show bgp summary`.
+	AddressFamily *BGPPeerAddressFamily `yaml:"address_family,omitempty" json:"address_family,omitempty"`
+
+	asnErr error
+}
+
+// BGPPeerAddressFamily names the address family a VerifyBGPPeers peer is
+// checked in, in the same afi/safi form as BgpAddressFamily.
+type BGPPeerAddressFamily struct {
+	AFI  string `yaml:"afi" json:"afi"`
+	SAFI string `yaml:"safi,omitempty" json:"safi,omitempty"`
+}
+
+// summaryCommand returns the `show bgp ... summary` to read the peer from.
+func (p BGPPeer) summaryCommand() string {
+	if p.AddressFamily == nil {
+		return "show bgp summary"
+	}
+	return bgpSummaryCommand(p.AddressFamily.AFI, p.AddressFamily.SAFI)
+}
+
+// afLabel names the family the peer is evaluated in for messages.
+func (p BGPPeer) afLabel() string {
+	if p.AddressFamily == nil {
+		return "all address families"
+	}
+	cmd := bgpSummaryCommand(p.AddressFamily.AFI, p.AddressFamily.SAFI)
+	return strings.TrimSuffix(strings.TrimPrefix(cmd, "show bgp "), " summary")
 }
 
 func NewVerifyBGPPeers(inputs map[string]any) (test.Test, error) {
@@ -65,12 +110,13 @@ func NewVerifyBGPPeers(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyBGPPeers",
 			TestDescription: "Verify BGP peer status and configuration",
 			TestCategories:  []string{"routing", "bgp"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
 	if inputs != nil {
 		if peers, ok := inputs["peers"].([]any); ok {
-			for _, p := range peers {
+			for i, p := range peers {
 				if peerMap, ok := p.(map[string]any); ok {
 					peer := BGPPeer{
 						State: "Established",
@@ -82,14 +128,22 @@ func NewVerifyBGPPeers(inputs map[string]any) (test.Test, error) {
 					if state, ok := peerMap["state"].(string); ok {
 						peer.State = state
 					}
-					if asn, ok := peerMap["asn"].(float64); ok {
-						peer.ASN = int(asn)
-					} else if asn, ok := peerMap["asn"].(int); ok {
-						peer.ASN = asn
+					if raw, ok := peerMap["asn"]; ok {
+						peer.ASN, peer.asnErr = parseASN(raw)
 					}
 					if vrf, ok := peerMap["vrf"].(string); ok {
 						peer.VRF = vrf
 					}
+					if afMap, ok := peerMap["address_family"].(map[string]any); ok {
+						af := &BGPPeerAddressFamily{}
+						if err := test.GetString(afMap, "afi", &af.AFI); err != nil {
+							return nil, fmt.Errorf("peers[%d]: %w", i, err)
+						}
+						if err := test.GetString(afMap, "safi", &af.SAFI); err != nil {
+							return nil, fmt.Errorf("peers[%d]: %w", i, err)
+						}
+						peer.AddressFamily = af
+					}
 					t.Peers = append(t.Peers, peer)
 				}
 			}
@@ -99,6 +153,19 @@ func NewVerifyBGPPeers(inputs map[string]any) (test.Test, error) {
 	return t, nil
 }
 
+// InputSchema requires a non-empty peers list whose entries name a peer.
+func (t *VerifyBGPPeers) InputSchema() *test.Schema {
+	s := test.SchemaOf(t)
+	s.Required = []string{"peers"}
+	minItems := 1
+	peers := s.Properties["peers"]
+	peers.MinItems = &minItems
+	peers.Items.Required = []string{"peer"}
+	peers.Items.Properties["address_family"].Required = []string{"afi"}
+	peers.Items.Properties["asn"] = asnSchema()
+	return s
+}
+
 func (t *VerifyBGPPeers) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
 	result := &test.TestResult{
 		TestName:   t.Name(),
@@ -113,58 +180,78 @@ func (t *VerifyBGPPeers) Execute(ctx context.Context, dev device.Device) (*test.
 		return result, nil
 	}
 
-	cmd := device.Command{
-		Template: "show bgp summary",
-		Format:   "json",
-		UseCache: false,
-	}
-
-	cmdResult, err := dev.Execute(ctx, cmd)
-	if err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to get BGP summary: %v", err)
-		return result, nil
-	}
-
+	// Peers checked in the same address family share one summary.
+	summaries := map[string]map[string]any{}
 	issues := []string{}
+	evaluated := []string{}
 
-	if bgpData, ok := cmdResult.Output.(map[string]any); ok {
-		if vrfs, ok := bgpData["vrfs"].(map[string]any); ok {
-			for _, peer := range t.Peers {
-				vrfName := peer.VRF
-				if vrfName == "" {
-					vrfName = "default"
+	for i, peer := range t.Peers {
+		if test.Cancelled(ctx, result, i, len(t.Peers), "peers", issues) {
+			return result, nil
+		}
+		template := peer.summaryCommand()
+		vrfs, fetched := summaries[template]
+		if !fetched {
+			cmdResult, err := dev.Execute(ctx, device.Command{
+				Template: template,
+				Format:   "json",
+				UseCache: false,
+			})
+			if err != nil {
+				result.Status = test.TestError
+				if peer.AddressFamily == nil {
+					result.Message = fmt.Sprintf("Failed to get BGP summary: %v", err)
+				} else {
+					result.Message = fmt.Sprintf("Failed to get BGP summary for %s: %v", peer.afLabel(), err)
 				}
+				return result, nil
+			}
+			if bgpData, ok := cmdResult.Output.(map[string]any); ok {
+				vrfs, _ = bgpData["vrfs"].(map[string]any)
+			}
+			summaries[template] = vrfs
+		}
+		if vrfs == nil {
+			continue
+		}
+
+		vrfName := peer.VRF
+		if vrfName == "" {
+			vrfName = "default"
+		}
+		af := peer.afLabel()
+		evaluated = append(evaluated, fmt.Sprintf("%s vrf %s: %s", peer.Peer, vrfName, af))
+
+		vrfData, vrfExists := vrfs[vrfName]
+		if !vrfExists {
+			issues = append(issues, fmt.Sprintf("VRF %s not found in %s", vrfName, af))
+			continue
+		}
 
-				vrfData, vrfExists := vrfs[vrfName]
-				if !vrfExists {
-					issues = append(issues, fmt.Sprintf("VRF %s not found", vrfName))
+		if vrf, ok := vrfData.(map[string]any); ok {
+			if peers, ok := vrf["peers"].(map[string]any); ok {
+				peerData, peerExists := peers[peer.Peer]
+				if !peerExists {
+					issues = append(issues, fmt.Sprintf("Peer %s not found in VRF %s (%s)", peer.Peer, vrfName, af))
 					continue
 				}
 
-				if vrf, ok := vrfData.(map[string]any); ok {
-					if peers, ok := vrf["peers"].(map[string]any); ok {
-						peerData, peerExists := peers[peer.Peer]
-						if !peerExists {
-							issues = append(issues, fmt.Sprintf("Peer %s not found in VRF %s", peer.Peer, vrfName))
-							continue
+				if peerInfo, ok := peerData.(map[string]any); ok {
+					if peerState, ok := peerInfo["peerState"].(string); ok {
+						if !strings.EqualFold(peerState, peer.State) {
+							issues = append(issues, fmt.Sprintf("Peer %s (%s): expected state %s, got %s",
+								peer.Peer, af, peer.State, peerState))
 						}
+					}
 
-						if peerInfo, ok := peerData.(map[string]any); ok {
-							if peerState, ok := peerInfo["peerState"].(string); ok {
-								if !strings.EqualFold(peerState, peer.State) {
-									issues = append(issues, fmt.Sprintf("Peer %s: expected state %s, got %s",
-										peer.Peer, peer.State, peerState))
-								}
-							}
-
-							if peer.ASN > 0 {
-								if asn, ok := peerInfo["asn"].(float64); ok {
-									if int(asn) != peer.ASN {
-										issues = append(issues, fmt.Sprintf("Peer %s: expected ASN %d, got %d",
-											peer.Peer, peer.ASN, int(asn)))
-									}
-								}
+					if peer.ASN > 0 {
+						if raw, ok := peerInfo["asn"]; ok {
+							asn, err := parseASN(raw)
+							if err != nil {
+								issues = append(issues, fmt.Sprintf("Peer %s (%s): %v", peer.Peer, af, err))
+							} else if asn != peer.ASN {
+								issues = append(issues, fmt.Sprintf("Peer %s (%s): expected ASN %s, got %s",
+									peer.Peer, af, formatASN(peer.ASN), formatASN(asn)))
 							}
 						}
 					}
@@ -173,6 +260,8 @@ func (t *VerifyBGPPeers) Execute(ctx context.Context, dev device.Device) (*test.
 		}
 	}
 
+	result.Details = map[string]any{"evaluated": evaluated}
+
 	if len(issues) > 0 {
 		result.Status = test.TestFailure
 		result.Message = fmt.Sprintf("BGP peer issues: %v", issues)
@@ -190,8 +279,11 @@ func (t *VerifyBGPPeers) ValidateInput(input any) error {
 		if peer.Peer == "" {
 			return fmt.Errorf("peer at index %d has no address", i)
 		}
-		if peer.ASN < 0 {
-			return fmt.Errorf("peer %s has invalid ASN", peer.Peer)
+		if peer.asnErr != nil {
+			return fmt.Errorf("peer %s has invalid ASN: %w", peer.Peer, peer.asnErr)
+		}
+		if peer.AddressFamily != nil && strings.TrimSpace(peer.AddressFamily.AFI) == "" {
+			return fmt.Errorf("peer %s: address_family requires afi", peer.Peer)
 		}
 	}
 
@@ -479,6 +571,51 @@ type BgpRoute struct {
 	VRF           string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
 }
 
+// bgpRouteCommandFamily returns the keyword EOS uses in route commands
+// for prefix: `show ip bgp` / `show ip route` for IPv4, `show ipv6 bgp`
+// / `show ipv6 route` for IPv6. An explicit `address_family` input wins;
+// without one the family comes from the prefix itself, so one routes
+// list can mix IPv4 and IPv6 prefixes.
+func bgpRouteCommandFamily(af, prefix string) string {
+	switch af {
+	case "ipv6":
+		return "ipv6"
+	case "ipv4":
+		return "ip"
+	}
+	return rib.Family(prefix)
+}
+
+// validateBgpRouteFamily checks `address_family` and, when it is set,
+// that every route prefix belongs to it, so an IPv6 prefix is not
+// silently looked up in the IPv4 table.
+func validateBgpRouteFamily(af string, routes []BgpRoute) error {
+	if af == "" {
+		return nil
+	}
+	if af != "ipv4" && af != "ipv6" {
+		return fmt.Errorf("address_family must be ipv4 or ipv6, got %q", af)
+	}
+	for i, r := range routes {
+		if _, _, err := net.ParseCIDR(r.Prefix); err != nil {
+			continue
+		}
+		if rib.IsIPv6(r.Prefix) != (af == "ipv6") {
+			return fmt.Errorf("routes[%d]: prefix %s is not an %s prefix", i, r.Prefix, af)
+		}
+	}
+	return nil
+}
+
+// bgpRouteScope names the table a route test checked in its messages:
+// "BGP ipv6" with an explicit `address_family`, plain "BGP" otherwise.
+func bgpRouteScope(af string) string {
+	if af == "" {
+		return "BGP"
+	}
+	return "BGP " + af
+}
+
 // parseBgpRoutes reads the `routes:` list from a YAML inputs map into
 // []BgpRoute. Each entry must be a map with at least `prefix`; `vrf`
 // defaults to "default" and `expected_paths` is optional.
@@ -513,6 +650,198 @@ func parseBgpRoutes(inputs map[string]any) ([]BgpRoute, error) {
 	return routes, nil
 }
 
+// bgpPeerLabel names a peer for report messages: its address, or
+// "interface EthernetN" for interface-based (RFC5549) peers.
+func bgpPeerLabel(peer BgpPeerExtended) string {
+	if peer.PeerAddress != "" {
+		return peer.PeerAddress
+	}
+	return "interface " + peer.Interface
+}
+
+// bgpNeighborCommand returns the `show bgp neighbors` invocation for a
+// single peer. Interface-based peers have no address to put on the
+// command line — `show bgp neighbors  vrf default` is malformed — so for
+// them the whole VRF is fetched and findBgpNeighbor picks the entry.
+func bgpNeighborCommand(peer BgpPeerExtended, vrf string) string {
+	if peer.PeerAddress != "" {
+		return fmt.Sprintf("show bgp neighbors %s vrf %s", peer.PeerAddress, vrf)
+	}
+	return fmt.Sprintf("show bgp neighbors vrf %s", vrf)
+}
+
+// findBgpNeighbor returns the peerList entry for peer from a
+// `show bgp neighbors` response. Address peers match on peerAddress;
+// interface peers match on ifName or, as VerifyBGPPeerSession does for
+// the summary, on a peerAddress ending in "%<interface>" (the link-local
+// form EOS uses for unnumbered sessions). A peer with neither never
+// matches.
+func findBgpNeighbor(output any, vrf string, peer BgpPeerExtended) (map[string]any, bool) {
+	var response struct {
+		VRFs map[string]struct {
+			PeerList []map[string]any `json:"peerList"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(output, &response); err != nil {
+		return nil, false
+	}
+	for _, entry := range response.VRFs[vrf].PeerList {
+		addr, _ := entry["peerAddress"].(string)
+		if peer.PeerAddress != "" {
+			// Compare parsed addresses so IPv6 peers written in a
+			// different textual form still match.
+			ip := net.ParseIP(addr)
+			if addr == peer.PeerAddress || (ip != nil && ip.Equal(net.ParseIP(peer.PeerAddress))) {
+				return entry, true
+			}
+			continue
+		}
+		if peer.Interface == "" {
+			continue
+		}
+		if ifName, _ := entry["ifName"].(string); ifName == peer.Interface {
+			return entry, true
+		}
+		if strings.HasSuffix(addr, "%"+peer.Interface) {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// validateBgpPeerIdentity requires every peer to name either an
+// address or an interface, but not both.
+func validateBgpPeerIdentity(peers []BgpPeerExtended) error {
+	for i, peer := range peers {
+		if peer.PeerAddress == "" && peer.Interface == "" {
+			return fmt.Errorf("bgp_peers[%d]: one of peer_address or interface is required", i)
+		}
+		if peer.PeerAddress != "" && peer.Interface != "" {
+			return fmt.Errorf("bgp_peers[%d]: peer_address and interface are mutually exclusive", i)
+		}
+	}
+	return nil
+}
+
+// bgpPeersSchema is the input schema shared by the tests that take a
+// bgp_peers list: the test's own fields, with every peer required to
+// name an address or an interface as validateBgpPeerIdentity enforces.
+func bgpPeersSchema(t test.Test) *test.Schema {
+	s := test.SchemaOf(t)
+	peer := s.Properties["bgp_peers"].Items
+	peer.AnyOf = []*test.Schema{
+		{Required: []string{"peer_address"}},
+		{Required: []string{"interface"}},
+	}
+	// The counter inputs also take a list of names or single-entry maps
+	// (see parseBgpDropStats), which the map field types cannot express.
+	for _, key := range []string{"drop_stats", "update_errors"} {
+		byName := peer.Properties[key]
+		peer.Properties[key] = &test.Schema{AnyOf: []*test.Schema{
+			{Type: "array", Items: &test.Schema{AnyOf: []*test.Schema{{Type: "string"}, byName}}},
+			byName,
+		}}
+	}
+	return s
+}
+
+func (t *VerifyBGPPeerSession) InputSchema() *test.Schema         { return bgpPeersSchema(t) }
+func (t *VerifyBGPExchangedRoutes) InputSchema() *test.Schema     { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerMPCaps) InputSchema() *test.Schema          { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerASNCap) InputSchema() *test.Schema          { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerRouteRefreshCap) InputSchema() *test.Schema { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerMD5Auth) InputSchema() *test.Schema         { return bgpPeersSchema(t) }
+func (t *VerifyBGPAdvCommunities) InputSchema() *test.Schema      { return bgpPeersSchema(t) }
+func (t *VerifyBGPTimers) InputSchema() *test.Schema              { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerDropStats) InputSchema() *test.Schema       { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerUpdateErrors) InputSchema() *test.Schema    { return bgpPeersSchema(t) }
+func (t *VerifyBgpRouteMaps) InputSchema() *test.Schema           { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerRouteLimit) InputSchema() *test.Schema      { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerGroup) InputSchema() *test.Schema           { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerSessionRibd) InputSchema() *test.Schema     { return bgpPeersSchema(t) }
+func (t *VerifyBGPNlriAcceptance) InputSchema() *test.Schema      { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerTtlMultiHops) InputSchema() *test.Schema    { return bgpPeersSchema(t) }
+func (t *VerifyBGPGracefulRestart) InputSchema() *test.Schema     { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerFlapCount) InputSchema() *test.Schema       { return bgpPeersSchema(t) }
+func (t *VerifyBGPAdditionalPaths) InputSchema() *test.Schema     { return bgpPeersSchema(t) }
+func (t *VerifyBGPPeerConnectedCheck) InputSchema() *test.Schema  { return bgpPeersSchema(t) }
+
+// bgpNeighborEntry is one peer selected from a `show bgp neighbors vrf
+// all` response, along with the label used for it in report messages.
+type bgpNeighborEntry struct {
+	Label string
+	VRF   string
+	Info  map[string]any
+}
+
+// selectBgpNeighbors picks the peers a neighbor-detail test should
+// check. With an explicit peer list each peer is looked up with
+// findBgpNeighbor and missing ones are reported as issues. With no peers
+// configured every peer in every VRF is returned, so the test audits the
+// whole device.
+func selectBgpNeighbors(output any, peers []BgpPeerExtended) ([]bgpNeighborEntry, []string) {
+	var entries []bgpNeighborEntry
+	var issues []string
+
+	if len(peers) > 0 {
+		for _, peer := range peers {
+			vrf := peer.VRF
+			if vrf == "" {
+				vrf = "default"
+			}
+			info, found := findBgpNeighbor(output, vrf, peer)
+			if !found {
+				issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", bgpPeerLabel(peer), vrf))
+				continue
+			}
+			entries = append(entries, bgpNeighborEntry{Label: bgpPeerLabel(peer), VRF: vrf, Info: info})
+		}
+		return entries, issues
+	}
+
+	var response struct {
+		VRFs map[string]struct {
+			PeerList []map[string]any `json:"peerList"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(output, &response); err != nil {
+		return nil, []string{fmt.Sprintf("Failed to parse BGP neighbors output: %v", err)}
+	}
+	vrfs := make([]string, 0, len(response.VRFs))
+	for vrf := range response.VRFs {
+		vrfs = append(vrfs, vrf)
+	}
+	sort.Strings(vrfs)
+	for _, vrf := range vrfs {
+		for _, info := range response.VRFs[vrf].PeerList {
+			addr, _ := info["peerAddress"].(string)
+			entries = append(entries, bgpNeighborEntry{Label: addr, VRF: vrf, Info: info})
+		}
+	}
+	if len(entries) == 0 {
+		issues = append(issues, "No BGP peers found")
+	}
+	return entries, issues
+}
+
+// bgpNeighborCapability reads neighborCapabilities.<key> from a peer's
+// neighbor detail.
+func bgpNeighborCapability(info map[string]any, key string) (bgpCapability, bool) {
+	caps, ok := info["neighborCapabilities"].(map[string]any)
+	if !ok {
+		return bgpCapability{}, false
+	}
+	raw, ok := caps[key]
+	if !ok {
+		return bgpCapability{}, false
+	}
+	var c bgpCapability
+	if err := decodeOutput(raw, &c); err != nil {
+		return bgpCapability{}, false
+	}
+	return c, true
+}
+
 // BgpPeerExtended represents extended BGP peer configuration
 type BgpPeerExtended struct {
 	PeerAddress           string         `yaml:"peer_address,omitempty" json:"peer_address,omitempty"`
@@ -527,8 +856,9 @@ type BgpPeerExtended struct {
 	MaximumRoutes         int            `yaml:"maximum_routes,omitempty" json:"maximum_routes,omitempty"`
 	WarningLimit          int            `yaml:"warning_limit,omitempty" json:"warning_limit,omitempty"`
 	PeerGroup             string         `yaml:"peer_group,omitempty" json:"peer_group,omitempty"`
-	TTL                   int            `yaml:"ttl,omitempty" json:"ttl,omitempty"`
-	ExpectedTTL           int            `yaml:"expected_ttl,omitempty" json:"expected_ttl,omitempty"`
+	TTLSecurity           int            `yaml:"ttl_security,omitempty" json:"ttl_security,omitempty"`
+	AddressFamily         string         `yaml:"address_family,omitempty" json:"address_family,omitempty"`
+	Direction             string         `yaml:"direction,omitempty" json:"direction,omitempty"`
 	MaxHops               int            `yaml:"max_hops,omitempty" json:"max_hops,omitempty"`
 	DropStats             map[string]int `yaml:"drop_stats,omitempty" json:"drop_stats,omitempty"`
 	UpdateErrors          map[string]any `yaml:"update_errors,omitempty" json:"update_errors,omitempty"`
@@ -618,7 +948,10 @@ func (t *VerifyBGPPeerCount) Execute(ctx context.Context, dev device.Device) (*t
 
 	issues := []string{}
 
-	for _, af := range t.AddressFamilies {
+	for i, af := range t.AddressFamilies {
+		if test.Cancelled(ctx, result, i, len(t.AddressFamilies), "address families", issues) {
+			return result, nil
+		}
 		vrf := af.VRF
 		if vrf == "" {
 			vrf = "default"
@@ -754,6 +1087,7 @@ func NewVerifyBGPPeersHealth(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyBGPPeersHealth",
 			TestDescription: "Verifies health of all BGP peers",
 			TestCategories:  []string{"routing", "bgp", "health"},
+			TestSeverity:    test.SeverityCritical,
 		},
 		CheckTCPQueues: true,
 	}
@@ -799,7 +1133,10 @@ func (t *VerifyBGPPeersHealth) Execute(ctx context.Context, dev device.Device) (
 
 	issues := []string{}
 
-	for _, af := range t.AddressFamilies {
+	for i, af := range t.AddressFamilies {
+		if test.Cancelled(ctx, result, i, len(t.AddressFamilies), "address families", issues) {
+			return result, nil
+		}
 		vrf := af.VRF
 		if vrf == "" {
 			vrf = "default"
@@ -928,6 +1265,7 @@ func NewVerifyBGPSpecificPeers(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyBGPSpecificPeers",
 			TestDescription: "Verifies specific BGP peers health",
 			TestCategories:  []string{"routing", "bgp", "specific-peers"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
@@ -1102,7 +1440,9 @@ func (t *VerifyBGPSpecificPeers) ValidateInput(input any) error {
 //     vrf: "MGMT"
 type VerifyBGPPeerSession struct {
 	test.BaseTest
-	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
+	MinimumEstablishedTime int               `yaml:"minimum_established_time,omitempty" json:"minimum_established_time,omitempty"`
+	CheckTCPQueues         bool              `yaml:"check_tcp_queues,omitempty" json:"check_tcp_queues,omitempty"`
+	BGPPeers               []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
 }
 
 func NewVerifyBGPPeerSession(inputs map[string]any) (test.Test, error) {
@@ -1111,7 +1451,16 @@ func NewVerifyBGPPeerSession(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyBGPPeerSession",
 			TestDescription: "Verifies individual BGP peer sessions",
 			TestCategories:  []string{"routing", "bgp", "session"},
+			TestSeverity:    test.SeverityCritical,
 		},
+		CheckTCPQueues: true,
+	}
+
+	if err := test.GetInt(inputs, "minimum_established_time", &t.MinimumEstablishedTime); err != nil {
+		return nil, err
+	}
+	if err := test.GetBool(inputs, "check_tcp_queues", &t.CheckTCPQueues); err != nil {
+		return nil, err
 	}
 
 	if inputs != nil {
@@ -1194,13 +1543,7 @@ func (t *VerifyBGPPeerSession) Execute(ctx context.Context, dev device.Device) (
 
 							if found {
 								if peerInfo, ok := peerData.(map[string]any); ok {
-									// Check peer state
-									if state, ok := peerInfo["peerState"].(string); ok {
-										if !strings.EqualFold(state, "Established") {
-											issues = append(issues, fmt.Sprintf("Peer %s in VRF %s is %s, not Established",
-												peerKey, vrf, state))
-										}
-									}
+									issues = append(issues, t.checkPeer(peerKey, vrf, peerInfo, time.Now())...)
 								}
 							} else {
 								identifier := peer.PeerAddress
@@ -1228,7 +1571,65 @@ func (t *VerifyBGPPeerSession) Execute(ctx context.Context, dev device.Device) (
 	return result, nil
 }
 
-func (t *VerifyBGPPeerSession) ValidateInput(input any) error { return nil }
+// checkPeer evaluates one `show bgp summary` peer entry against the
+// session state, minimum uptime, and TCP queue requirements, returning
+// one issue per failed condition so the report says exactly why a
+// peer is unhealthy.
+func (t *VerifyBGPPeerSession) checkPeer(peerKey, vrf string, peerInfo map[string]any, now time.Time) []string {
+	var issues []string
+	if state, ok := peerInfo["peerState"].(string); ok {
+		if !strings.EqualFold(state, "Established") {
+			// Uptime and queues are meaningless for a down session.
+			return []string{fmt.Sprintf("Peer %s in VRF %s is %s, not Established", peerKey, vrf, state)}
+		}
+	}
+
+	if t.MinimumEstablishedTime > 0 {
+		if uptime, ok := bgpEstablishedSeconds(peerInfo, now); ok && uptime < float64(t.MinimumEstablishedTime) {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s established for only %.0f seconds (minimum: %d)",
+				peerKey, vrf, uptime, t.MinimumEstablishedTime))
+		}
+	}
+
+	if t.CheckTCPQueues {
+		if inQueue, ok := peerInfo["inMsgQueue"].(float64); ok && inQueue > 0 {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s has %d messages in input queue",
+				peerKey, vrf, int(inQueue)))
+		}
+		if outQueue, ok := peerInfo["outMsgQueue"].(float64); ok && outQueue > 0 {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s has %d messages in output queue",
+				peerKey, vrf, int(outQueue)))
+		}
+	}
+	return issues
+}
+
+// bgpEstablishedSeconds returns how long a peer has been in its current
+// state. `show bgp neighbors` reports a duration in `establishedTime`;
+// `show bgp summary` only has `upDownTime`, which EOS emits as the Unix
+// timestamp of the last state change rather than a duration, so it is
+// converted relative to now.
+func bgpEstablishedSeconds(peerInfo map[string]any, now time.Time) (float64, bool) {
+	if v, ok := peerInfo["establishedTime"].(float64); ok {
+		return v, true
+	}
+	v, ok := peerInfo["upDownTime"].(float64)
+	if !ok {
+		return 0, false
+	}
+	// Anything past 2001-09-09 is a timestamp, not a plausible uptime.
+	if v > 1e9 {
+		return now.Sub(time.Unix(0, int64(v*float64(time.Second)))).Seconds(), true
+	}
+	return v, true
+}
+
+func (t *VerifyBGPPeerSession) ValidateInput(input any) error {
+	if t.MinimumEstablishedTime < 0 {
+		return fmt.Errorf("minimum_established_time must not be negative")
+	}
+	return nil
+}
 
 // VerifyBGPExchangedRoutes verifies the advertised and received routes of BGP IPv4 peer(s).
 //
@@ -1240,6 +1641,9 @@ func (t *VerifyBGPPeerSession) ValidateInput(input any) error { return nil }
 //   - If `check_active` input flag is True, verifies that the route is 'valid' and 'active'.
 //   - If `check_active` input flag is False, verifies that the route is 'valid'.
 //
+// The advertised- and received-routes queries for every peer are sent as
+// one batched request rather than two round-trips per peer.
+//
 // Expected Results:
 //   - Success: All specified advertised/received routes are found and have correct states.
 //   - Failure: Routes are missing or don't have expected 'active' and 'valid' states.
@@ -1264,7 +1668,8 @@ func (t *VerifyBGPPeerSession) ValidateInput(input any) error { return nil }
 //   - "192.0.254.5/32"
 type VerifyBGPExchangedRoutes struct {
 	test.BaseTest
-	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
+	BGPPeers    []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
+	CheckActive bool              `yaml:"check_active,omitempty" json:"check_active,omitempty"`
 }
 
 func NewVerifyBGPExchangedRoutes(inputs map[string]any) (test.Test, error) {
@@ -1307,6 +1712,10 @@ func NewVerifyBGPExchangedRoutes(inputs map[string]any) (test.Test, error) {
 		}
 	}
 
+	if err := test.GetBool(inputs, "check_active", &t.CheckActive); err != nil {
+		return nil, err
+	}
+
 	return t, nil
 }
 
@@ -1320,69 +1729,79 @@ func (t *VerifyBGPExchangedRoutes) Execute(ctx context.Context, dev device.Devic
 
 	issues := []string{}
 
+	// One query per peer and direction, all sent in a single runCmds
+	// request; each result is matched back to its query so errors still
+	// name the peer they belong to.
+	type routeQuery struct {
+		peer      string
+		vrf       string
+		kind      string // "advertised" or "received"
+		direction string // checkRoutes wording
+		expected  []string
+	}
+	var queries []routeQuery
+	var cmds []device.Command
 	for _, peer := range t.BGPPeers {
 		vrf := peer.VRF
 		if vrf == "" {
 			vrf = "default"
 		}
-
-		// Check advertised routes
-		if len(peer.AdvertisedRoutes) > 0 {
-			cmd := device.Command{
-				Template: fmt.Sprintf("show bgp neighbors %s advertised-routes vrf %s", peer.PeerAddress, vrf),
-				Format:   "json",
-				UseCache: false,
+		for _, q := range []routeQuery{
+			{peer.PeerAddress, vrf, "advertised", "advertised to", peer.AdvertisedRoutes},
+			{peer.PeerAddress, vrf, "received", "received from", peer.ReceivedRoutes},
+		} {
+			if len(q.expected) == 0 {
+				continue
 			}
-
-			cmdResult, err := dev.Execute(ctx, cmd)
+			cmdStr, err := renderBGPNeighborRoutes(q.peer, q.kind, q.vrf)
 			if err != nil {
-				issues = append(issues, fmt.Sprintf("Failed to get advertised routes for peer %s: %v", peer.PeerAddress, err))
+				issues = append(issues, err.Error())
 				continue
 			}
-
-			if bgpData, ok := cmdResult.Output.(map[string]any); ok {
-				if vrfData, ok := bgpData["vrfs"].(map[string]any); ok {
-					if vrfInfo, ok := vrfData[vrf].(map[string]any); ok {
-						if routes, ok := vrfInfo["bgpRouteEntries"].(map[string]any); ok {
-							for _, expectedRoute := range peer.AdvertisedRoutes {
-								if _, exists := routes[expectedRoute]; !exists {
-									issues = append(issues, fmt.Sprintf("Route %s not advertised to peer %s", expectedRoute, peer.PeerAddress))
-								}
-							}
-						}
-					}
-				}
-			}
-		}
-
-		// Check received routes
-		if len(peer.ReceivedRoutes) > 0 {
-			cmd := device.Command{
-				Template: fmt.Sprintf("show bgp neighbors %s received-routes vrf %s", peer.PeerAddress, vrf),
+			queries = append(queries, q)
+			cmds = append(cmds, device.Command{
+				Template: cmdStr,
 				Format:   "json",
 				UseCache: false,
-			}
+			})
+		}
+	}
 
-			cmdResult, err := dev.Execute(ctx, cmd)
+	var results []*device.CommandResult
+	var err error
+	if len(cmds) > 0 {
+		results, err = dev.ExecuteBatch(ctx, cmds)
+	}
+	if err != nil {
+		// EOS fails the whole request when one command is rejected, so
+		// fall back to one command at a time to attribute the failure.
+		results = make([]*device.CommandResult, len(cmds))
+		for i, cmd := range cmds {
+			if test.Cancelled(ctx, result, i, len(cmds), "route queries", issues) {
+				return result, nil
+			}
+			res, err := dev.Execute(ctx, cmd)
 			if err != nil {
-				issues = append(issues, fmt.Sprintf("Failed to get received routes for peer %s: %v", peer.PeerAddress, err))
-				continue
+				res = &device.CommandResult{Command: cmd, Error: err}
 			}
+			results[i] = res
+		}
+	}
 
-			if bgpData, ok := cmdResult.Output.(map[string]any); ok {
-				if vrfData, ok := bgpData["vrfs"].(map[string]any); ok {
-					if vrfInfo, ok := vrfData[vrf].(map[string]any); ok {
-						if routes, ok := vrfInfo["bgpRouteEntries"].(map[string]any); ok {
-							for _, expectedRoute := range peer.ReceivedRoutes {
-								if _, exists := routes[expectedRoute]; !exists {
-									issues = append(issues, fmt.Sprintf("Route %s not received from peer %s", expectedRoute, peer.PeerAddress))
-								}
-							}
-						}
-					}
-				}
-			}
+	for i, q := range queries {
+		var res *device.CommandResult
+		if i < len(results) {
+			res = results[i]
 		}
+		if res == nil {
+			issues = append(issues, fmt.Sprintf("Failed to get %s routes for peer %s: no response", q.kind, q.peer))
+			continue
+		}
+		if res.Error != nil {
+			issues = append(issues, fmt.Sprintf("Failed to get %s routes for peer %s: %v", q.kind, q.peer, res.Error))
+			continue
+		}
+		issues = append(issues, t.checkRoutes(res.Output, q.vrf, q.expected, q.direction, q.peer)...)
 	}
 
 	if len(issues) > 0 {
@@ -1395,7 +1814,66 @@ func (t *VerifyBGPExchangedRoutes) Execute(ctx context.Context, dev device.Devic
 	return result, nil
 }
 
-func (t *VerifyBGPExchangedRoutes) ValidateInput(input any) error { return nil }
+// checkRoutes compares the expected prefixes against the
+// bgpRouteEntries of an advertised-/received-routes response. Missing
+// prefixes and prefixes that are present but not in the required state
+// are reported separately, since the second usually points at policy or
+// next-hop resolution rather than a missing advertisement. direction is
+// "advertised to" or "received from" and only shapes the message.
+func (t *VerifyBGPExchangedRoutes) checkRoutes(output any, vrf string, expected []string, direction, peer string) []string {
+	var resp struct {
+		VRFs map[string]struct {
+			BgpRouteEntries map[string]struct {
+				BgpRoutePaths []struct {
+					RouteType struct {
+						Valid  bool `json:"valid"`
+						Active bool `json:"active"`
+					} `json:"routeType"`
+				} `json:"bgpRoutePaths"`
+			} `json:"bgpRouteEntries"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(output, &resp); err != nil {
+		return []string{fmt.Sprintf("Failed to parse routes %s peer %s: %v", direction, peer, err)}
+	}
+
+	var issues []string
+	routes := resp.VRFs[vrf].BgpRouteEntries
+	for _, prefix := range expected {
+		entry, exists := routes[prefix]
+		if !exists {
+			issues = append(issues, fmt.Sprintf("Route %s not %s peer %s", prefix, direction, peer))
+			continue
+		}
+		valid, active := false, false
+		for _, path := range entry.BgpRoutePaths {
+			valid = valid || path.RouteType.Valid
+			active = active || path.RouteType.Active
+		}
+		if !valid || (t.CheckActive && !active) {
+			issues = append(issues, fmt.Sprintf("Route %s %s peer %s is present but not in the expected state: valid=%t, active=%t",
+				prefix, direction, peer, valid, active))
+		}
+	}
+	return issues
+}
+
+func (t *VerifyBGPExchangedRoutes) ValidateInput(input any) error {
+	for i, peer := range t.BGPPeers {
+		if _, err := renderBGPNeighborRoutes(peer.PeerAddress, "advertised", peer.VRF); err != nil {
+			return fmt.Errorf("bgp_peers[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// bgpNeighborRoutesCmd lists the routes exchanged with one peer;
+// direction is "advertised" or "received".
+var bgpNeighborRoutesCmd = device.Template("show bgp neighbors {peer} {direction}-routes vrf {vrf}")
+
+func renderBGPNeighborRoutes(peer, direction, vrf string) (string, error) {
+	return bgpNeighborRoutesCmd.Render(map[string]any{"peer": peer, "direction": direction, "vrf": vrf})
+}
 
 // VerifyBGPPeerMPCaps verifies that BGP peers have the expected multiprotocol capabilities.
 //
@@ -1403,6 +1881,10 @@ func (t *VerifyBGPExchangedRoutes) ValidateInput(input any) error { return nil }
 // their advertised multiprotocol capabilities. It ensures that peers can exchange routes
 // for specific address families like IPv4 unicast, IPv6 unicast, or EVPN.
 //
+// Capability names are normalized to EOS keys before lookup, so "ipv4 unicast",
+// "ipv4-unicast" and "ipv4Unicast" are equivalent and "evpn" maps to "l2VpnEvpn".
+// Each capability must be both advertised and received.
+//
 // Expected Results:
 //   - Success: All specified peers have the required multiprotocol capabilities advertised.
 //   - Failure: A peer is missing expected multiprotocol capabilities or has incorrect capabilities.
@@ -1415,8 +1897,10 @@ func (t *VerifyBGPExchangedRoutes) ValidateInput(input any) error { return nil }
 //     bgp_peers:
 //   - peer_address: "10.0.0.1"
 //     vrf: "default"
+//     capabilities: ["ipv4 unicast", "evpn"]
 //   - peer_address: "2001:db8::1"
 //     vrf: "default"
+//     capabilities: ["ipv6Unicast"]
 type VerifyBGPPeerMPCaps struct {
 	test.BaseTest
 	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
@@ -1439,6 +1923,9 @@ func NewVerifyBGPPeerMPCaps(inputs map[string]any) (test.Test, error) {
 					if addr, ok := peerMap["peer_address"].(string); ok {
 						peer.PeerAddress = addr
 					}
+					if intf, ok := peerMap["interface"].(string); ok {
+						peer.Interface = intf
+					}
 					if vrf, ok := peerMap["vrf"].(string); ok {
 						peer.VRF = vrf
 					}
@@ -1468,43 +1955,60 @@ func (t *VerifyBGPPeerMPCaps) Execute(ctx context.Context, dev device.Device) (*
 
 	issues := []string{}
 
-	for _, peer := range t.BGPPeers {
+	for i, peer := range t.BGPPeers {
+		if test.Cancelled(ctx, result, i, len(t.BGPPeers), "peers", issues) {
+			return result, nil
+		}
 		vrf := peer.VRF
 		if vrf == "" {
 			vrf = "default"
 		}
 
+		identifier := bgpPeerLabel(peer)
+
 		cmd := device.Command{
-			Template: fmt.Sprintf("show bgp neighbors %s vrf %s", peer.PeerAddress, vrf),
+			Template: bgpNeighborCommand(peer, vrf),
 			Format:   "json",
 			UseCache: false,
 		}
 
 		cmdResult, err := dev.Execute(ctx, cmd)
 		if err != nil {
-			issues = append(issues, fmt.Sprintf("Failed to get BGP neighbor %s details: %v", peer.PeerAddress, err))
+			issues = append(issues, fmt.Sprintf("Failed to get BGP neighbor %s details: %v", identifier, err))
 			continue
 		}
 
-		if bgpData, ok := cmdResult.Output.(map[string]any); ok {
-			if vrfData, ok := bgpData["vrfs"].(map[string]any); ok {
-				if vrfInfo, ok := vrfData[vrf].(map[string]any); ok {
-					if peerList, ok := vrfInfo["peerList"].([]any); ok && len(peerList) > 0 {
-						if peerInfo, ok := peerList[0].(map[string]any); ok {
-							if capabilities, ok := peerInfo["capabilities"].(map[string]any); ok {
-								if mpCaps, ok := capabilities["multiprotocolCaps"].(map[string]any); ok {
-									for _, expectedCap := range peer.Capabilities {
-										if _, exists := mpCaps[expectedCap]; !exists {
-											issues = append(issues, fmt.Sprintf("Peer %s missing capability %s", peer.PeerAddress, expectedCap))
-										}
-									}
-								} else {
-									issues = append(issues, fmt.Sprintf("Peer %s has no multiprotocol capabilities", peer.PeerAddress))
-								}
-							}
-						}
-					}
-				}
+		entry, found := findBgpNeighbor(cmdResult.Output, vrf, peer)
+		if !found {
+			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", identifier, vrf))
+			continue
+		}
+		// EOS nests negotiated capabilities under `neighborCapabilities`;
+		// the `capabilities` key read previously does not exist in the
+		// neighbor detail output, so every peer looked capability-less.
+		var neighbor struct {
+			NeighborCapabilities struct {
+				MultiprotocolCaps map[string]bgpCapability `json:"multiprotocolCaps"`
+			} `json:"neighborCapabilities"`
+		}
+		if err := decodeOutput(entry, &neighbor); err != nil {
+			issues = append(issues, fmt.Sprintf("Failed to parse BGP neighbor %s details: %v", identifier, err))
+			continue
+		}
+		mpCaps := neighbor.NeighborCapabilities.MultiprotocolCaps
+		if len(mpCaps) == 0 {
+			issues = append(issues, fmt.Sprintf("Peer %s has no multiprotocol capabilities", identifier))
+			continue
+		}
+		for _, expectedCap := range peer.Capabilities {
+			key := normalizeBgpMPCapability(expectedCap)
+			c, exists := mpCaps[key]
+			switch {
+			case !exists:
+				issues = append(issues, fmt.Sprintf("Peer %s missing capability %s (checked %s)", identifier, expectedCap, key))
+			case !c.Advertised || !c.Received:
+				issues = append(issues, fmt.Sprintf("Peer %s capability %s not negotiated: advertised=%t, received=%t",
+					identifier, key, c.Advertised, c.Received))
 			}
 		}
 	}
@@ -1519,14 +2023,74 @@ func (t *VerifyBGPPeerMPCaps) Execute(ctx context.Context, dev device.Device) (*
 	return result, nil
 }
 
-func (t *VerifyBGPPeerMPCaps) ValidateInput(input any) error { return nil }
+// bgpCapability is the advertised/received pair EOS reports for each
+// negotiated BGP capability under `neighborCapabilities`.
+type bgpCapability struct {
+	Advertised bool `json:"advertised"`
+	Received   bool `json:"received"`
+	Enabled    bool `json:"enabled"`
+}
 
-// VerifyBGPPeerASNCap verifies that BGP peers support 4-byte ASN capability.
+// bgpMPCapabilityKeys maps user-facing AFI/SAFI spellings to the keys
+// EOS uses under `neighborCapabilities.multiprotocolCaps`. Lookups go
+// through normalizeBgpMPCapability, which lowercases and strips
+// separators first, so "ipv4 unicast", "ipv4-unicast" and "IPv4Unicast"
+// all land on the same entry.
+var bgpMPCapabilityKeys = map[string]string{
+	"ipv4unicast":          "ipv4Unicast",
+	"ipv6unicast":          "ipv6Unicast",
+	"ipv4multicast":        "ipv4Multicast",
+	"ipv6multicast":        "ipv6Multicast",
+	"ipv4labeledunicast":   "ipv4MplsLabels",
+	"ipv4mplslabel":        "ipv4MplsLabels",
+	"ipv4mplslabels":       "ipv4MplsLabels",
+	"ipv6labeledunicast":   "ipv6MplsLabels",
+	"ipv6mplslabel":        "ipv6MplsLabels",
+	"ipv6mplslabels":       "ipv6MplsLabels",
+	"ipv4srte":             "ipv4SrTe",
+	"ipv6srte":             "ipv6SrTe",
+	"ipv4mplsvpn":          "ipv4MplsVpn",
+	"vpnipv4":              "ipv4MplsVpn",
+	"ipv6mplsvpn":          "ipv6MplsVpn",
+	"vpnipv6":              "ipv6MplsVpn",
+	"ipv4flowspec":         "ipv4FlowSpec",
+	"ipv6flowspec":         "ipv6FlowSpec",
+	"ipv4flowspecvpn":      "ipv4FlowSpecVpn",
+	"ipv6flowspecvpn":      "ipv6FlowSpecVpn",
+	"l2vpnvpls":            "l2VpnVpls",
+	"l2vpnevpn":            "l2VpnEvpn",
+	"evpn":                 "l2VpnEvpn",
+	"linkstate":            "linkState",
+	"rtmembership":         "rtMembership",
+	"ipv4rtmembership":     "rtMembership",
+	"ipv4mvpn":             "ipv4Mvpn",
+	"dps":                  "dps",
+	"dynamicpathselection": "dps",
+}
+
+// normalizeBgpMPCapability returns the EOS multiprotocolCaps key for a
+// user-supplied capability name. Unknown names are returned unchanged
+// so that raw EOS keys keep working for families not in the table.
+func normalizeBgpMPCapability(name string) string {
+	folded := strings.NewReplacer("-", "", "_", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+	if key, ok := bgpMPCapabilityKeys[folded]; ok {
+		return key
+	}
+	return name
+}
+
+func (t *VerifyBGPPeerMPCaps) ValidateInput(input any) error {
+	return validateBgpPeerIdentity(t.BGPPeers)
+}
+
+// VerifyBGPPeerASNCap verifies that BGP peers support 4-byte ASN capability.
 //
 // This test validates that BGP peers have negotiated and advertised support for
 // 4-byte Autonomous System Numbers (ASNs) as defined in RFC 4893. This capability
 // is essential for modern BGP deployments that use ASNs beyond the 16-bit range.
 //
+// When `bgp_peers` is omitted, every peer in every VRF is checked.
+//
 // Expected Results:
 //   - Success: All specified peers have 4-byte ASN capability negotiated and active.
 //   - Failure: A peer is missing 4-byte ASN capability or has not negotiated it properly.
@@ -1563,6 +2127,9 @@ func NewVerifyBGPPeerASNCap(inputs map[string]any) (test.Test, error) {
 					if addr, ok := peerMap["peer_address"].(string); ok {
 						peer.PeerAddress = addr
 					}
+					if intf, ok := peerMap["interface"].(string); ok {
+						peer.Interface = intf
+					}
 					if vrf, ok := peerMap["vrf"].(string); ok {
 						peer.VRF = vrf
 					}
@@ -1584,7 +2151,7 @@ func (t *VerifyBGPPeerASNCap) Execute(ctx context.Context, dev device.Device) (*
 	}
 
 	cmd := device.Command{
-		Template: "show bgp neighbors",
+		Template: "show bgp neighbors vrf all",
 		Format:   "json",
 		UseCache: false,
 	}
@@ -1596,49 +2163,17 @@ func (t *VerifyBGPPeerASNCap) Execute(ctx context.Context, dev device.Device) (*
 		return result, nil
 	}
 
-	var response struct {
-		VRFs map[string]struct {
-			Neighbors map[string]struct {
-				Capabilities struct {
-					FourOctetAsNumber struct {
-						Advertised bool `json:"advertised"`
-						Received   bool `json:"received"`
-					} `json:"fourOctetAsNumber"`
-				} `json:"capabilities"`
-			} `json:"neighbors"`
-		} `json:"vrfs"`
-	}
-
-	if err := decodeOutput(cmdResult.Output, &response); err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to parse BGP neighbors output: %v", err)
-		return result, nil
-	}
-
-	issues := []string{}
-
-	for _, peer := range t.BGPPeers {
-		vrf := peer.VRF
-		if vrf == "" {
-			vrf = "default"
-		}
-
-		vrfData, exists := response.VRFs[vrf]
-		if !exists {
-			issues = append(issues, fmt.Sprintf("VRF %s not found", vrf))
-			continue
-		}
+	entries, issues := selectBgpNeighbors(cmdResult.Output, t.BGPPeers)
 
-		neighbor, exists := vrfData.Neighbors[peer.PeerAddress]
-		if !exists {
-			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", peer.PeerAddress, vrf))
+	for _, entry := range entries {
+		c, found := bgpNeighborCapability(entry.Info, "fourOctetAsnCap")
+		if !found {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: four-octet ASN capability not found", entry.Label, entry.VRF))
 			continue
 		}
-
-		// Check for four-octet ASN capability
-		if !neighbor.Capabilities.FourOctetAsNumber.Advertised || !neighbor.Capabilities.FourOctetAsNumber.Received {
-			issues = append(issues, fmt.Sprintf("Peer %s missing four-octet ASN capability: advertised=%t, received=%t",
-				peer.PeerAddress, neighbor.Capabilities.FourOctetAsNumber.Advertised, neighbor.Capabilities.FourOctetAsNumber.Received))
+		if !c.Advertised || !c.Received {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s missing four-octet ASN capability: advertised=%t, received=%t",
+				entry.Label, entry.VRF, c.Advertised, c.Received))
 		}
 	}
 
@@ -1646,14 +2181,14 @@ func (t *VerifyBGPPeerASNCap) Execute(ctx context.Context, dev device.Device) (*
 		result.Status = test.TestFailure
 		result.Message = fmt.Sprintf("BGP four-octet ASN capability validation failed: %s", strings.Join(issues, "; "))
 	} else {
-		result.Message = fmt.Sprintf("All BGP peers have four-octet ASN capability (%d peers)", len(t.BGPPeers))
+		result.Message = fmt.Sprintf("All BGP peers have four-octet ASN capability (%d peers)", len(entries))
 	}
 
 	return result, nil
 }
 
 func (t *VerifyBGPPeerASNCap) ValidateInput(input any) error {
-	return nil
+	return validateBgpPeerIdentity(t.BGPPeers)
 }
 
 // VerifyBGPPeerRouteRefreshCap verifies that BGP peers support route refresh capability.
@@ -1663,6 +2198,8 @@ func (t *VerifyBGPPeerASNCap) ValidateInput(input any) error {
 // re-advertise its Adj-RIB-Out for a specific address family, enabling dynamic
 // policy changes without tearing down the BGP session.
 //
+// When `bgp_peers` is omitted, every peer in every VRF is checked.
+//
 // Expected Results:
 //   - Success: All specified peers have route refresh capability negotiated and active.
 //   - Failure: A peer is missing route refresh capability or has not negotiated it properly.
@@ -1699,6 +2236,9 @@ func NewVerifyBGPPeerRouteRefreshCap(inputs map[string]any) (test.Test, error) {
 					if addr, ok := peerMap["peer_address"].(string); ok {
 						peer.PeerAddress = addr
 					}
+					if intf, ok := peerMap["interface"].(string); ok {
+						peer.Interface = intf
+					}
 					if vrf, ok := peerMap["vrf"].(string); ok {
 						peer.VRF = vrf
 					}
@@ -1720,7 +2260,7 @@ func (t *VerifyBGPPeerRouteRefreshCap) Execute(ctx context.Context, dev device.D
 	}
 
 	cmd := device.Command{
-		Template: "show bgp neighbors",
+		Template: "show bgp neighbors vrf all",
 		Format:   "json",
 		UseCache: false,
 	}
@@ -1732,64 +2272,188 @@ func (t *VerifyBGPPeerRouteRefreshCap) Execute(ctx context.Context, dev device.D
 		return result, nil
 	}
 
-	var response struct {
-		VRFs map[string]struct {
-			Neighbors map[string]struct {
-				Capabilities struct {
-					RouteRefresh struct {
-						Advertised bool `json:"advertised"`
-						Received   bool `json:"received"`
-					} `json:"routeRefresh"`
-				} `json:"capabilities"`
-			} `json:"neighbors"`
-		} `json:"vrfs"`
+	entries, issues := selectBgpNeighbors(cmdResult.Output, t.BGPPeers)
+
+	for _, entry := range entries {
+		c, found := bgpNeighborCapability(entry.Info, "routeRefreshCap")
+		if !found {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: route refresh capability not found", entry.Label, entry.VRF))
+			continue
+		}
+		if !c.Advertised || !c.Received {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s missing route refresh capability: advertised=%t, received=%t",
+				entry.Label, entry.VRF, c.Advertised, c.Received))
+		}
 	}
 
-	if err := decodeOutput(cmdResult.Output, &response); err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to parse BGP neighbors output: %v", err)
-		return result, nil
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP route refresh capability validation failed: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All BGP peers have route refresh capability (%d peers)", len(entries))
 	}
 
-	issues := []string{}
+	return result, nil
+}
 
-	for _, peer := range t.BGPPeers {
-		vrf := peer.VRF
-		if vrf == "" {
-			vrf = "default"
-		}
+func (t *VerifyBGPPeerRouteRefreshCap) ValidateInput(input any) error {
+	return validateBgpPeerIdentity(t.BGPPeers)
+}
 
-		vrfData, exists := response.VRFs[vrf]
-		if !exists {
-			issues = append(issues, fmt.Sprintf("VRF %s not found", vrf))
-			continue
+// VerifyBGPAdditionalPaths verifies BGP add-paths is negotiated with peers.
+//
+// Add-paths (RFC 7911) lets a speaker advertise more than the best path
+// for a prefix, which is what gives receivers a precomputed backup for
+// fast reroute. Each direction is only usable when both ends agree: the
+// local router's receive capability is negotiated when it was advertised
+// and the peer advertised the matching send capability, and vice versa.
+// EOS reports both directions per address family under
+// `neighborCapabilities.addPathsRecvCaps` and `addPathsSendCaps`. This
+// test performs the following checks for each specified peer:
+//  1. Verifies that the peer is found in its VRF.
+//  2. Validates that add-paths is negotiated in `direction` (send, receive
+//     or both) for `address_family` (IPv4 unicast unless set).
+//
+// Expected Results:
+//   - Success: All peers have add-paths negotiated in the expected directions.
+//   - Failure: A peer is not found or add-paths is not negotiated in an expected direction.
+//   - Error: The test will error if BGP neighbor information cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyBGPAdditionalPaths"
+//     module: "routing"
+//     inputs:
+//     bgp_peers:
+//   - peer_address: "10.0.0.1"
+//     vrf: "default"
+//     direction: "receive"
+//   - peer_address: "10.0.0.2"
+//     address_family: "evpn"
+//     direction: "both"
+type VerifyBGPAdditionalPaths struct {
+	test.BaseTest
+	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
+}
+
+// addPathsDirections maps the direction input to the capability keys it
+// requires.
+var addPathsDirections = map[string][]string{
+	"receive": {"addPathsRecvCaps"},
+	"send":    {"addPathsSendCaps"},
+	"both":    {"addPathsRecvCaps", "addPathsSendCaps"},
+}
+
+func NewVerifyBGPAdditionalPaths(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBGPAdditionalPaths{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBGPAdditionalPaths",
+			TestDescription: "Verifies BGP add-paths capabilities",
+			TestCategories:  []string{"routing", "bgp", "capabilities"},
+		},
+	}
+
+	if inputs != nil {
+		if peers, ok := inputs["bgp_peers"].([]any); ok {
+			for i, p := range peers {
+				peerMap, ok := p.(map[string]any)
+				if !ok {
+					continue
+				}
+				peer := BgpPeerExtended{VRF: "default", AddressFamily: "ipv4Unicast"}
+				if addr, ok := peerMap["peer_address"].(string); ok {
+					peer.PeerAddress = addr
+				}
+				if intf, ok := peerMap["interface"].(string); ok {
+					peer.Interface = intf
+				}
+				if vrf, ok := peerMap["vrf"].(string); ok {
+					peer.VRF = vrf
+				}
+				if err := test.GetString(peerMap, "address_family", &peer.AddressFamily); err != nil {
+					return nil, fmt.Errorf("bgp_peers[%d]: %w", i, err)
+				}
+				if err := test.GetString(peerMap, "direction", &peer.Direction); err != nil {
+					return nil, fmt.Errorf("bgp_peers[%d]: %w", i, err)
+				}
+				t.BGPPeers = append(t.BGPPeers, peer)
+			}
 		}
+	}
 
-		neighbor, exists := vrfData.Neighbors[peer.PeerAddress]
-		if !exists {
-			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", peer.PeerAddress, vrf))
+	return t, nil
+}
+
+func (t *VerifyBGPAdditionalPaths) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show bgp neighbors vrf all",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get BGP neighbors: %v", err)
+		return result, nil
+	}
+
+	issues := []string{}
+	for _, peer := range t.BGPPeers {
+		label := bgpPeerLabel(peer)
+		info, found := findBgpNeighbor(cmdResult.Output, peer.VRF, peer)
+		if !found {
+			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", label, peer.VRF))
 			continue
 		}
 
-		// Check for route refresh capability
-		if !neighbor.Capabilities.RouteRefresh.Advertised || !neighbor.Capabilities.RouteRefresh.Received {
-			issues = append(issues, fmt.Sprintf("Peer %s missing route refresh capability: advertised=%t, received=%t",
-				peer.PeerAddress, neighbor.Capabilities.RouteRefresh.Advertised, neighbor.Capabilities.RouteRefresh.Received))
+		family := normalizeBgpMPCapability(peer.AddressFamily)
+		caps, _ := info["neighborCapabilities"].(map[string]any)
+		for _, key := range addPathsDirections[strings.ToLower(peer.Direction)] {
+			direction := "receive"
+			if key == "addPathsSendCaps" {
+				direction = "send"
+			}
+			var byFamily map[string]bgpCapability
+			_ = decodeOutput(caps[key], &byFamily)
+			c, ok := byFamily[family]
+			switch {
+			case !ok:
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: add-paths %s not configured for %s",
+					label, peer.VRF, direction, family))
+			case !c.Advertised || !c.Received:
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: add-paths %s not negotiated for %s: advertised=%t, received=%t",
+					label, peer.VRF, direction, family, c.Advertised, c.Received))
+			}
 		}
 	}
 
 	if len(issues) > 0 {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("BGP route refresh capability validation failed: %s", strings.Join(issues, "; "))
+		result.Message = fmt.Sprintf("BGP add-paths validation failed: %s", strings.Join(issues, "; "))
 	} else {
-		result.Message = fmt.Sprintf("All BGP peers have route refresh capability (%d peers)", len(t.BGPPeers))
+		result.Message = fmt.Sprintf("BGP add-paths negotiated with all %d peers", len(t.BGPPeers))
 	}
 
 	return result, nil
 }
 
-func (t *VerifyBGPPeerRouteRefreshCap) ValidateInput(input any) error {
-	return nil
+func (t *VerifyBGPAdditionalPaths) ValidateInput(input any) error {
+	if len(t.BGPPeers) == 0 {
+		return fmt.Errorf("at least one BGP peer must be specified")
+	}
+	for i, peer := range t.BGPPeers {
+		if _, ok := addPathsDirections[strings.ToLower(peer.Direction)]; !ok {
+			return fmt.Errorf("bgp_peers[%d] (%s): direction must be send, receive or both, got %q", i, bgpPeerLabel(peer), peer.Direction)
+		}
+	}
+	return validateBgpPeerIdentity(t.BGPPeers)
 }
 
 // VerifyBGPPeerMD5Auth verifies MD5 authentication is configured for BGP peers.
@@ -1798,6 +2462,8 @@ func (t *VerifyBGPPeerRouteRefreshCap) ValidateInput(input any) error {
 //  1. Verifies that the peer is found in its VRF in the BGP configuration.
 //  2. Validates that TCP MD5 authentication is enabled for the BGP session.
 //
+// When `bgp_peers` is omitted, every peer in every VRF is checked.
+//
 // Expected Results:
 //   - Success: All specified peers have MD5 authentication enabled.
 //   - Failure: A peer is not found or MD5 authentication is not enabled.
@@ -1835,6 +2501,9 @@ func NewVerifyBGPPeerMD5Auth(inputs map[string]any) (test.Test, error) {
 					if addr, ok := peerMap["peer_address"].(string); ok {
 						peer.PeerAddress = addr
 					}
+					if intf, ok := peerMap["interface"].(string); ok {
+						peer.Interface = intf
+					}
 					if vrf, ok := peerMap["vrf"].(string); ok {
 						peer.VRF = vrf
 					}
@@ -1856,7 +2525,7 @@ func (t *VerifyBGPPeerMD5Auth) Execute(ctx context.Context, dev device.Device) (
 	}
 
 	cmd := device.Command{
-		Template: "show bgp neighbors",
+		Template: "show bgp neighbors vrf all",
 		Format:   "json",
 		UseCache: false,
 	}
@@ -1868,43 +2537,11 @@ func (t *VerifyBGPPeerMD5Auth) Execute(ctx context.Context, dev device.Device) (
 		return result, nil
 	}
 
-	var response struct {
-		VRFs map[string]struct {
-			Neighbors map[string]struct {
-				TcpMD5Auth bool `json:"tcpMd5AuthEnabled"`
-			} `json:"neighbors"`
-		} `json:"vrfs"`
-	}
-
-	if err := decodeOutput(cmdResult.Output, &response); err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to parse BGP neighbors output: %v", err)
-		return result, nil
-	}
-
-	issues := []string{}
-
-	for _, peer := range t.BGPPeers {
-		vrf := peer.VRF
-		if vrf == "" {
-			vrf = "default"
-		}
-
-		vrfData, exists := response.VRFs[vrf]
-		if !exists {
-			issues = append(issues, fmt.Sprintf("VRF %s not found", vrf))
-			continue
-		}
-
-		neighbor, exists := vrfData.Neighbors[peer.PeerAddress]
-		if !exists {
-			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", peer.PeerAddress, vrf))
-			continue
-		}
+	entries, issues := selectBgpNeighbors(cmdResult.Output, t.BGPPeers)
 
-		// Check for MD5 authentication
-		if !neighbor.TcpMD5Auth {
-			issues = append(issues, fmt.Sprintf("Peer %s does not have MD5 authentication enabled", peer.PeerAddress))
+	for _, entry := range entries {
+		if enabled, _ := entry.Info["md5AuthEnabled"].(bool); !enabled {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s does not have MD5 authentication enabled", entry.Label, entry.VRF))
 		}
 	}
 
@@ -1912,14 +2549,14 @@ func (t *VerifyBGPPeerMD5Auth) Execute(ctx context.Context, dev device.Device) (
 		result.Status = test.TestFailure
 		result.Message = fmt.Sprintf("BGP MD5 authentication validation failed: %s", strings.Join(issues, "; "))
 	} else {
-		result.Message = fmt.Sprintf("All BGP peers have MD5 authentication enabled (%d peers)", len(t.BGPPeers))
+		result.Message = fmt.Sprintf("All BGP peers have MD5 authentication enabled (%d peers)", len(entries))
 	}
 
 	return result, nil
 }
 
 func (t *VerifyBGPPeerMD5Auth) ValidateInput(input any) error {
-	return nil
+	return validateBgpPeerIdentity(t.BGPPeers)
 }
 
 // VerifyEVPNType2Route verifies the presence and correctness of EVPN Type-2 routes.
@@ -1981,8 +2618,9 @@ func (t *VerifyEVPNType2Route) ValidateInput(input any) error { return nil }
 //
 // This test performs the following checks for each specified peer:
 //  1. Verifies that the peer is found in its VRF in the BGP configuration.
-//  2. Validates that community capabilities are advertised and received.
-//  3. Checks for standard, extended, and large community support as applicable.
+//  2. Validates that the peer is configured to send each requested community
+//     type (standard, extended, large). All three are required when
+//     `advertised_communities` is omitted.
 //
 // Expected Results:
 //   - Success: All specified peers have proper community capabilities configured.
@@ -2053,19 +2691,19 @@ func (t *VerifyBGPAdvCommunities) Execute(ctx context.Context, dev device.Device
 
 	issues := []string{}
 
-	for _, peer := range t.BGPPeers {
+	for i, peer := range t.BGPPeers {
+		if test.Cancelled(ctx, result, i, len(t.BGPPeers), "peers", issues) {
+			return result, nil
+		}
 		vrf := peer.VRF
 		if vrf == "" {
 			vrf = "default"
 		}
 
-		identifier := peer.PeerAddress
-		if identifier == "" {
-			identifier = "interface " + peer.Interface
-		}
+		identifier := bgpPeerLabel(peer)
 
 		cmd := device.Command{
-			Template: fmt.Sprintf("show bgp neighbors %s vrf %s", peer.PeerAddress, vrf),
+			Template: bgpNeighborCommand(peer, vrf),
 			Format:   "json",
 			UseCache: false,
 		}
@@ -2076,35 +2714,37 @@ func (t *VerifyBGPAdvCommunities) Execute(ctx context.Context, dev device.Device
 			continue
 		}
 
-		if bgpData, ok := cmdResult.Output.(map[string]any); ok {
-			if vrfData, ok := bgpData["vrfs"].(map[string]any); ok {
-				if vrfInfo, ok := vrfData[vrf].(map[string]any); ok {
-					if peerList, ok := vrfInfo["peerList"].([]any); ok && len(peerList) > 0 {
-						if peerInfo, ok := peerList[0].(map[string]any); ok {
-							// Check for community capabilities and configuration
-							if capabilities, ok := peerInfo["capabilities"].(map[string]any); ok {
-								for _, expectedComm := range peer.AdvertisedCommunities {
-									switch expectedComm {
-									case "standard":
-										if _, exists := capabilities["standardCommunity"]; !exists {
-											issues = append(issues, fmt.Sprintf("Peer %s missing standard community capability", identifier))
-										}
-									case "extended":
-										if _, exists := capabilities["extendedCommunity"]; !exists {
-											issues = append(issues, fmt.Sprintf("Peer %s missing extended community capability", identifier))
-										}
-									case "large":
-										if _, exists := capabilities["largeCommunity"]; !exists {
-											issues = append(issues, fmt.Sprintf("Peer %s missing large community capability", identifier))
-										}
-									}
-								}
-							}
-						}
-					}
-				}
+		entry, found := findBgpNeighbor(cmdResult.Output, vrf, peer)
+		if !found {
+			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", identifier, vrf))
+			continue
+		}
+		var neighbor struct {
+			AdvertisedCommunities map[string]bool `json:"advertisedCommunities"`
+		}
+		if err := decodeOutput(entry, &neighbor); err != nil {
+			issues = append(issues, fmt.Sprintf("Failed to parse BGP neighbor %s details: %v", identifier, err))
+			continue
+		}
+
+		// `advertisedCommunities` reflects the peer's `send-community`
+		// configuration: one boolean per community type. The previous
+		// lookup under `capabilities` matched keys EOS never emits, so
+		// every requested type was reported missing or silently skipped.
+		expected := peer.AdvertisedCommunities
+		if len(expected) == 0 {
+			expected = []string{"standard", "extended", "large"}
+		}
+		var missing []string
+		for _, comm := range expected {
+			if !neighbor.AdvertisedCommunities[strings.ToLower(comm)] {
+				missing = append(missing, comm)
 			}
 		}
+		if len(missing) > 0 {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s not sending %s communities",
+				identifier, vrf, strings.Join(missing, ", ")))
+		}
 	}
 
 	if len(issues) > 0 {
@@ -2121,6 +2761,19 @@ func (t *VerifyBGPAdvCommunities) ValidateInput(input any) error {
 	if len(t.BGPPeers) == 0 {
 		return fmt.Errorf("at least one BGP peer must be specified")
 	}
+	if err := validateBgpPeerIdentity(t.BGPPeers); err != nil {
+		return err
+	}
+	for i, peer := range t.BGPPeers {
+		for _, comm := range peer.AdvertisedCommunities {
+			switch strings.ToLower(comm) {
+			case "standard", "extended", "large":
+			default:
+				return fmt.Errorf("bgp_peers[%d] (%s): unknown community type %q (want standard, extended or large)",
+					i, bgpPeerLabel(peer), comm)
+			}
+		}
+	}
 	return nil
 }
 
@@ -2288,6 +2941,9 @@ func (t *VerifyBGPTimers) ValidateInput(input any) error {
 //     - If no specific drop statistics are provided, checks all available counters.
 //     - Confirms that all checked counters have a value of zero.
 //
+// `drop_stats` may be a list of counter names, each expected to be zero, or a
+// map of counter name to expected value.
+//
 // Expected Results:
 //   - Success: All specified peers are found with zero drop statistics.
 //   - Failure: A peer is not found or has non-zero drop statistics counters.
@@ -2324,25 +2980,23 @@ func NewVerifyBGPPeerDropStats(inputs map[string]any) (test.Test, error) {
 
 	if inputs != nil {
 		if peers, ok := inputs["bgp_peers"].([]any); ok {
-			for _, p := range peers {
+			for i, p := range peers {
 				if peerMap, ok := p.(map[string]any); ok {
 					peer := BgpPeerExtended{VRF: "default"}
 					if addr, ok := peerMap["peer_address"].(string); ok {
 						peer.PeerAddress = addr
 					}
+					if intf, ok := peerMap["interface"].(string); ok {
+						peer.Interface = intf
+					}
 					if vrf, ok := peerMap["vrf"].(string); ok {
 						peer.VRF = vrf
 					}
-					if dropStats, ok := peerMap["drop_stats"].(map[string]any); ok {
-						peer.DropStats = make(map[string]int)
-						for k, v := range dropStats {
-							if val, ok := v.(float64); ok {
-								peer.DropStats[k] = int(val)
-							} else if val, ok := v.(int); ok {
-								peer.DropStats[k] = val
-							}
-						}
+					dropStats, err := parseBgpDropStats(peerMap["drop_stats"])
+					if err != nil {
+						return nil, fmt.Errorf("bgp_peers[%d]: %w", i, err)
 					}
+					peer.DropStats = dropStats
 					t.BGPPeers = append(t.BGPPeers, peer)
 				}
 			}
@@ -2362,52 +3016,40 @@ func (t *VerifyBGPPeerDropStats) Execute(ctx context.Context, dev device.Device)
 
 	issues := []string{}
 
-	for _, peer := range t.BGPPeers {
+	for i, peer := range t.BGPPeers {
+		if test.Cancelled(ctx, result, i, len(t.BGPPeers), "peers", issues) {
+			return result, nil
+		}
 		vrf := peer.VRF
 		if vrf == "" {
 			vrf = "default"
 		}
 
+		identifier := bgpPeerLabel(peer)
+
 		cmd := device.Command{
-			Template: fmt.Sprintf("show bgp neighbors %s vrf %s", peer.PeerAddress, vrf),
+			Template: bgpNeighborCommand(peer, vrf),
 			Format:   "json",
 			UseCache: false,
 		}
 
 		cmdResult, err := dev.Execute(ctx, cmd)
 		if err != nil {
-			issues = append(issues, fmt.Sprintf("Failed to get BGP neighbor %s details: %v", peer.PeerAddress, err))
+			issues = append(issues, fmt.Sprintf("Failed to get BGP neighbor %s details: %v", identifier, err))
 			continue
 		}
 
-		if bgpData, ok := cmdResult.Output.(map[string]any); ok {
-			if vrfData, ok := bgpData["vrfs"].(map[string]any); ok {
-				if vrfInfo, ok := vrfData[vrf].(map[string]any); ok {
-					if peerList, ok := vrfInfo["peerList"].([]any); ok && len(peerList) > 0 {
-						if peerInfo, ok := peerList[0].(map[string]any); ok {
-							if dropStatsInfo, ok := peerInfo["dropStats"].(map[string]any); ok {
-								// Check each expected drop statistic
-								for statType, expectedValue := range peer.DropStats {
-									if actualValue, exists := dropStatsInfo[statType]; exists {
-										if actualFloat, ok := actualValue.(float64); ok {
-											actualInt := int(actualFloat)
-											if actualInt != expectedValue {
-												issues = append(issues, fmt.Sprintf("Peer %s: expected %s=%d, got %d",
-													peer.PeerAddress, statType, expectedValue, actualInt))
-											}
-										}
-									} else {
-										issues = append(issues, fmt.Sprintf("Peer %s: drop statistic %s not found", peer.PeerAddress, statType))
-									}
-								}
-							} else {
-								issues = append(issues, fmt.Sprintf("Peer %s: no drop statistics found", peer.PeerAddress))
-							}
-						}
-					}
-				}
-			}
+		peerInfo, found := findBgpNeighbor(cmdResult.Output, vrf, peer)
+		if !found {
+			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", identifier, vrf))
+			continue
 		}
+		dropStatsInfo, ok := peerInfo["dropStats"].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Peer %s: no drop statistics found", identifier))
+			continue
+		}
+		issues = append(issues, checkBgpDropStats(identifier, dropStatsInfo, peer.DropStats)...)
 	}
 
 	if len(issues) > 0 {
@@ -2420,10 +3062,91 @@ func (t *VerifyBGPPeerDropStats) Execute(ctx context.Context, dev device.Device)
 	return result, nil
 }
 
+// parseBgpDropStats normalizes the `drop_stats` input into a map of
+// counter name to expected value. It accepts a list of counter names,
+// each expected to be zero, a map of counter name to expected value, or
+// a list mixing names and single-entry maps. A missing key yields nil,
+// meaning "check every counter".
+func parseBgpDropStats(raw any) (map[string]int, error) {
+	out := map[string]int{}
+	switch v := raw.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		for i, item := range v {
+			switch entry := item.(type) {
+			case string:
+				out[entry] = 0
+			case map[string]any:
+				for name := range entry {
+					var want int
+					if err := test.GetInt(entry, name, &want); err != nil {
+						return nil, fmt.Errorf("drop_stats[%d]: %w", i, err)
+					}
+					out[name] = want
+				}
+			default:
+				return nil, fmt.Errorf("drop_stats[%d]: expected counter name or map, got %T", i, item)
+			}
+		}
+	case map[string]any:
+		for name := range v {
+			var want int
+			if err := test.GetInt(v, name, &want); err != nil {
+				return nil, fmt.Errorf("drop_stats: %w", err)
+			}
+			out[name] = want
+		}
+	default:
+		return nil, fmt.Errorf("drop_stats: expected list or map, got %T", raw)
+	}
+	return out, nil
+}
+
+// checkBgpDropStats compares a peer's dropStats against the expected
+// values. With no expectations every numeric counter must be zero.
+func checkBgpDropStats(identifier string, info map[string]any, expected map[string]int) []string {
+	if len(expected) == 0 {
+		expected = make(map[string]int, len(info))
+		for name, v := range info {
+			if _, ok := v.(float64); ok {
+				expected[name] = 0
+			}
+		}
+	}
+
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var issues []string
+	for _, name := range names {
+		actual, exists := info[name]
+		if !exists {
+			issues = append(issues, fmt.Sprintf("Peer %s: drop statistic %s not found", identifier, name))
+			continue
+		}
+		got, ok := actual.(float64)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Peer %s: drop statistic %s is %T, not a number", identifier, name, actual))
+			continue
+		}
+		if int(got) != expected[name] {
+			issues = append(issues, fmt.Sprintf("Peer %s: expected %s=%d, got %d", identifier, name, expected[name], int(got)))
+		}
+	}
+	return issues
+}
+
 func (t *VerifyBGPPeerDropStats) ValidateInput(input any) error {
 	if len(t.BGPPeers) == 0 {
 		return fmt.Errorf("at least one BGP peer must be specified")
 	}
+	if err := validateBgpPeerIdentity(t.BGPPeers); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2439,7 +3162,9 @@ func (t *VerifyBGPPeerDropStats) ValidateInput(input any) error {
 //     - If no update error counters are provided, checks all available counters.
 //     - Confirms that all checked counters have a value of zero.
 //
-// Note: For "disabledAfiSafi" error counter field, checking that it's not "None" versus 0.
+// `update_errors` may be a list of counter names, each expected to be zero, or a
+// map of counter name to expected value. The "disabledAfiSafi" field is a string
+// and is expected to be "None" when listed by name.
 //
 // Expected Results:
 //   - Success: All specified peers are found with zero update error counters.
@@ -2458,7 +3183,8 @@ func (t *VerifyBGPPeerDropStats) ValidateInput(input any) error {
 //   - peer_address: "fd00:dc:1::1"
 //     vrf: "default"
 //     update_errors:
-//   - "inUpdErrWithdraw"
+//     inUpdErrWithdraw: 0
+//     disabledAfiSafi: "None"
 type VerifyBGPPeerUpdateErrors struct {
 	test.BaseTest
 	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
@@ -2475,18 +3201,23 @@ func NewVerifyBGPPeerUpdateErrors(inputs map[string]any) (test.Test, error) {
 
 	if inputs != nil {
 		if peers, ok := inputs["bgp_peers"].([]any); ok {
-			for _, p := range peers {
+			for i, p := range peers {
 				if peerMap, ok := p.(map[string]any); ok {
 					peer := BgpPeerExtended{VRF: "default"}
 					if addr, ok := peerMap["peer_address"].(string); ok {
 						peer.PeerAddress = addr
 					}
+					if intf, ok := peerMap["interface"].(string); ok {
+						peer.Interface = intf
+					}
 					if vrf, ok := peerMap["vrf"].(string); ok {
 						peer.VRF = vrf
 					}
-					if updateErrors, ok := peerMap["update_errors"].(map[string]any); ok {
-						peer.UpdateErrors = updateErrors
+					updateErrors, err := parseBgpUpdateErrors(peerMap["update_errors"])
+					if err != nil {
+						return nil, fmt.Errorf("bgp_peers[%d]: %w", i, err)
 					}
+					peer.UpdateErrors = updateErrors
 					t.BGPPeers = append(t.BGPPeers, peer)
 				}
 			}
@@ -2506,72 +3237,40 @@ func (t *VerifyBGPPeerUpdateErrors) Execute(ctx context.Context, dev device.Devi
 
 	issues := []string{}
 
-	for _, peer := range t.BGPPeers {
+	for i, peer := range t.BGPPeers {
+		if test.Cancelled(ctx, result, i, len(t.BGPPeers), "peers", issues) {
+			return result, nil
+		}
 		vrf := peer.VRF
 		if vrf == "" {
 			vrf = "default"
 		}
 
+		identifier := bgpPeerLabel(peer)
+
 		cmd := device.Command{
-			Template: fmt.Sprintf("show bgp neighbors %s vrf %s", peer.PeerAddress, vrf),
+			Template: bgpNeighborCommand(peer, vrf),
 			Format:   "json",
 			UseCache: false,
 		}
 
 		cmdResult, err := dev.Execute(ctx, cmd)
 		if err != nil {
-			issues = append(issues, fmt.Sprintf("Failed to get BGP neighbor %s details: %v", peer.PeerAddress, err))
+			issues = append(issues, fmt.Sprintf("Failed to get BGP neighbor %s details: %v", identifier, err))
 			continue
 		}
 
-		if bgpData, ok := cmdResult.Output.(map[string]any); ok {
-			if vrfData, ok := bgpData["vrfs"].(map[string]any); ok {
-				if vrfInfo, ok := vrfData[vrf].(map[string]any); ok {
-					if peerList, ok := vrfInfo["peerList"].([]any); ok && len(peerList) > 0 {
-						if peerInfo, ok := peerList[0].(map[string]any); ok {
-							if updateErrorInfo, ok := peerInfo["updateErrorInfo"].(map[string]any); ok {
-								// Check each expected update error counter
-								for errorType, expectedValue := range peer.UpdateErrors {
-									if actualValue, exists := updateErrorInfo[errorType]; exists {
-										// Convert expected value to float64 for comparison
-										var expectedFloat float64
-										switch v := expectedValue.(type) {
-										case float64:
-											expectedFloat = v
-										case int:
-											expectedFloat = float64(v)
-										case string:
-											if errorType == "disabledAfiSafi" {
-												if actualStr, ok := actualValue.(string); ok {
-													if actualStr != v {
-														issues = append(issues, fmt.Sprintf("Peer %s: expected %s=%s, got %s",
-															peer.PeerAddress, errorType, v, actualStr))
-													}
-												}
-												continue
-											}
-										default:
-											expectedFloat = 0
-										}
-
-										if actualFloat, ok := actualValue.(float64); ok {
-											if actualFloat != expectedFloat {
-												issues = append(issues, fmt.Sprintf("Peer %s: expected %s=%.0f, got %.0f",
-													peer.PeerAddress, errorType, expectedFloat, actualFloat))
-											}
-										}
-									} else {
-										issues = append(issues, fmt.Sprintf("Peer %s: update error counter %s not found", peer.PeerAddress, errorType))
-									}
-								}
-							} else {
-								issues = append(issues, fmt.Sprintf("Peer %s: no update error information found", peer.PeerAddress))
-							}
-						}
-					}
-				}
-			}
+		peerInfo, found := findBgpNeighbor(cmdResult.Output, vrf, peer)
+		if !found {
+			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", identifier, vrf))
+			continue
+		}
+		updateErrorInfo, ok := peerInfo["updateErrorInfo"].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Peer %s: no update error information found", identifier))
+			continue
 		}
+		issues = append(issues, checkBgpUpdateErrors(identifier, updateErrorInfo, peer.UpdateErrors)...)
 	}
 
 	if len(issues) > 0 {
@@ -2584,10 +3283,106 @@ func (t *VerifyBGPPeerUpdateErrors) Execute(ctx context.Context, dev device.Devi
 	return result, nil
 }
 
+// bgpUpdateErrorDisabledAfiSafi is the one update-error field EOS
+// reports as a string: the name of the AFI/SAFI disabled by an update
+// error, or "None" when no family has been disabled.
+const bgpUpdateErrorDisabledAfiSafi = "disabledAfiSafi"
+
+// parseBgpUpdateErrors normalizes the `update_errors` input into a map
+// of counter name to expected value. Two shapes are accepted: a list of
+// counter names, each expected to be clean (zero, or "None" for
+// disabledAfiSafi), and a map of counter name to expected value. List
+// items may also be single-entry maps, as in the example catalog. A
+// missing key yields nil, meaning "check every counter".
+func parseBgpUpdateErrors(raw any) (map[string]any, error) {
+	switch v := raw.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		out := make(map[string]any, len(v))
+		for i, item := range v {
+			switch entry := item.(type) {
+			case string:
+				if entry == bgpUpdateErrorDisabledAfiSafi {
+					out[entry] = "None"
+				} else {
+					out[entry] = 0
+				}
+			case map[string]any:
+				for name, want := range entry {
+					out[name] = want
+				}
+			default:
+				return nil, fmt.Errorf("update_errors[%d]: expected counter name or map, got %T", i, item)
+			}
+		}
+		return out, nil
+	case map[string]any:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("update_errors: expected list or map, got %T", raw)
+	}
+}
+
+// checkBgpUpdateErrors compares a peer's updateErrorInfo against the
+// expected values. With no expectations every reported counter must be
+// clean.
+func checkBgpUpdateErrors(identifier string, info map[string]any, expected map[string]any) []string {
+	if len(expected) == 0 {
+		expected = make(map[string]any, len(info))
+		for name := range info {
+			if name == bgpUpdateErrorDisabledAfiSafi {
+				expected[name] = "None"
+			} else {
+				expected[name] = 0
+			}
+		}
+	}
+
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var issues []string
+	for _, name := range names {
+		actual, exists := info[name]
+		if !exists {
+			issues = append(issues, fmt.Sprintf("Peer %s: update error counter %s not found", identifier, name))
+			continue
+		}
+		switch want := expected[name].(type) {
+		case string:
+			if got := fmt.Sprint(actual); got != want {
+				issues = append(issues, fmt.Sprintf("Peer %s: expected %s=%s, got %s", identifier, name, want, got))
+			}
+		default:
+			wantNum := 0
+			if err := test.GetInt(expected, name, &wantNum); err != nil {
+				issues = append(issues, fmt.Sprintf("Peer %s: %v", identifier, err))
+				continue
+			}
+			got, ok := actual.(float64)
+			if !ok {
+				issues = append(issues, fmt.Sprintf("Peer %s: update error counter %s is %T, not a number", identifier, name, actual))
+				continue
+			}
+			if int(got) != wantNum {
+				issues = append(issues, fmt.Sprintf("Peer %s: expected %s=%d, got %d", identifier, name, wantNum, int(got)))
+			}
+		}
+	}
+	return issues
+}
+
 func (t *VerifyBGPPeerUpdateErrors) ValidateInput(input any) error {
 	if len(t.BGPPeers) == 0 {
 		return fmt.Errorf("at least one BGP peer must be specified")
 	}
+	if err := validateBgpPeerIdentity(t.BGPPeers); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -3475,6 +4270,11 @@ func (t *VerifyBGPNlriAcceptance) ValidateInput(input any) error {
 // and that these paths meet the specified criteria. It's useful for verifying
 // redundancy and path diversity in BGP networks.
 //
+// Each route is looked up in the BGP table of its own family, `show ip
+// bgp` or `show ipv6 bgp`, so one list can mix IPv4 and IPv6 prefixes.
+// Setting `address_family` to "ipv4" or "ipv6" pins every route to that
+// table and rejects prefixes of the other family.
+//
 // Expected Results:
 //   - Success: All specified routes have the expected number of valid paths.
 //   - Failure: Routes have fewer paths than expected or paths don't meet criteria.
@@ -3490,9 +4290,12 @@ func (t *VerifyBGPNlriAcceptance) ValidateInput(input any) error {
 //     vrf: "default"
 //   - prefix: "10.0.0.0/8"
 //     expected_paths: 3
+//   - prefix: "2001:db8::/32"
+//     expected_paths: 2
 type VerifyBGPRoutePaths struct {
 	test.BaseTest
-	Routes []BgpRoute `yaml:"routes" json:"routes"`
+	Routes        []BgpRoute `yaml:"routes" json:"routes"`
+	AddressFamily string     `yaml:"address_family,omitempty" json:"address_family,omitempty"`
 }
 
 func NewVerifyBGPRoutePaths(inputs map[string]any) (test.Test, error) {
@@ -3510,6 +4313,10 @@ func NewVerifyBGPRoutePaths(inputs map[string]any) (test.Test, error) {
 	}
 	t.Routes = routes
 
+	if err := test.GetString(inputs, "address_family", &t.AddressFamily); err != nil {
+		return nil, err
+	}
+
 	return t, nil
 }
 
@@ -3521,20 +4328,7 @@ func (t *VerifyBGPRoutePaths) Execute(ctx context.Context, dev device.Device) (*
 		Categories: t.Categories(),
 	}
 
-	cmd := device.Command{
-		Template: "show ip bgp vrf all",
-		Format:   "json",
-		UseCache: false,
-	}
-
-	cmdResult, err := dev.Execute(ctx, cmd)
-	if err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to get BGP RIB: %v", err)
-		return result, nil
-	}
-
-	var response struct {
+	type bgpRIB struct {
 		VRFs map[string]struct {
 			BgpRouteEntries map[string]struct {
 				BgpRoutePaths []any `json:"bgpRoutePaths"`
@@ -3542,20 +4336,43 @@ func (t *VerifyBGPRoutePaths) Execute(ctx context.Context, dev device.Device) (*
 		} `json:"vrfs"`
 	}
 
-	if err := decodeOutput(cmdResult.Output, &response); err != nil {
-		result.Status = test.TestError
-		result.Message = fmt.Sprintf("Failed to parse BGP RIB output: %v", err)
-		return result, nil
+	// One `show <family> bgp vrf all` per family the routes need.
+	ribs := map[string]*bgpRIB{}
+	for _, route := range t.Routes {
+		family := bgpRouteCommandFamily(t.AddressFamily, route.Prefix)
+		if ribs[family] != nil {
+			continue
+		}
+		cmd := device.Command{
+			Template: fmt.Sprintf("show %s bgp vrf all", family),
+			Format:   "json",
+			UseCache: false,
+		}
+
+		cmdResult, err := dev.Execute(ctx, cmd)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get BGP RIB: %v", err)
+			return result, nil
+		}
+
+		table := &bgpRIB{}
+		if err := decodeOutput(cmdResult.Output, table); err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to parse BGP RIB output: %v", err)
+			return result, nil
+		}
+		ribs[family] = table
 	}
 
 	issues := []string{}
 	for _, route := range t.Routes {
-		vrfData, exists := response.VRFs[route.VRF]
+		vrfData, exists := ribs[bgpRouteCommandFamily(t.AddressFamily, route.Prefix)].VRFs[route.VRF]
 		if !exists {
 			issues = append(issues, fmt.Sprintf("VRF %s not present for prefix %s", route.VRF, route.Prefix))
 			continue
 		}
-		entry, exists := vrfData.BgpRouteEntries[route.Prefix]
+		entry, exists := vrfData.BgpRouteEntries[rib.Canonical(route.Prefix)]
 		if !exists {
 			issues = append(issues, fmt.Sprintf("BGP route %s not found in VRF %s", route.Prefix, route.VRF))
 			continue
@@ -3572,9 +4389,9 @@ func (t *VerifyBGPRoutePaths) Execute(ctx context.Context, dev device.Device) (*
 
 	if len(issues) > 0 {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("BGP route paths validation failed: %s", strings.Join(issues, "; "))
+		result.Message = fmt.Sprintf("%s route paths validation failed: %s", bgpRouteScope(t.AddressFamily), strings.Join(issues, "; "))
 	} else {
-		result.Message = fmt.Sprintf("All BGP route paths verified (%d routes)", len(t.Routes))
+		result.Message = fmt.Sprintf("All %s route paths verified (%d routes)", bgpRouteScope(t.AddressFamily), len(t.Routes))
 	}
 
 	return result, nil
@@ -3589,7 +4406,7 @@ func (t *VerifyBGPRoutePaths) ValidateInput(input any) error {
 			return fmt.Errorf("routes[%d]: prefix is required", i)
 		}
 	}
-	return nil
+	return validateBgpRouteFamily(t.AddressFamily, t.Routes)
 }
 
 // VerifyBGPRouteECMP verifies that BGP routes have proper ECMP (Equal Cost Multi-Path) behavior.
@@ -3598,6 +4415,17 @@ func (t *VerifyBGPRoutePaths) ValidateInput(input any) error {
 // as expected. It ensures that ECMP is functioning correctly for BGP routes and that
 // traffic distribution meets the specified requirements.
 //
+// This test performs the following checks for each specified route:
+//  1. Verifies that the route is installed in the VRF's routing table.
+//  2. Compares the installed next-hop count against `expected_paths` exactly.
+//     When `expected_paths` is omitted, at least two next-hops are required.
+//  3. If `check_path_status` is true, verifies every BGP path for the prefix
+//     is flagged both `valid` and `ecmp` in `show ip bgp`.
+//
+// IPv6 prefixes are checked against `show ipv6 route` and `show ipv6 bgp`,
+// IPv4 ones against the `ip` forms. Setting `address_family` pins every
+// route to one family and rejects prefixes of the other.
+//
 // Expected Results:
 //   - Success: All specified routes have the expected ECMP behavior and path distribution.
 //   - Failure: Routes don't have proper ECMP or path distribution is incorrect.
@@ -3607,6 +4435,7 @@ func (t *VerifyBGPRoutePaths) ValidateInput(input any) error {
 //   - name: "VerifyBGPRouteECMP"
 //     module: "routing"
 //     inputs:
+//     check_path_status: true
 //     routes:
 //   - prefix: "192.168.1.0/24"
 //     expected_paths: 4
@@ -3615,7 +4444,9 @@ func (t *VerifyBGPRoutePaths) ValidateInput(input any) error {
 //     expected_paths: 2
 type VerifyBGPRouteECMP struct {
 	test.BaseTest
-	Routes []BgpRoute `yaml:"routes" json:"routes"`
+	Routes          []BgpRoute `yaml:"routes" json:"routes"`
+	CheckPathStatus bool       `yaml:"check_path_status,omitempty" json:"check_path_status,omitempty"`
+	AddressFamily   string     `yaml:"address_family,omitempty" json:"address_family,omitempty"`
 }
 
 func NewVerifyBGPRouteECMP(inputs map[string]any) (test.Test, error) {
@@ -3633,6 +4464,13 @@ func NewVerifyBGPRouteECMP(inputs map[string]any) (test.Test, error) {
 	}
 	t.Routes = routes
 
+	if err := test.GetBool(inputs, "check_path_status", &t.CheckPathStatus); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "address_family", &t.AddressFamily); err != nil {
+		return nil, err
+	}
+
 	return t, nil
 }
 
@@ -3647,15 +4485,24 @@ func (t *VerifyBGPRouteECMP) Execute(ctx context.Context, dev device.Device) (*t
 	// Group routes by VRF so we issue one `show ip route vrf X bgp detail`
 	// per VRF rather than per route. `show ip route bgp detail vrf all` is
 	// rejected by EOS, so default VRF and named VRFs share the same form.
-	byVRF := map[string][]BgpRoute{}
+	type routeTable struct{ family, vrf string }
+	byVRF := map[routeTable][]BgpRoute{}
 	for _, r := range t.Routes {
-		byVRF[r.VRF] = append(byVRF[r.VRF], r)
+		key := routeTable{bgpRouteCommandFamily(t.AddressFamily, r.Prefix), r.VRF}
+		byVRF[key] = append(byVRF[key], r)
 	}
 
 	issues := []string{}
-	for vrf, routes := range byVRF {
+	verified := []string{}
+	checked := 0
+	for key, routes := range byVRF {
+		if test.Cancelled(ctx, result, checked, len(byVRF), "VRFs", issues) {
+			return result, nil
+		}
+		checked++
+		family, vrf := key.family, key.vrf
 		cmd := device.Command{
-			Template: fmt.Sprintf("show ip route vrf %s bgp detail", vrf),
+			Template: fmt.Sprintf("show %s route vrf %s bgp detail", family, vrf),
 			Format:   "json",
 			UseCache: false,
 		}
@@ -3690,12 +4537,13 @@ func (t *VerifyBGPRouteECMP) Execute(ctx context.Context, dev device.Device) (*t
 		}
 
 		for _, route := range routes {
-			entry, exists := vrfData.Routes[route.Prefix]
+			entry, exists := vrfData.Routes[rib.Canonical(route.Prefix)]
 			if !exists {
 				issues = append(issues, fmt.Sprintf("BGP route %s not found in VRF %s", route.Prefix, vrf))
 				continue
 			}
 			got := len(entry.Vias)
+			verified = append(verified, fmt.Sprintf("%s=%d", route.Prefix, got))
 			switch {
 			case route.ExpectedPaths > 0 && got != route.ExpectedPaths:
 				issues = append(issues, fmt.Sprintf("Route %s in VRF %s: expected %d ECMP next-hops, got %d",
@@ -3704,19 +4552,86 @@ func (t *VerifyBGPRouteECMP) Execute(ctx context.Context, dev device.Device) (*t
 				issues = append(issues, fmt.Sprintf("Route %s in VRF %s has only %d next-hop(s), expected ECMP",
 					route.Prefix, vrf, got))
 			}
+
+			if t.CheckPathStatus {
+				pathIssues, err := checkBgpEcmpPathStatus(ctx, dev, family, route.Prefix, vrf)
+				if err != nil {
+					issues = append(issues, fmt.Sprintf("Route %s in VRF %s: path status check failed: %v",
+						route.Prefix, vrf, err))
+					continue
+				}
+				issues = append(issues, pathIssues...)
+			}
 		}
 	}
 
 	if len(issues) > 0 {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("BGP ECMP validation failed: %s", strings.Join(issues, "; "))
+		result.Message = fmt.Sprintf("%s ECMP validation failed: %s", bgpRouteScope(t.AddressFamily), strings.Join(issues, "; "))
 	} else {
-		result.Message = fmt.Sprintf("All BGP ECMP routes verified (%d routes)", len(t.Routes))
+		sort.Strings(verified)
+		result.Message = fmt.Sprintf("All %s ECMP routes verified (%d routes; next-hops: %s)",
+			bgpRouteScope(t.AddressFamily), len(t.Routes), strings.Join(verified, ", "))
 	}
 
 	return result, nil
 }
 
+var bgpPrefixCmd = device.Template("show {family} bgp {prefix} vrf {vrf}")
+
+// checkBgpEcmpPathStatus inspects every BGP path for prefix in vrf and
+// returns one issue per path that is not both valid and part of the ECMP
+// set. The RIB view (`show ip route ... detail`) only lists installed
+// vias, so a path that BGP considers invalid or non-ECMP would still
+// look fine there; the BGP table is the authoritative source for the
+// per-path flags.
+func checkBgpEcmpPathStatus(ctx context.Context, dev device.Device, family, prefix, vrf string) ([]string, error) {
+	cmdStr, err := bgpPrefixCmd.Render(map[string]any{"family": family, "prefix": prefix, "vrf": vrf})
+	if err != nil {
+		return nil, err
+	}
+	cmd := device.Command{
+		Template: cmdStr,
+		Format:   "json",
+		UseCache: false,
+	}
+	res, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		VRFs map[string]struct {
+			BgpRouteEntries map[string]struct {
+				BgpRoutePaths []struct {
+					NextHop   string `json:"nextHop"`
+					RouteType struct {
+						Valid bool `json:"valid"`
+						Ecmp  bool `json:"ecmp"`
+					} `json:"routeType"`
+				} `json:"bgpRoutePaths"`
+			} `json:"bgpRouteEntries"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(res.Output, &resp); err != nil {
+		return nil, err
+	}
+
+	entry, ok := resp.VRFs[vrf].BgpRouteEntries[rib.Canonical(prefix)]
+	if !ok {
+		return []string{fmt.Sprintf("Route %s in VRF %s not found in BGP table", prefix, vrf)}, nil
+	}
+
+	var issues []string
+	for _, path := range entry.BgpRoutePaths {
+		if !path.RouteType.Valid || !path.RouteType.Ecmp {
+			issues = append(issues, fmt.Sprintf("Route %s in VRF %s: path via %s valid=%t ecmp=%t",
+				prefix, vrf, path.NextHop, path.RouteType.Valid, path.RouteType.Ecmp))
+		}
+	}
+	return issues, nil
+}
+
 func (t *VerifyBGPRouteECMP) ValidateInput(input any) error {
 	if len(t.Routes) == 0 {
 		return fmt.Errorf("at least one route must be specified")
@@ -3726,7 +4641,7 @@ func (t *VerifyBGPRouteECMP) ValidateInput(input any) error {
 			return fmt.Errorf("routes[%d]: prefix is required", i)
 		}
 	}
-	return nil
+	return validateBgpRouteFamily(t.AddressFamily, t.Routes)
 }
 
 // VerifyBGPRedistribution verifies that route redistribution into BGP is working correctly.
@@ -3735,6 +4650,14 @@ func (t *VerifyBGPRouteECMP) ValidateInput(input any) error {
 // are being properly redistributed into BGP according to the configured policies.
 // It ensures that redistribution filters and route-maps are applied correctly.
 //
+// This test performs the following checks for each source protocol and VRF:
+//  1. Verifies the protocol is configured for redistribution in the VRF
+//     (`show bgp instance`), and that its route-map matches `route_map` if given.
+//  2. If `expected_count` is set, counts the locally originated BGP routes in
+//     the VRF (`show ip bgp vrf <vrf>`) whose prefix is sourced from that
+//     protocol in the RIB (`show ip route vrf <vrf> <proto>`), and compares the
+//     count to `expected_count` exactly.
+//
 // Expected Results:
 //   - Success: All expected routes are redistributed into BGP with correct attributes.
 //   - Failure: Expected routes are missing from BGP or have incorrect attributes.
@@ -3748,6 +4671,7 @@ func (t *VerifyBGPRouteECMP) ValidateInput(input any) error {
 //   - source_protocol: "ospf"
 //     expected_count: 10
 //     vrf: "default"
+//     route_map: "RM-OSPF-TO-BGP"
 //   - source_protocol: "static"
 //     expected_count: 5
 //
@@ -3757,6 +4681,7 @@ type BgpRedistribution struct {
 	SourceProtocol string `yaml:"source_protocol" json:"source_protocol"`
 	ExpectedCount  int    `yaml:"expected_count,omitempty" json:"expected_count,omitempty"`
 	VRF            string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+	RouteMap       string `yaml:"route_map,omitempty" json:"route_map,omitempty"`
 }
 
 type VerifyBGPRedistribution struct {
@@ -3793,6 +4718,9 @@ func NewVerifyBGPRedistribution(inputs map[string]any) (test.Test, error) {
 				if s, ok := m["vrf"].(string); ok && s != "" {
 					entry.VRF = s
 				}
+				if s, ok := m["route_map"].(string); ok {
+					entry.RouteMap = s
+				}
 				t.RedistributedRoutes = append(t.RedistributedRoutes, entry)
 			}
 		}
@@ -3845,7 +4773,12 @@ func (t *VerifyBGPRedistribution) Execute(ctx context.Context, dev device.Device
 		return result, nil
 	}
 
+	// The BGP table is fetched at most once per VRF, however many
+	// protocols are checked against it.
+	localBgp := map[string]map[string]bool{}
+
 	issues := []string{}
+	counts := []string{}
 	for _, entry := range t.RedistributedRoutes {
 		vrfData, exists := response.VRFs[entry.VRF]
 		if !exists {
@@ -3857,16 +4790,14 @@ func (t *VerifyBGPRedistribution) Execute(ctx context.Context, dev device.Device
 		// match. The test schema doesn't pin AF, so this is the most lenient
 		// reading — "is this protocol redistributed *somewhere* in this VRF".
 		found := false
+		var routeMaps []string
 		for _, af := range vrfData.AfiSafiConfig {
 			for _, r := range af.RedistributedRoutes {
 				if strings.EqualFold(r.Proto, entry.SourceProtocol) {
 					found = true
-					break
+					routeMaps = append(routeMaps, r.RouteMap)
 				}
 			}
-			if found {
-				break
-			}
 		}
 		if !found {
 			issues = append(issues, fmt.Sprintf("source protocol %q not configured for redistribution in VRF %s",
@@ -3874,17 +4805,37 @@ func (t *VerifyBGPRedistribution) Execute(ctx context.Context, dev device.Device
 			continue
 		}
 
+		if entry.RouteMap != "" && !slices.Contains(routeMaps, entry.RouteMap) {
+			issues = append(issues, fmt.Sprintf("source protocol %q in VRF %s: expected route-map %s, configured %v",
+				entry.SourceProtocol, entry.VRF, entry.RouteMap, routeMaps))
+		}
+
 		if entry.ExpectedCount > 0 {
-			count, err := countRoutesByProto(ctx, dev, entry.SourceProtocol, entry.VRF)
+			local, ok := localBgp[entry.VRF]
+			if !ok {
+				local, err = locallyOriginatedBgpPrefixes(ctx, dev, entry.VRF)
+				if err != nil {
+					result.Status = test.TestError
+					result.Message = fmt.Sprintf("Failed to get BGP table for VRF %s: %v", entry.VRF, err)
+					return result, nil
+				}
+				localBgp[entry.VRF] = local
+			}
+			ribPrefixes, err := ribPrefixesByProto(ctx, dev, entry.SourceProtocol, entry.VRF)
 			if err != nil {
-				// Don't fail the test because the upper-bound count check
-				// couldn't run; the config check above is the primary signal.
-				issues = append(issues, fmt.Sprintf("source protocol %q in VRF %s: redistribution configured, but RIB count check skipped (%v)",
-					entry.SourceProtocol, entry.VRF, err))
-				continue
+				result.Status = test.TestError
+				result.Message = fmt.Sprintf("Failed to get %s routes for VRF %s: %v", entry.SourceProtocol, entry.VRF, err)
+				return result, nil
+			}
+			count := 0
+			for _, prefix := range ribPrefixes {
+				if local[prefix] {
+					count++
+				}
 			}
-			if count < entry.ExpectedCount {
-				issues = append(issues, fmt.Sprintf("source protocol %q in VRF %s: expected at least %d routes in RIB, found %d",
+			counts = append(counts, fmt.Sprintf("%s/%s=%d", entry.VRF, strings.ToLower(entry.SourceProtocol), count))
+			if count != entry.ExpectedCount {
+				issues = append(issues, fmt.Sprintf("source protocol %q in VRF %s: expected %d redistributed routes, found %d",
 					entry.SourceProtocol, entry.VRF, entry.ExpectedCount, count))
 			}
 		}
@@ -3893,6 +4844,9 @@ func (t *VerifyBGPRedistribution) Execute(ctx context.Context, dev device.Device
 	if len(issues) > 0 {
 		result.Status = test.TestFailure
 		result.Message = fmt.Sprintf("BGP redistribution validation failed: %s", strings.Join(issues, "; "))
+	} else if len(counts) > 0 {
+		result.Message = fmt.Sprintf("All BGP redistribution sources verified (%d entries; counts: %s)",
+			len(t.RedistributedRoutes), strings.Join(counts, ", "))
 	} else {
 		result.Message = fmt.Sprintf("All BGP redistribution sources verified (%d entries)", len(t.RedistributedRoutes))
 	}
@@ -3900,12 +4854,48 @@ func (t *VerifyBGPRedistribution) Execute(ctx context.Context, dev device.Device
 	return result, nil
 }
 
-// countRoutesByProto returns the number of IPv4 routes in `vrf` whose
-// source is `proto`. The expected_count check is an upper bound — a
-// protocol may have N RIB routes but only some subset gets redistributed
-// into BGP via route-maps. We use this to flag the obvious case where
-// the RIB itself doesn't have enough routes to satisfy the expectation.
-func countRoutesByProto(ctx context.Context, dev device.Device, proto, vrf string) (int, error) {
+// locallyOriginatedBgpPrefixes returns the prefixes in vrf's BGP table
+// that have at least one locally originated path. Redistributed routes
+// carry no next-hop in `show ip bgp` (EOS renders an empty string or the
+// unspecified address), which separates them from routes learned from a
+// peer for the same prefix.
+func locallyOriginatedBgpPrefixes(ctx context.Context, dev device.Device, vrf string) (map[string]bool, error) {
+	cmd := device.Command{
+		Template: fmt.Sprintf("show ip bgp vrf %s", vrf),
+		Format:   "json",
+		UseCache: false,
+	}
+	res, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		VRFs map[string]struct {
+			BgpRouteEntries map[string]struct {
+				BgpRoutePaths []struct {
+					NextHop string `json:"nextHop"`
+				} `json:"bgpRoutePaths"`
+			} `json:"bgpRouteEntries"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(res.Output, &resp); err != nil {
+		return nil, err
+	}
+	out := map[string]bool{}
+	for prefix, entry := range resp.VRFs[vrf].BgpRouteEntries {
+		for _, path := range entry.BgpRoutePaths {
+			switch path.NextHop {
+			case "", "0.0.0.0", "::":
+				out[prefix] = true
+			}
+		}
+	}
+	return out, nil
+}
+
+// ribPrefixesByProto returns the IPv4 prefixes in `vrf` whose RIB source
+// is `proto`.
+func ribPrefixesByProto(ctx context.Context, dev device.Device, proto, vrf string) ([]string, error) {
 	cmd := device.Command{
 		Template: fmt.Sprintf("show ip route vrf %s %s", vrf, strings.ToLower(proto)),
 		Format:   "json",
@@ -3913,7 +4903,7 @@ func countRoutesByProto(ctx context.Context, dev device.Device, proto, vrf strin
 	}
 	res, err := dev.Execute(ctx, cmd)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 	var resp struct {
 		VRFs map[string]struct {
@@ -3921,12 +4911,13 @@ func countRoutesByProto(ctx context.Context, dev device.Device, proto, vrf strin
 		} `json:"vrfs"`
 	}
 	if err := decodeOutput(res.Output, &resp); err != nil {
-		return 0, err
+		return nil, err
 	}
-	if v, ok := resp.VRFs[vrf]; ok {
-		return len(v.Routes), nil
+	prefixes := make([]string, 0, len(resp.VRFs[vrf].Routes))
+	for prefix := range resp.VRFs[vrf].Routes {
+		prefixes = append(prefixes, prefix)
 	}
-	return 0, nil
+	return prefixes, nil
 }
 
 func (t *VerifyBGPRedistribution) ValidateInput(input any) error {
@@ -3943,13 +4934,21 @@ func (t *VerifyBGPRedistribution) ValidateInput(input any) error {
 
 // VerifyBGPPeerTtlMultiHops verifies TTL security and multi-hop BGP peer configurations.
 //
-// This test validates that BGP peers configured for multi-hop sessions have the correct
-// TTL security settings. This is important for eBGP peers that are not directly connected
-// and require TTL security to prevent certain types of attacks.
+// TTL security (GTSM, RFC 5082) and eBGP multihop are separate settings:
+// TTL security makes the router send with TTL 255 and drop packets that
+// arrive with a TTL below a minimum, while multihop only raises the TTL
+// of outgoing packets so a peer several hops away can be reached. This
+// test performs the following checks for each specified peer:
+//  1. Verifies that the peer is found in its VRF.
+//  2. If `ttl_security` is set, validates that TTL security is enabled
+//     (outgoing `ttl` of 255) and that the minimum accepted TTL, derived
+//     from `maxTtlHops` as 256 - maxTtlHops, equals `ttl_security`.
+//  3. If `max_hops` is set, validates that the multihop limit, which EOS
+//     reports as the outgoing `ttl`, equals `max_hops`.
 //
 // Expected Results:
-//   - Success: All multi-hop peers have correct TTL security configuration.
-//   - Failure: Peers have incorrect TTL security settings or multi-hop configuration.
+//   - Success: All peers have the expected TTL security and multihop settings.
+//   - Failure: A peer is not found, or its TTL security or multihop setting differs.
 //   - Error: The test will error if BGP peer TTL information cannot be retrieved.
 //
 // Example YAML configuration:
@@ -3958,9 +4957,10 @@ func (t *VerifyBGPRedistribution) ValidateInput(input any) error {
 //     inputs:
 //     bgp_peers:
 //   - peer_address: "10.0.0.1"
-//     expected_ttl: 255
-//     max_hops: 5
+//     ttl_security: 254
 //     vrf: "default"
+//   - peer_address: "10.255.0.9"
+//     max_hops: 5
 type VerifyBGPPeerTtlMultiHops struct {
 	test.BaseTest
 	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
@@ -3970,14 +4970,14 @@ func NewVerifyBGPPeerTtlMultiHops(inputs map[string]any) (test.Test, error) {
 	t := &VerifyBGPPeerTtlMultiHops{
 		BaseTest: test.BaseTest{
 			TestName:        "VerifyBGPPeerTtlMultiHops",
-			TestDescription: "Verifies BGP peers TTL multihop configuration",
+			TestDescription: "Verifies BGP peers TTL security and multihop configuration",
 			TestCategories:  []string{"routing", "bgp", "multihop"},
 		},
 	}
 
 	if inputs != nil {
 		if peers, ok := inputs["bgp_peers"].([]any); ok {
-			for _, p := range peers {
+			for i, p := range peers {
 				peerMap, ok := p.(map[string]any)
 				if !ok {
 					continue
@@ -3989,15 +4989,11 @@ func NewVerifyBGPPeerTtlMultiHops(inputs map[string]any) (test.Test, error) {
 				if vrf, ok := peerMap["vrf"].(string); ok {
 					peer.VRF = vrf
 				}
-				if v, ok := peerMap["expected_ttl"].(float64); ok {
-					peer.ExpectedTTL = int(v)
-				} else if v, ok := peerMap["expected_ttl"].(int); ok {
-					peer.ExpectedTTL = v
+				if err := test.GetInt(peerMap, "ttl_security", &peer.TTLSecurity); err != nil {
+					return nil, fmt.Errorf("bgp_peers[%d]: %w", i, err)
 				}
-				if v, ok := peerMap["max_hops"].(float64); ok {
-					peer.MaxHops = int(v)
-				} else if v, ok := peerMap["max_hops"].(int); ok {
-					peer.MaxHops = v
+				if err := test.GetInt(peerMap, "max_hops", &peer.MaxHops); err != nil {
+					return nil, fmt.Errorf("bgp_peers[%d]: %w", i, err)
 				}
 				t.BGPPeers = append(t.BGPPeers, peer)
 			}
@@ -4007,6 +5003,9 @@ func NewVerifyBGPPeerTtlMultiHops(inputs map[string]any) (test.Test, error) {
 	return t, nil
 }
 
+// gtsmTTL is the TTL a router with TTL security enabled sends with.
+const gtsmTTL = 255
+
 func (t *VerifyBGPPeerTtlMultiHops) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
 	result := &test.TestResult{
 		TestName:   t.Name(),
@@ -4028,13 +5027,14 @@ func (t *VerifyBGPPeerTtlMultiHops) Execute(ctx context.Context, dev device.Devi
 		return result, nil
 	}
 
+	type ttlPeer struct {
+		PeerAddress string `json:"peerAddress"`
+		TTL         int    `json:"ttl"`
+		MaxTtlHops  int    `json:"maxTtlHops"`
+	}
 	var response struct {
 		VRFs map[string]struct {
-			PeerList []struct {
-				PeerAddress string `json:"peerAddress"`
-				TTL         int    `json:"ttl"`
-				MaxTtlHops  int    `json:"maxTtlHops"`
-			} `json:"peerList"`
+			PeerList []ttlPeer `json:"peerList"`
 		} `json:"vrfs"`
 	}
 
@@ -4058,11 +5058,7 @@ func (t *VerifyBGPPeerTtlMultiHops) Execute(ctx context.Context, dev device.Devi
 			continue
 		}
 
-		var match *struct {
-			PeerAddress string `json:"peerAddress"`
-			TTL         int    `json:"ttl"`
-			MaxTtlHops  int    `json:"maxTtlHops"`
-		}
+		var match *ttlPeer
 		for i := range vrfData.PeerList {
 			if vrfData.PeerList[i].PeerAddress == peer.PeerAddress {
 				match = &vrfData.PeerList[i]
@@ -4074,13 +5070,19 @@ func (t *VerifyBGPPeerTtlMultiHops) Execute(ctx context.Context, dev device.Devi
 			continue
 		}
 
-		if peer.ExpectedTTL > 0 && match.TTL != peer.ExpectedTTL {
-			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: expected TTL %d, got %d",
-				peer.PeerAddress, vrf, peer.ExpectedTTL, match.TTL))
+		if peer.TTLSecurity > 0 {
+			switch {
+			case match.TTL != gtsmTTL || match.MaxTtlHops <= 0:
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: TTL security not enabled, expected minimum TTL %d",
+					peer.PeerAddress, vrf, peer.TTLSecurity))
+			case gtsmTTL+1-match.MaxTtlHops != peer.TTLSecurity:
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: TTL security minimum TTL %d, expected %d",
+					peer.PeerAddress, vrf, gtsmTTL+1-match.MaxTtlHops, peer.TTLSecurity))
+			}
 		}
-		if peer.MaxHops > 0 && match.MaxTtlHops != peer.MaxHops {
-			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: expected max_hops %d, got %d",
-				peer.PeerAddress, vrf, peer.MaxHops, match.MaxTtlHops))
+		if peer.MaxHops > 0 && match.TTL != peer.MaxHops {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: multihop limit %d, expected %d",
+				peer.PeerAddress, vrf, match.TTL, peer.MaxHops))
 		}
 	}
 
@@ -4102,9 +5104,1189 @@ func (t *VerifyBGPPeerTtlMultiHops) ValidateInput(input any) error {
 		if peer.PeerAddress == "" {
 			return fmt.Errorf("bgp_peers[%d]: peer_address is required", i)
 		}
-		if peer.ExpectedTTL == 0 && peer.MaxHops == 0 {
-			return fmt.Errorf("bgp_peers[%d] (%s): at least one of expected_ttl or max_hops must be set", i, peer.PeerAddress)
+		if peer.TTLSecurity == 0 && peer.MaxHops == 0 {
+			return fmt.Errorf("bgp_peers[%d] (%s): at least one of ttl_security or max_hops must be set", i, peer.PeerAddress)
+		}
+		if peer.TTLSecurity < 0 || peer.TTLSecurity > gtsmTTL {
+			return fmt.Errorf("bgp_peers[%d] (%s): ttl_security must be between 1 and %d", i, peer.PeerAddress, gtsmTTL)
+		}
+		if peer.MaxHops < 0 || peer.MaxHops > gtsmTTL {
+			return fmt.Errorf("bgp_peers[%d] (%s): max_hops must be between 1 and %d", i, peer.PeerAddress, gtsmTTL)
 		}
 	}
 	return nil
 }
+
+// VerifyBGPGracefulRestart verifies BGP graceful restart capabilities of BGP peers.
+//
+// Graceful restart lets a peer keep forwarding on stale routes while its
+// neighbor's control plane restarts, which is what makes hitless upgrades
+// (SSU/ASU) hitless. This test performs the following checks for each peer:
+//  1. Verifies that the peer is found in its VRF.
+//  2. Validates that the graceful-restart and graceful-restart-helper
+//     capabilities are both advertised and received.
+//  3. If `minimum_restart_time` is set, validates that the restart time
+//     the peer advertised is at least that many seconds.
+//
+// When `bgp_peers` is omitted, every peer in every VRF is checked.
+//
+// Expected Results:
+//   - Success: All peers have graceful restart negotiated with a sufficient restart time.
+//   - Failure: A peer is not found, is missing a graceful restart capability, or advertises a short restart time.
+//   - Error: The test will error if BGP neighbor information cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyBGPGracefulRestart"
+//     module: "routing"
+//     inputs:
+//     minimum_restart_time: 120
+//     bgp_peers:
+//   - peer_address: "10.0.0.1"
+//     vrf: "default"
+//   - interface: "Ethernet1"
+//     vrf: "default"
+type VerifyBGPGracefulRestart struct {
+	test.BaseTest
+	BGPPeers           []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
+	MinimumRestartTime int               `yaml:"minimum_restart_time,omitempty" json:"minimum_restart_time,omitempty"`
+}
+
+func NewVerifyBGPGracefulRestart(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBGPGracefulRestart{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBGPGracefulRestart",
+			TestDescription: "Verifies BGP graceful restart capabilities",
+			TestCategories:  []string{"routing", "bgp", "capabilities"},
+		},
+	}
+
+	if inputs != nil {
+		if err := test.GetInt(inputs, "minimum_restart_time", &t.MinimumRestartTime); err != nil {
+			return nil, err
+		}
+		if peers, ok := inputs["bgp_peers"].([]any); ok {
+			for _, p := range peers {
+				peerMap, ok := p.(map[string]any)
+				if !ok {
+					continue
+				}
+				peer := BgpPeerExtended{VRF: "default"}
+				if addr, ok := peerMap["peer_address"].(string); ok {
+					peer.PeerAddress = addr
+				}
+				if intf, ok := peerMap["interface"].(string); ok {
+					peer.Interface = intf
+				}
+				if vrf, ok := peerMap["vrf"].(string); ok {
+					peer.VRF = vrf
+				}
+				t.BGPPeers = append(t.BGPPeers, peer)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyBGPGracefulRestart) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show bgp neighbors vrf all",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get BGP neighbors: %v", err)
+		return result, nil
+	}
+
+	entries, issues := selectBgpNeighbors(cmdResult.Output, t.BGPPeers)
+
+	for _, entry := range entries {
+		for _, c := range []struct{ key, name string }{
+			{"gracefulRestartCap", "graceful-restart"},
+			{"gracefulRestartHelperCap", "graceful-restart-helper"},
+		} {
+			capability, found := bgpNeighborCapability(entry.Info, c.key)
+			if !found || !capability.Advertised || !capability.Received {
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s missing %s capability: advertised=%t, received=%t",
+					entry.Label, entry.VRF, c.name, capability.Advertised, capability.Received))
+			}
+		}
+
+		if t.MinimumRestartTime > 0 {
+			restartTime, found := bgpGracefulRestartTime(entry.Info)
+			if !found {
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: graceful restart time not reported", entry.Label, entry.VRF))
+			} else if restartTime < t.MinimumRestartTime {
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: restart time %ds is below minimum %ds",
+					entry.Label, entry.VRF, restartTime, t.MinimumRestartTime))
+			}
+		}
+	}
+
+	result.Details = map[string]any{"peer_count": len(entries)}
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP graceful restart validation failed: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All BGP peers have graceful restart negotiated (%d peers)", len(entries))
+	}
+
+	return result, nil
+}
+
+// bgpGracefulRestartTime returns the restart time, in seconds, carried
+// in the peer's graceful-restart capability.
+func bgpGracefulRestartTime(info map[string]any) (int, bool) {
+	caps, ok := info["neighborCapabilities"].(map[string]any)
+	if !ok {
+		return 0, false
+	}
+	gr, ok := caps["gracefulRestartCap"].(map[string]any)
+	if !ok {
+		return 0, false
+	}
+	restartTime, ok := gr["restartTime"].(float64)
+	if !ok {
+		return 0, false
+	}
+	return int(restartTime), true
+}
+
+func (t *VerifyBGPGracefulRestart) ValidateInput(input any) error {
+	if t.MinimumRestartTime < 0 {
+		return fmt.Errorf("minimum_restart_time must be non-negative, got %d", t.MinimumRestartTime)
+	}
+	return validateBgpPeerIdentity(t.BGPPeers)
+}
+
+// VerifyBGPPeerConnectedCheck verifies eBGP peers are directly connected.
+//
+// In a leaf-spine fabric every eBGP session runs over a point-to-point
+// link. A session that only comes up because ebgp-multihop was added, or
+// whose peer address is reached through another route, points to a
+// cabling or subnet mismatch. This test performs the following checks for
+// each specified peer:
+//  1. Verifies that the peer is found in its VRF.
+//  2. Validates that the session is single-hop: the outgoing `ttl` is 1,
+//     or TTL security is enabled with `maxTtlHops` of 1.
+//  3. For peers given by address, validates that the route to the peer
+//     address (`show ip route vrf <vrf> <address>`) is a connected route.
+//     Interface peers are connected by definition and skip this check.
+//
+// Expected Results:
+//   - Success: Every peer is single-hop and on a connected subnet.
+//   - Failure: A peer is not found, requires multihop, or is not on a connected subnet.
+//   - Error: The test will error if BGP neighbor or route information cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyBGPPeerConnectedCheck"
+//     module: "routing"
+//     inputs:
+//     bgp_peers:
+//   - peer_address: "10.0.1.0"
+//   - interface: "Ethernet1"
+type VerifyBGPPeerConnectedCheck struct {
+	test.BaseTest
+	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
+}
+
+func NewVerifyBGPPeerConnectedCheck(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBGPPeerConnectedCheck{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBGPPeerConnectedCheck",
+			TestDescription: "Verifies eBGP peers are directly connected",
+			TestCategories:  []string{"routing", "bgp"},
+		},
+	}
+
+	if inputs != nil {
+		if peers, ok := inputs["bgp_peers"].([]any); ok {
+			for _, p := range peers {
+				peerMap, ok := p.(map[string]any)
+				if !ok {
+					continue
+				}
+				peer := BgpPeerExtended{VRF: "default"}
+				if addr, ok := peerMap["peer_address"].(string); ok {
+					peer.PeerAddress = addr
+				}
+				if intf, ok := peerMap["interface"].(string); ok {
+					peer.Interface = intf
+				}
+				if vrf, ok := peerMap["vrf"].(string); ok {
+					peer.VRF = vrf
+				}
+				t.BGPPeers = append(t.BGPPeers, peer)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyBGPPeerConnectedCheck) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show bgp neighbors vrf all",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get BGP neighbors: %v", err)
+		return result, nil
+	}
+
+	issues := []string{}
+	for i, peer := range t.BGPPeers {
+		if test.Cancelled(ctx, result, i, len(t.BGPPeers), "peers", issues) {
+			return result, nil
+		}
+		label := bgpPeerLabel(peer)
+		info, found := findBgpNeighbor(cmdResult.Output, peer.VRF, peer)
+		if !found {
+			issues = append(issues, fmt.Sprintf("BGP peer %s not found in VRF %s", label, peer.VRF))
+			continue
+		}
+
+		ttl, _ := info["ttl"].(float64)
+		hops := int(ttl)
+		if maxHops, _ := info["maxTtlHops"].(float64); hops == gtsmTTL && maxHops > 0 {
+			hops = int(maxHops)
+		}
+		if hops > 1 {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s requires multihop (%d hops)", label, peer.VRF, hops))
+			continue
+		}
+
+		if peer.PeerAddress == "" {
+			continue
+		}
+		routeType, prefix, err := peerRouteType(ctx, dev, peer.VRF, peer.PeerAddress)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get route to peer %s in VRF %s: %v", label, peer.VRF, err)
+			return result, nil
+		}
+		switch {
+		case prefix == "":
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s has no route", label, peer.VRF))
+		case !strings.EqualFold(routeType, "connected"):
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s is not on a connected subnet (reached via %s route %s)",
+				label, peer.VRF, routeType, prefix))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP directly-connected validation failed: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d BGP peers are directly connected", len(t.BGPPeers))
+	}
+
+	return result, nil
+}
+
+// peerRouteType returns the type and prefix of the route EOS uses to
+// reach address in vrf, or an empty prefix when there is none.
+func peerRouteType(ctx context.Context, dev device.Device, vrf, address string) (routeType, prefix string, err error) {
+	cmdStr, err := rib.VRFRouteCommand(vrf, address)
+	if err != nil {
+		return "", "", err
+	}
+	cmdResult, err := dev.Execute(ctx, device.Command{Template: cmdStr, Format: "json", UseCache: false})
+	if err != nil {
+		return "", "", err
+	}
+	var response struct {
+		VRFs map[string]struct {
+			Routes map[string]struct {
+				RouteType string `json:"routeType"`
+			} `json:"routes"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(cmdResult.Output, &response); err != nil {
+		return "", "", err
+	}
+	// EOS answers an address lookup with the longest matching route.
+	for p, route := range response.VRFs[vrf].Routes {
+		return route.RouteType, p, nil
+	}
+	return "", "", nil
+}
+
+func (t *VerifyBGPPeerConnectedCheck) ValidateInput(input any) error {
+	if len(t.BGPPeers) == 0 {
+		return fmt.Errorf("at least one BGP peer must be specified")
+	}
+	for i, peer := range t.BGPPeers {
+		if peer.PeerAddress != "" && net.ParseIP(peer.PeerAddress) == nil {
+			return fmt.Errorf("bgp_peers[%d]: invalid peer_address %q", i, peer.PeerAddress)
+		}
+	}
+	return validateBgpPeerIdentity(t.BGPPeers)
+}
+
+// VerifyBGPPeerFlapCount verifies BGP peers are not flapping.
+//
+// VerifyBGPPeers only looks at the current session state, so a peer that
+// drops and re-establishes every few minutes passes whenever it happens to
+// be up. This test performs the following checks for each peer:
+//  1. Verifies that the peer is found in its VRF.
+//  2. Counts the peer's flaps from `establishedTransitions`. The transition
+//     into the current Established session is not a flap; every other
+//     transition means the session went down at some point.
+//  3. Fails when the flap count exceeds `max_flaps`.
+//
+// When `bgp_peers` is omitted, every peer in every VRF is checked.
+//
+// Expected Results:
+//   - Success: No peer has flapped more than `max_flaps` times.
+//   - Failure: A peer is not found or has flapped more than `max_flaps` times.
+//   - Error: The test will error if BGP neighbor information cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyBGPPeerFlapCount"
+//     module: "routing"
+//     inputs:
+//     max_flaps: 3
+//     bgp_peers:
+//   - peer_address: "10.0.0.1"
+//     vrf: "default"
+type VerifyBGPPeerFlapCount struct {
+	test.BaseTest
+	BGPPeers []BgpPeerExtended `yaml:"bgp_peers" json:"bgp_peers"`
+	MaxFlaps int               `yaml:"max_flaps" json:"max_flaps"`
+}
+
+func NewVerifyBGPPeerFlapCount(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBGPPeerFlapCount{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBGPPeerFlapCount",
+			TestDescription: "Verifies BGP peers are not flapping",
+			TestCategories:  []string{"routing", "bgp"},
+		},
+		MaxFlaps: -1,
+	}
+
+	if inputs != nil {
+		if err := test.GetInt(inputs, "max_flaps", &t.MaxFlaps); err != nil {
+			return nil, err
+		}
+		if peers, ok := inputs["bgp_peers"].([]any); ok {
+			for _, p := range peers {
+				peerMap, ok := p.(map[string]any)
+				if !ok {
+					continue
+				}
+				peer := BgpPeerExtended{VRF: "default"}
+				if addr, ok := peerMap["peer_address"].(string); ok {
+					peer.PeerAddress = addr
+				}
+				if intf, ok := peerMap["interface"].(string); ok {
+					peer.Interface = intf
+				}
+				if vrf, ok := peerMap["vrf"].(string); ok {
+					peer.VRF = vrf
+				}
+				t.BGPPeers = append(t.BGPPeers, peer)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyBGPPeerFlapCount) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show bgp neighbors vrf all",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get BGP neighbors: %v", err)
+		return result, nil
+	}
+
+	entries, issues := selectBgpNeighbors(cmdResult.Output, t.BGPPeers)
+	now := time.Now()
+
+	for _, entry := range entries {
+		transitions, ok := entry.Info["establishedTransitions"].(float64)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("Peer %s in VRF %s: establishedTransitions not reported", entry.Label, entry.VRF))
+			continue
+		}
+		state, _ := entry.Info["state"].(string)
+		flaps := int(transitions)
+		if state == "Established" && flaps > 0 {
+			flaps--
+		}
+		if flaps <= t.MaxFlaps {
+			continue
+		}
+
+		uptime := "down"
+		if state == "Established" {
+			if seconds, ok := bgpEstablishedSeconds(entry.Info, now); ok {
+				uptime = fmt.Sprintf("up %.0f seconds", seconds)
+			}
+		}
+		issues = append(issues, fmt.Sprintf("Peer %s in VRF %s flapped %d times (maximum: %d), %s",
+			entry.Label, entry.VRF, flaps, t.MaxFlaps, uptime))
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP peer flap validation failed: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All BGP peers within %d flaps (%d peers)", t.MaxFlaps, len(entries))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyBGPPeerFlapCount) ValidateInput(input any) error {
+	if t.MaxFlaps < 0 {
+		return fmt.Errorf("max_flaps is required and must be non-negative")
+	}
+	return validateBgpPeerIdentity(t.BGPPeers)
+}
+
+// VerifyBGPVrfAllPeersEstablished verifies every BGP peer in the given VRFs is Established.
+//
+// This is the "is the fabric healthy" smoke test: rather than listing peers,
+// it takes every peer `show bgp summary` knows about and requires each to be
+// Established. This test performs the following checks:
+//  1. Verifies that each listed VRF exists and has at least one peer.
+//  2. Validates that every peer in those VRFs is in the Established state.
+//
+// When `vrfs` is omitted, every VRF on the device is checked.
+//
+// Expected Results:
+//   - Success: Every peer in the selected VRFs is Established.
+//   - Failure: A VRF is missing or empty, or a peer is not Established.
+//   - Error: The test will error if the BGP summary cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyBGPVrfAllPeersEstablished"
+//     module: "routing"
+//     inputs:
+//     vrfs:
+//   - "default"
+//   - "PROD"
+type VerifyBGPVrfAllPeersEstablished struct {
+	test.BaseTest
+	VRFs []string `yaml:"vrfs,omitempty" json:"vrfs,omitempty"`
+}
+
+func NewVerifyBGPVrfAllPeersEstablished(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBGPVrfAllPeersEstablished{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBGPVrfAllPeersEstablished",
+			TestDescription: "Verifies all BGP peers in the given VRFs are Established",
+			TestCategories:  []string{"routing", "bgp"},
+			TestSeverity:    test.SeverityCritical,
+		},
+	}
+
+	if inputs != nil {
+		if err := test.GetStringSlice(inputs, "vrfs", &t.VRFs); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyBGPVrfAllPeersEstablished) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show bgp summary vrf all",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get BGP summary: %v", err)
+		return result, nil
+	}
+
+	var response struct {
+		VRFs map[string]struct {
+			Peers map[string]struct {
+				PeerState string `json:"peerState"`
+			} `json:"peers"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(cmdResult.Output, &response); err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to parse BGP summary output: %v", err)
+		return result, nil
+	}
+
+	vrfs := t.VRFs
+	if len(vrfs) == 0 {
+		for vrf := range response.VRFs {
+			vrfs = append(vrfs, vrf)
+		}
+		sort.Strings(vrfs)
+	}
+
+	issues := []string{}
+	checked := 0
+
+	for _, vrf := range vrfs {
+		vrfData, exists := response.VRFs[vrf]
+		if !exists {
+			issues = append(issues, fmt.Sprintf("VRF %s not found", vrf))
+			continue
+		}
+		if len(vrfData.Peers) == 0 && len(t.VRFs) > 0 {
+			issues = append(issues, fmt.Sprintf("No BGP peers in VRF %s", vrf))
+			continue
+		}
+
+		peers := make([]string, 0, len(vrfData.Peers))
+		for peer := range vrfData.Peers {
+			peers = append(peers, peer)
+		}
+		sort.Strings(peers)
+
+		for _, peer := range peers {
+			checked++
+			if state := vrfData.Peers[peer].PeerState; state != "Established" {
+				issues = append(issues, fmt.Sprintf("Peer %s in VRF %s is %s", peer, vrf, state))
+			}
+		}
+	}
+
+	if len(issues) == 0 && checked == 0 {
+		issues = append(issues, "No BGP peers found")
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP peers not established: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d BGP peers Established across %d VRFs", checked, len(vrfs))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyBGPVrfAllPeersEstablished) ValidateInput(input any) error {
+	for i, vrf := range t.VRFs {
+		if vrf == "" {
+			return fmt.Errorf("vrfs[%d]: VRF name must not be empty", i)
+		}
+	}
+	return nil
+}
+
+// VerifyEVPNMacMobility verifies no MAC address is moving between VTEPs.
+//
+// Every time a MAC moves to a new VTEP, the VTEP that learns it advertises
+// a Type-2 route with a higher MAC Mobility sequence number (RFC 7432
+// section 15). A MAC that keeps flapping between VTEPs, for example
+// because of a loop or a duplicated host, leaves a high sequence number
+// behind. This test reads `show bgp evpn route-type mac-ip`, takes the
+// highest sequence number advertised for each MAC, and fails when it
+// exceeds `max_mobility_seq`. Sticky (static) MACs cannot move and are not
+// checked.
+//
+// Expected Results:
+//   - Success: No MAC has a mobility sequence number above `max_mobility_seq`.
+//   - Failure: One or more MACs exceed `max_mobility_seq`.
+//   - Error: The test will error if EVPN route information cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyEVPNMacMobility"
+//     module: "routing"
+//     inputs:
+//     max_mobility_seq: 5
+type VerifyEVPNMacMobility struct {
+	test.BaseTest
+	MaxMobilitySeq int `yaml:"max_mobility_seq" json:"max_mobility_seq"`
+}
+
+func NewVerifyEVPNMacMobility(inputs map[string]any) (test.Test, error) {
+	t := &VerifyEVPNMacMobility{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyEVPNMacMobility",
+			TestDescription: "Verifies no EVPN MAC address is flapping between VTEPs",
+			TestCategories:  []string{"routing", "evpn"},
+		},
+		MaxMobilitySeq: -1,
+	}
+
+	if err := test.GetInt(inputs, "max_mobility_seq", &t.MaxMobilitySeq); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyEVPNMacMobility) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show bgp evpn route-type mac-ip",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get EVPN MAC-IP routes: %v", err)
+		return result, nil
+	}
+
+	var response struct {
+		EvpnRoutes map[string]struct {
+			RouteKeyDetail struct {
+				MAC string `json:"mac"`
+			} `json:"routeKeyDetail"`
+			EvpnRoutePaths []struct {
+				NextHop     string `json:"nextHop"`
+				RouteDetail struct {
+					ExtCommunities []string `json:"extCommunities"`
+				} `json:"routeDetail"`
+			} `json:"evpnRoutePaths"`
+		} `json:"evpnRoutes"`
+	}
+	if err := decodeOutput(cmdResult.Output, &response); err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected EVPN MAC-IP route output: %v", err)
+		return result, nil
+	}
+
+	type mobility struct {
+		seq  int
+		vtep string
+	}
+	// A MAC has one mac-ip route per IP it is bound to and per RD, so keep
+	// the highest sequence number seen for each MAC.
+	highest := map[string]mobility{}
+	for _, route := range response.EvpnRoutes {
+		mac := route.RouteKeyDetail.MAC
+		if mac == "" {
+			continue
+		}
+		for _, path := range route.EvpnRoutePaths {
+			seq, ok := macMobilitySeq(path.RouteDetail.ExtCommunities)
+			if ok && seq > highest[mac].seq {
+				highest[mac] = mobility{seq: seq, vtep: path.NextHop}
+			}
+		}
+	}
+
+	macs := make([]string, 0, len(highest))
+	for mac, m := range highest {
+		if m.seq > t.MaxMobilitySeq {
+			macs = append(macs, mac)
+		}
+	}
+	sort.Strings(macs)
+
+	issues := make([]string, 0, len(macs))
+	for _, mac := range macs {
+		m := highest[mac]
+		issue := fmt.Sprintf("MAC %s mobility sequence %d (maximum: %d)", mac, m.seq, t.MaxMobilitySeq)
+		if m.vtep != "" {
+			issue += fmt.Sprintf(", last advertised by VTEP %s", m.vtep)
+		}
+		issues = append(issues, issue)
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("EVPN MAC mobility validation failed: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("No EVPN MAC above mobility sequence %d (%d MAC-IP routes)", t.MaxMobilitySeq, len(response.EvpnRoutes))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyEVPNMacMobility) ValidateInput(input any) error {
+	if t.MaxMobilitySeq < 0 {
+		return fmt.Errorf("max_mobility_seq is required and must be non-negative")
+	}
+	return nil
+}
+
+// macMobilitySeq returns the sequence number from the MAC Mobility
+// extended community EOS renders as "EvpnMacMobility:7" (or
+// "EvpnMacMobility:Sticky:0" for a static MAC). Sticky MACs and routes
+// without the community report ok=false.
+func macMobilitySeq(communities []string) (seq int, ok bool) {
+	for _, c := range communities {
+		name, value, found := strings.Cut(c, ":")
+		if !found || !strings.EqualFold(strings.TrimSpace(name), "EvpnMacMobility") {
+			continue
+		}
+		if strings.Contains(strings.ToLower(value), "sticky") {
+			return 0, false
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return 0, false
+		}
+		return n, true
+	}
+	return 0, false
+}
+
+// VerifyBGPConfederation verifies the BGP confederation configuration.
+//
+// A confederation splits one AS into member sub-ASes that peer with each
+// other as if external while presenting a single AS to the outside. A
+// wrong confederation identifier leaks the sub-AS to external peers, and a
+// member AS missing from `bgp confederation peers` turns an intra-
+// confederation session into a true eBGP one. This test reads
+// `show bgp instance` and performs the following checks for the VRF:
+//  1. Validates the confederation identifier equals `confed_id`.
+//  2. Validates every AS in `member_as` is configured as a confederation peer.
+//
+// ASNs may be written as asplain numbers or asdot strings.
+//
+// Expected Results:
+//   - Success: The confederation identifier matches and every member AS is configured.
+//   - Failure: No confederation is configured, the identifier differs, or a member AS is missing.
+//   - Error: The test will error if BGP instance information cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyBGPConfederation"
+//     module: "routing"
+//     inputs:
+//     confed_id: 65000
+//     member_as: [65101, 65102, "1.10"]
+type VerifyBGPConfederation struct {
+	test.BaseTest
+	ConfedID uint32   `yaml:"confed_id" json:"confed_id"`
+	MemberAS []uint32 `yaml:"member_as,omitempty" json:"member_as,omitempty"`
+	VRF      string   `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyBGPConfederation(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBGPConfederation{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBGPConfederation",
+			TestDescription: "Verifies the BGP confederation identifier and member ASes",
+			TestCategories:  []string{"routing", "bgp"},
+		},
+		VRF: "default",
+	}
+
+	if raw, ok := inputs["confed_id"]; ok {
+		asn, err := parseASN(raw)
+		if err != nil {
+			return nil, fmt.Errorf("confed_id: %w", err)
+		}
+		t.ConfedID = asn
+	}
+	if raw, ok := inputs["member_as"]; ok {
+		members, ok := raw.([]any)
+		if !ok {
+			return nil, fmt.Errorf("member_as: expected list, got %T", raw)
+		}
+		for i, m := range members {
+			asn, err := parseASN(m)
+			if err != nil {
+				return nil, fmt.Errorf("member_as[%d]: %w", i, err)
+			}
+			t.MemberAS = append(t.MemberAS, asn)
+		}
+	}
+	if err := test.GetString(inputs, "vrf", &t.VRF); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyBGPConfederation) InputSchema() *test.Schema {
+	s := test.SchemaOf(t)
+	s.Required = []string{"confed_id"}
+	s.Properties["confed_id"] = asnSchema()
+	s.Properties["member_as"].Items = asnSchema()
+	return s
+}
+
+func (t *VerifyBGPConfederation) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show bgp instance",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get BGP instance config: %v", err)
+		return result, nil
+	}
+
+	var response struct {
+		VRFs map[string]struct {
+			ConfederationID    any   `json:"confederationId"`
+			ConfederationPeers []any `json:"confederationPeers"`
+		} `json:"vrfs"`
+	}
+	if err := decodeOutput(cmdResult.Output, &response); err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to parse BGP instance output: %v", err)
+		return result, nil
+	}
+
+	vrf, ok := response.VRFs[t.VRF]
+	if !ok {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP is not configured in VRF %s", t.VRF)
+		return result, nil
+	}
+	if vrf.ConfederationID == nil {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("No BGP confederation configured in VRF %s, expected identifier %s", t.VRF, formatASN(t.ConfedID))
+		return result, nil
+	}
+	confedID, err := parseASN(vrf.ConfederationID)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected confederation identifier: %v", err)
+		return result, nil
+	}
+
+	issues := []string{}
+	if confedID != t.ConfedID {
+		issues = append(issues, fmt.Sprintf("confederation identifier is %s, expected %s", formatASN(confedID), formatASN(t.ConfedID)))
+	}
+
+	configured := map[uint32]bool{}
+	for _, raw := range vrf.ConfederationPeers {
+		if asn, err := parseASN(raw); err == nil {
+			configured[asn] = true
+		}
+	}
+	missing := []string{}
+	for _, asn := range t.MemberAS {
+		if !configured[asn] {
+			missing = append(missing, formatASN(asn))
+		}
+	}
+	if len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("member AS not configured as confederation peers: %s", strings.Join(missing, ", ")))
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP confederation validation failed in VRF %s: %s", t.VRF, strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("BGP confederation %s verified with %d member ASes", formatASN(confedID), len(t.MemberAS))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyBGPConfederation) ValidateInput(input any) error {
+	if t.ConfedID == 0 {
+		return fmt.Errorf("confed_id is required")
+	}
+	for i, asn := range t.MemberAS {
+		if asn == 0 {
+			return fmt.Errorf("member_as[%d]: AS 0 is reserved", i)
+		}
+		if asn == t.ConfedID {
+			return fmt.Errorf("member_as[%d]: %s is the confederation identifier, not a member AS", i, formatASN(asn))
+		}
+	}
+	return nil
+}
+
+// VerifyBGPRouteAttributes verifies the path attributes of BGP routes.
+//
+// Presence checks such as VerifyBGPExchangedRoutes pass even when a
+// policy sets the wrong attributes, so traffic takes an unintended path.
+// This test reads `show ip bgp <prefix> vrf <vrf>` (`show ipv6 bgp` for
+// IPv6 prefixes) for each expected route and, on its active path, checks
+// in order:
+//  1. The origin code (igp, egp or incomplete), if `origin` is set.
+//  2. The MED, if `med` is set.
+//  3. The local preference, if `local_pref` is set.
+//  4. The AS path against `as_path_regex`, if set. The path is matched as
+//     EOS prints it, space-separated ASNs with an empty string for locally
+//     originated routes, so "^65001 " matches routes learned from AS 65001.
+//
+// Only the first mismatching attribute of each route is reported.
+//
+// Expected Results:
+//   - Success: Every route's active path carries the expected attributes.
+//   - Failure: A route is missing, has no active path, or an attribute differs.
+//   - Error: The test will error if BGP route information cannot be retrieved.
+//
+// Example YAML configuration:
+//   - name: "VerifyBGPRouteAttributes"
+//     module: "routing"
+//     inputs:
+//     routes:
+//   - prefix: "10.100.0.0/16"
+//     vrf: "default"
+//     origin: "igp"
+//     local_pref: 200
+//     as_path_regex: "^65001( |$)"
+//   - prefix: "2001:db8:100::/48"
+//     med: 50
+type VerifyBGPRouteAttributes struct {
+	test.BaseTest
+	Routes []BgpRouteAttributes `yaml:"routes" json:"routes"`
+}
+
+// BgpRouteAttributes is one route and the attributes expected on it.
+// Unset attributes are not checked.
+type BgpRouteAttributes struct {
+	Prefix      string `yaml:"prefix" json:"prefix"`
+	VRF         string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+	Origin      string `yaml:"origin,omitempty" json:"origin,omitempty"`
+	MED         *int   `yaml:"med,omitempty" json:"med,omitempty"`
+	LocalPref   *int   `yaml:"local_pref,omitempty" json:"local_pref,omitempty"`
+	ASPathRegex string `yaml:"as_path_regex,omitempty" json:"as_path_regex,omitempty"`
+
+	asPath *regexp.Regexp
+}
+
+func NewVerifyBGPRouteAttributes(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBGPRouteAttributes{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBGPRouteAttributes",
+			TestDescription: "Verifies BGP route path attributes",
+			TestCategories:  []string{"routing", "bgp"},
+		},
+	}
+
+	if inputs != nil {
+		if routes, ok := inputs["routes"].([]any); ok {
+			for i, r := range routes {
+				routeMap, ok := r.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("routes[%d]: expected map, got %T", i, r)
+				}
+				route := BgpRouteAttributes{VRF: "default"}
+				if err := test.GetString(routeMap, "prefix", &route.Prefix); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "vrf", &route.VRF); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "origin", &route.Origin); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "as_path_regex", &route.ASPathRegex); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if route.ASPathRegex != "" {
+					re, err := regexp.Compile(route.ASPathRegex)
+					if err != nil {
+						return nil, fmt.Errorf("routes[%d]: invalid as_path_regex: %w", i, err)
+					}
+					route.asPath = re
+				}
+				var err error
+				if route.MED, err = optionalIntInput(routeMap, "med"); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if route.LocalPref, err = optionalIntInput(routeMap, "local_pref"); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				t.Routes = append(t.Routes, route)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyBGPRouteAttributes) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	issues := []string{}
+	for i, route := range t.Routes {
+		if test.Cancelled(ctx, result, i, len(t.Routes), "routes", issues) {
+			return result, nil
+		}
+		cmdStr, err := bgpPrefixCmd.Render(map[string]any{"family": rib.Family(route.Prefix), "prefix": route.Prefix, "vrf": route.VRF})
+		if err != nil {
+			issues = append(issues, err.Error())
+			continue
+		}
+		cmdResult, err := dev.Execute(ctx, device.Command{Template: cmdStr, Format: "json", UseCache: false})
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get BGP route %s in VRF %s: %v", route.Prefix, route.VRF, err)
+			return result, nil
+		}
+
+		var resp struct {
+			VRFs map[string]struct {
+				BgpRouteEntries map[string]struct {
+					BgpRoutePaths []struct {
+						ASPathEntry struct {
+							ASPath string `json:"asPath"`
+						} `json:"asPathEntry"`
+						MED       *int `json:"med"`
+						LocalPref *int `json:"localPreference"`
+						RouteType struct {
+							Active bool `json:"active"`
+						} `json:"routeType"`
+						RouteDetail struct {
+							Origin string `json:"origin"`
+						} `json:"routeDetail"`
+					} `json:"bgpRoutePaths"`
+				} `json:"bgpRouteEntries"`
+			} `json:"vrfs"`
+		}
+		if err := decodeOutput(cmdResult.Output, &resp); err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to parse BGP route %s in VRF %s: %v", route.Prefix, route.VRF, err)
+			return result, nil
+		}
+
+		label := fmt.Sprintf("Route %s in VRF %s", route.Prefix, route.VRF)
+		entry, ok := resp.VRFs[route.VRF].BgpRouteEntries[rib.Canonical(route.Prefix)]
+		if !ok {
+			issues = append(issues, label+" not found in BGP table")
+			continue
+		}
+		active := -1
+		for j, path := range entry.BgpRoutePaths {
+			if path.RouteType.Active {
+				active = j
+				break
+			}
+		}
+		if active < 0 {
+			issues = append(issues, label+" has no active path")
+			continue
+		}
+		path := entry.BgpRoutePaths[active]
+
+		switch {
+		case route.Origin != "" && !strings.EqualFold(path.RouteDetail.Origin, route.Origin):
+			issues = append(issues, fmt.Sprintf("%s: origin %s, expected %s", label, strings.ToLower(path.RouteDetail.Origin), strings.ToLower(route.Origin)))
+		case route.MED != nil && (path.MED == nil || *path.MED != *route.MED):
+			issues = append(issues, fmt.Sprintf("%s: MED %s, expected %d", label, optionalInt(path.MED), *route.MED))
+		case route.LocalPref != nil && (path.LocalPref == nil || *path.LocalPref != *route.LocalPref):
+			issues = append(issues, fmt.Sprintf("%s: local preference %s, expected %d", label, optionalInt(path.LocalPref), *route.LocalPref))
+		case route.asPath != nil && !route.asPath.MatchString(path.ASPathEntry.ASPath):
+			issues = append(issues, fmt.Sprintf("%s: AS path %q does not match %q", label, path.ASPathEntry.ASPath, route.ASPathRegex))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("BGP route attribute validation failed: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d BGP routes carry the expected attributes", len(t.Routes))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyBGPRouteAttributes) ValidateInput(input any) error {
+	if len(t.Routes) == 0 {
+		return fmt.Errorf("at least one route must be specified")
+	}
+	for i, route := range t.Routes {
+		if _, _, err := net.ParseCIDR(route.Prefix); err != nil {
+			return fmt.Errorf("routes[%d]: invalid prefix %q", i, route.Prefix)
+		}
+		if _, err := bgpPrefixCmd.Render(map[string]any{"family": rib.Family(route.Prefix), "prefix": route.Prefix, "vrf": route.VRF}); err != nil {
+			return fmt.Errorf("routes[%d]: %w", i, err)
+		}
+		switch strings.ToLower(route.Origin) {
+		case "", "igp", "egp", "incomplete":
+		default:
+			return fmt.Errorf("routes[%d]: origin must be igp, egp or incomplete, got %q", i, route.Origin)
+		}
+		if route.Origin == "" && route.MED == nil && route.LocalPref == nil && route.ASPathRegex == "" {
+			return fmt.Errorf("routes[%d] (%s): at least one of origin, med, local_pref or as_path_regex must be set", i, route.Prefix)
+		}
+	}
+	return nil
+}
+
+// optionalIntInput reads an integer input where zero is a meaningful
+// value, returning nil when key is absent.
+func optionalIntInput(m map[string]any, key string) (*int, error) {
+	if _, ok := m[key]; !ok {
+		return nil, nil
+	}
+	var v int
+	if err := test.GetInt(m, key, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// optionalInt formats an attribute that EOS may omit.
+func optionalInt(v *int) string {
+	if v == nil {
+		return "not set"
+	}
+	return fmt.Sprint(*v)
+}
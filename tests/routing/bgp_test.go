@@ -0,0 +1,1604 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const ecmpRouteDetail = `{
+  "vrfs": {
+    "default": {
+      "routes": {
+        "10.255.0.0/24": {"vias": [
+          {"nexthopAddr": "10.0.0.1", "interface": "Ethernet1"},
+          {"nexthopAddr": "10.0.0.3", "interface": "Ethernet2"}
+        ]}
+      }
+    }
+  }
+}`
+
+func TestVerifyBGPRouteECMP_FewerNextHopsThanExpected(t *testing.T) {
+	tst, err := NewVerifyBGPRouteECMP(map[string]any{
+		"routes": []any{
+			map[string]any{"prefix": "10.255.0.0/24", "expected_paths": float64(4)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev := device.NewFakeDevice().OnJSON(t, "show ip route vrf default bgp detail", ecmpRouteDetail)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "expected 4 ECMP next-hops, got 2") {
+		t.Errorf("message should report actual next-hop count: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPRouteECMP_ExactWidthPassesAndReportsCount(t *testing.T) {
+	tst, _ := NewVerifyBGPRouteECMP(map[string]any{
+		"routes": []any{
+			map[string]any{"prefix": "10.255.0.0/24", "expected_paths": 2},
+		},
+	})
+	dev := device.NewFakeDevice().OnJSON(t, "show ip route vrf default bgp detail", ecmpRouteDetail)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestSuccess {
+		t.Fatalf("status = %v, want success (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "10.255.0.0/24=2") {
+		t.Errorf("success message should carry the next-hop count: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPRouteECMP_PathStatusFlagsNonECMPPath(t *testing.T) {
+	tst, _ := NewVerifyBGPRouteECMP(map[string]any{
+		"check_path_status": true,
+		"routes": []any{
+			map[string]any{"prefix": "10.255.0.0/24", "expected_paths": 2},
+		},
+	})
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show ip route vrf default bgp detail", ecmpRouteDetail).
+		OnJSON(t, "show ip bgp 10.255.0.0/24 vrf default", `{
+  "vrfs": {"default": {"bgpRouteEntries": {"10.255.0.0/24": {"bgpRoutePaths": [
+    {"nextHop": "10.0.0.1", "routeType": {"valid": true, "active": true, "ecmp": true}},
+    {"nextHop": "10.0.0.3", "routeType": {"valid": true, "active": false, "ecmp": false}}
+  ]}}}}
+}`)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "path via 10.0.0.3 valid=true ecmp=false") {
+		t.Errorf("message should name the non-ECMP path: %s", res.Message)
+	}
+}
+
+const bgpInstanceRedistOSPF = `{
+  "vrfs": {"default": {"afiSafiConfig": {"v4u": {"redistributedRoutes": [
+    {"proto": "OSPF", "routeMap": "RM-OSPF-TO-BGP"},
+    {"proto": "Static"}
+  ]}}}}
+}`
+
+// Two OSPF prefixes are in the RIB, but only one made it into BGP as a
+// locally originated path; the other is only known via a peer.
+const bgpTableRedist = `{
+  "vrfs": {"default": {"bgpRouteEntries": {
+    "10.1.0.0/24": {"bgpRoutePaths": [{"nextHop": ""}]},
+    "10.2.0.0/24": {"bgpRoutePaths": [{"nextHop": "10.0.0.1"}]},
+    "10.9.0.0/24": {"bgpRoutePaths": [{"nextHop": ""}]}
+  }}}
+}`
+
+const ribOSPF = `{
+  "vrfs": {"default": {"routes": {
+    "10.1.0.0/24": {"routeType": "OSPF"},
+    "10.2.0.0/24": {"routeType": "OSPF"}
+  }}}
+}`
+
+func TestVerifyBGPRedistribution_OSPFCountMismatch(t *testing.T) {
+	tst, err := NewVerifyBGPRedistribution(map[string]any{
+		"redistributed_routes": []any{
+			map[string]any{"source_protocol": "ospf", "expected_count": float64(2), "route_map": "RM-OSPF-TO-BGP"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show bgp instance", bgpInstanceRedistOSPF).
+		OnJSON(t, "show ip bgp vrf default", bgpTableRedist).
+		OnJSON(t, "show ip route vrf default ospf", ribOSPF)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, `"ospf" in VRF default: expected 2 redistributed routes, found 1`) {
+		t.Errorf("message should report actual vs expected: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPRedistribution_CountMatchReportsPerProtocol(t *testing.T) {
+	tst, _ := NewVerifyBGPRedistribution(map[string]any{
+		"redistributed_routes": []any{
+			map[string]any{"source_protocol": "ospf", "expected_count": 1},
+		},
+	})
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show bgp instance", bgpInstanceRedistOSPF).
+		OnJSON(t, "show ip bgp vrf default", bgpTableRedist).
+		OnJSON(t, "show ip route vrf default ospf", ribOSPF)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestSuccess {
+		t.Fatalf("status = %v, want success (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "default/ospf=1") {
+		t.Errorf("success message should carry per-protocol count: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPRedistribution_RouteMapMismatch(t *testing.T) {
+	tst, _ := NewVerifyBGPRedistribution(map[string]any{
+		"redistributed_routes": []any{
+			map[string]any{"source_protocol": "static", "route_map": "RM-STATIC"},
+		},
+	})
+	dev := device.NewFakeDevice().OnJSON(t, "show bgp instance", bgpInstanceRedistOSPF)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "expected route-map RM-STATIC") {
+		t.Errorf("message should name the expected route-map: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPExchangedRoutes_PresentButInactive(t *testing.T) {
+	inputs := map[string]any{
+		"check_active": true,
+		"bgp_peers": []any{
+			map[string]any{
+				"peer_address":    "172.30.255.5",
+				"received_routes": []any{"192.0.255.4/32", "192.0.255.9/32", "192.0.255.10/32"},
+			},
+		},
+	}
+	received := `{
+  "vrfs": {"default": {"bgpRouteEntries": {
+    "192.0.255.4/32": {"bgpRoutePaths": [{"routeType": {"valid": true, "active": true}}]},
+    "192.0.255.9/32": {"bgpRoutePaths": [{"routeType": {"valid": true, "active": false}}]}
+  }}}
+}`
+	tst, err := NewVerifyBGPExchangedRoutes(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors 172.30.255.5 received-routes vrf default", received)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "Route 192.0.255.9/32 received from peer 172.30.255.5 is present but not in the expected state: valid=true, active=false") {
+		t.Errorf("inactive route should be reported as present-but-inactive: %s", res.Message)
+	}
+	if !strings.Contains(res.Message, "Route 192.0.255.10/32 not received from peer 172.30.255.5") {
+		t.Errorf("missing route should be reported separately: %s", res.Message)
+	}
+	if strings.Contains(res.Message, "192.0.255.4/32") {
+		t.Errorf("active route should not be reported: %s", res.Message)
+	}
+
+	// Without check_active the inactive-but-valid route is acceptable.
+	delete(inputs, "check_active")
+	tst, _ = NewVerifyBGPExchangedRoutes(inputs)
+	res = test.RunWithFake(t, tst, dev)
+	if strings.Contains(res.Message, "192.0.255.9/32") {
+		t.Errorf("valid route should pass when check_active is off: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPExchangedRoutes_ValidateInputRejectsUnsafeVRF(t *testing.T) {
+	tst, _ := NewVerifyBGPExchangedRoutes(map[string]any{"bgp_peers": []any{
+		map[string]any{"peer_address": "172.30.255.5", "vrf": "PROD | json", "received_routes": []any{"10.0.0.0/8"}},
+	}})
+	err := tst.ValidateInput(nil)
+	if err == nil || !strings.Contains(err.Error(), `bgp_peers[0]: template "show bgp neighbors {peer} {direction}-routes vrf {vrf}": parameter "vrf"`) {
+		t.Errorf("ValidateInput = %v, want unsafe vrf rejected", err)
+	}
+}
+
+func bgpSummaryWithPeers(t *testing.T, peers string) *device.FakeDevice {
+	t.Helper()
+	return device.NewFakeDevice().OnJSON(t, "show bgp summary", `{"vrfs": {"default": {"peers": {`+peers+`}}}}`)
+}
+
+func TestVerifyBGPPeerSession_RecentlyFlappedPeer(t *testing.T) {
+	tst, err := NewVerifyBGPPeerSession(map[string]any{
+		"minimum_established_time": 10000,
+		"bgp_peers":                []any{map[string]any{"peer_address": "10.1.0.1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// upDownTime is the timestamp of the last transition: 2 minutes ago.
+	flapped := fmt.Sprintf(`"10.1.0.1": {"peerState": "Established", "upDownTime": %d, "inMsgQueue": 0, "outMsgQueue": 0}`,
+		time.Now().Add(-2*time.Minute).Unix())
+	res := test.RunWithFake(t, tst, bgpSummaryWithPeers(t, flapped))
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "established for only") || !strings.Contains(res.Message, "(minimum: 10000)") {
+		t.Errorf("message should report the short uptime: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPPeerSession_NonzeroQueue(t *testing.T) {
+	peers := `"10.1.0.1": {"peerState": "Established", "upDownTime": 1, "inMsgQueue": 0, "outMsgQueue": 3}`
+	tst, _ := NewVerifyBGPPeerSession(map[string]any{
+		"bgp_peers": []any{map[string]any{"peer_address": "10.1.0.1"}},
+	})
+	res := test.RunWithFake(t, tst, bgpSummaryWithPeers(t, peers))
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "has 3 messages in output queue") {
+		t.Errorf("message should report the queue depth: %s", res.Message)
+	}
+
+	tst, _ = NewVerifyBGPPeerSession(map[string]any{
+		"check_tcp_queues": false,
+		"bgp_peers":        []any{map[string]any{"peer_address": "10.1.0.1"}},
+	})
+	res = test.RunWithFake(t, tst, bgpSummaryWithPeers(t, peers))
+	if res.Status != test.TestSuccess {
+		t.Errorf("queues should be ignored with check_tcp_queues=false: %v (%s)", res.Status, res.Message)
+	}
+}
+
+func TestNormalizeBgpMPCapability(t *testing.T) {
+	cases := map[string]string{
+		"evpn":          "l2VpnEvpn",
+		"l2vpn-evpn":    "l2VpnEvpn",
+		"ipv4 unicast":  "ipv4Unicast",
+		"IPv6_Unicast":  "ipv6Unicast",
+		"ipv4Unicast":   "ipv4Unicast",
+		"vpn-ipv4":      "ipv4MplsVpn",
+		"someFutureCap": "someFutureCap",
+	}
+	for in, want := range cases {
+		if got := normalizeBgpMPCapability(in); got != want {
+			t.Errorf("normalizeBgpMPCapability(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestVerifyBGPPeerMPCaps_EvpnMapsToL2VpnEvpn(t *testing.T) {
+	tst, _ := NewVerifyBGPPeerMPCaps(map[string]any{
+		"bgp_peers": []any{
+			map[string]any{"peer_address": "10.0.0.1", "capabilities": []any{"evpn", "ipv4 unicast"}},
+		},
+	})
+	dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors 10.0.0.1 vrf default", `{
+  "vrfs": {"default": {"peerList": [{"peerAddress": "10.0.0.1", "neighborCapabilities": {"multiprotocolCaps": {
+    "l2VpnEvpn":   {"advertised": true, "received": true, "enabled": true},
+    "ipv4Unicast": {"advertised": true, "received": false, "enabled": false}
+  }}}]}}
+}`)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if strings.Contains(res.Message, "l2VpnEvpn") {
+		t.Errorf("evpn should resolve to the negotiated l2VpnEvpn capability: %s", res.Message)
+	}
+	if !strings.Contains(res.Message, "capability ipv4Unicast not negotiated: advertised=true, received=false") {
+		t.Errorf("one-sided capability should fail with the normalized key: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPAdvCommunities_LargeRequestedButDisabled(t *testing.T) {
+	tst, _ := NewVerifyBGPAdvCommunities(map[string]any{
+		"bgp_peers": []any{
+			map[string]any{"peer_address": "10.1.0.1", "advertised_communities": []any{"standard", "large"}},
+		},
+	})
+	dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors 10.1.0.1 vrf default", `{
+  "vrfs": {"default": {"peerList": [{"peerAddress": "10.1.0.1",
+    "advertisedCommunities": {"standard": true, "extended": true, "large": false}}]}}
+}`)
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "not sending large communities") {
+		t.Errorf("message should name the missing community type: %s", res.Message)
+	}
+	if strings.Contains(res.Message, "standard") {
+		t.Errorf("enabled standard community should not be reported: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPAdvCommunities_UnknownTypeNamesInterfacePeer(t *testing.T) {
+	tst, err := NewVerifyBGPAdvCommunities(map[string]any{
+		"bgp_peers": []any{
+			map[string]any{"interface": "Ethernet4", "advertised_communities": []any{"regular"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `bgp_peers[0] (interface Ethernet4): unknown community type "regular"`
+	if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("ValidateInput = %v, want %q", err, want)
+	}
+}
+
+// Interface-based (RFC5549) peers have no address to put in the
+// command, so the neighbor-detail tests fetch the whole VRF and pick
+// the entry by interface.
+const bgpNeighborsUnnumbered = `{
+  "vrfs": {"default": {"peerList": [
+    {"peerAddress": "10.0.0.9", "ifName": "Ethernet9"},
+    {"peerAddress": "fe80::1%Ethernet1", "ifName": "Ethernet1",
+     "neighborCapabilities": {"multiprotocolCaps": {"ipv4Unicast": {"advertised": true, "received": true}}},
+     "advertisedCommunities": {"standard": true, "extended": false, "large": false},
+     "dropStats": {"inDropAsloop": 4},
+     "updateErrorInfo": {"inUpdErrWithdraw": 0}}
+  ]}}
+}`
+
+func TestNeighborDetailTests_InterfacePeer(t *testing.T) {
+	withField := func(k string, v any) map[string]any {
+		return map[string]any{"bgp_peers": []any{map[string]any{"interface": "Ethernet1", k: v}}}
+	}
+
+	cases := []struct {
+		name    string
+		factory func(map[string]any) (test.Test, error)
+		inputs  map[string]any
+		status  test.TestStatus
+		want    string
+	}{
+		{"mpcaps", NewVerifyBGPPeerMPCaps, withField("capabilities", []any{"ipv4 unicast"}), test.TestSuccess, ""},
+		{"communities", NewVerifyBGPAdvCommunities, withField("advertised_communities", []any{"extended"}), test.TestFailure,
+			"Peer interface Ethernet1 in VRF default not sending extended communities"},
+		{"dropstats", NewVerifyBGPPeerDropStats, withField("drop_stats", map[string]any{"inDropAsloop": 0}), test.TestFailure,
+			"Peer interface Ethernet1: expected inDropAsloop=0, got 4"},
+		{"updateerrors", NewVerifyBGPPeerUpdateErrors, withField("update_errors", map[string]any{"inUpdErrWithdraw": 0}), test.TestSuccess, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := tc.factory(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf default", bgpNeighborsUnnumbered)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if tc.want != "" && !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestFindBgpNeighbor_IPv6AddressForms(t *testing.T) {
+	out := device.DecodeJSON(t, `{"vrfs": {"default": {"peerList": [{"peerAddress": "fd00:dc:1::1"}]}}}`)
+	if _, ok := findBgpNeighbor(out, "default", BgpPeerExtended{PeerAddress: "fd00:dc:1:0:0:0:0:1"}); !ok {
+		t.Error("expanded IPv6 form should match the compressed peerAddress")
+	}
+	if _, ok := findBgpNeighbor(out, "default", BgpPeerExtended{PeerAddress: "fd00:dc:1::2"}); ok {
+		t.Error("different address must not match")
+	}
+}
+
+func TestFindBgpNeighbor_NoIdentityMatchesNothing(t *testing.T) {
+	out := device.DecodeJSON(t, `{"vrfs": {"default": {"peerList": [
+  {"peerAddress": "10.0.0.1"},
+  {"peerAddress": "fe80::1%Ethernet4", "ifName": "Ethernet4"}
+]}}}`)
+	if entry, ok := findBgpNeighbor(out, "default", BgpPeerExtended{}); ok {
+		t.Errorf("peer with no address or interface matched %v", entry)
+	}
+	if _, ok := findBgpNeighbor(out, "default", BgpPeerExtended{Interface: "Ethernet4"}); !ok {
+		t.Error("interface peer should match on ifName")
+	}
+}
+
+const bgpNeighborUpdateErrors = `{
+  "vrfs": {"default": {"peerList": [
+    {"peerAddress": "172.30.11.1",
+     "updateErrorInfo": {"inUpdErrWithdraw": 2, "inUpdErrIgnore": 0, "inUpdErrDisableAfiSafi": 0, "disabledAfiSafi": "ipv4Unicast"}}
+  ]}}
+}`
+
+func TestVerifyBGPPeerUpdateErrors_InputForms(t *testing.T) {
+	peer := func(updateErrors any) map[string]any {
+		p := map[string]any{"peer_address": "172.30.11.1"}
+		if updateErrors != nil {
+			p["update_errors"] = updateErrors
+		}
+		return map[string]any{"bgp_peers": []any{p}}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   []string
+		absent []string
+	}{
+		{"list", peer([]any{"inUpdErrIgnore", "disabledAfiSafi"}), test.TestFailure,
+			[]string{"expected disabledAfiSafi=None, got ipv4Unicast"}, []string{"inUpdErrWithdraw"}},
+		{"map", peer(map[string]any{"inUpdErrWithdraw": 2, "inUpdErrIgnore": 0}), test.TestSuccess, nil, nil},
+		{"list of maps", peer([]any{map[string]any{"inUpdErrWithdraw": 2}, "inUpdErrIgnore"}), test.TestSuccess, nil, nil},
+		{"map mismatch", peer(map[string]any{"inUpdErrWithdraw": 0}), test.TestFailure,
+			[]string{"expected inUpdErrWithdraw=0, got 2"}, nil},
+		{"all counters", peer(nil), test.TestFailure,
+			[]string{"inUpdErrWithdraw=0, got 2", "disabledAfiSafi=None, got ipv4Unicast"}, []string{"inUpdErrIgnore"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPPeerUpdateErrors(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors 172.30.11.1 vrf default", bgpNeighborUpdateErrors)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(res.Message, w) {
+					t.Errorf("message %q should contain %q", res.Message, w)
+				}
+			}
+			for _, a := range tc.absent {
+				if strings.Contains(res.Message, a) {
+					t.Errorf("message %q should not mention %q", res.Message, a)
+				}
+			}
+		})
+	}
+}
+
+func TestParseBgpUpdateErrors_RejectsBadListItem(t *testing.T) {
+	if _, err := parseBgpUpdateErrors([]any{"inUpdErrWithdraw", 3.0}); err == nil {
+		t.Error("expected error for non-string list item")
+	}
+}
+
+const bgpNeighborDropStats = `{
+  "vrfs": {"default": {"peerList": [
+    {"peerAddress": "172.30.11.1",
+     "dropStats": {"inDropAsloop": 3, "inDropOrigId": 0, "prefixEvpnDroppedUnsupportedRouteType": 0, "inDropNhLocal": 1}}
+  ]}}
+}`
+
+func TestVerifyBGPPeerDropStats_InputForms(t *testing.T) {
+	peer := func(dropStats any) map[string]any {
+		p := map[string]any{"peer_address": "172.30.11.1"}
+		if dropStats != nil {
+			p["drop_stats"] = dropStats
+		}
+		return map[string]any{"bgp_peers": []any{p}}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   []string
+		absent []string
+	}{
+		{"list clean", peer([]any{"inDropOrigId", "prefixEvpnDroppedUnsupportedRouteType"}), test.TestSuccess, nil, nil},
+		{"list nonzero", peer([]any{"inDropAsloop", "inDropOrigId"}), test.TestFailure,
+			[]string{"expected inDropAsloop=0, got 3"}, []string{"inDropNhLocal"}},
+		{"map", peer(map[string]any{"inDropAsloop": 3.0}), test.TestSuccess, nil, nil},
+		{"check all", peer(nil), test.TestFailure,
+			[]string{"expected inDropAsloop=0, got 3", "expected inDropNhLocal=0, got 1"}, []string{"inDropOrigId"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPPeerDropStats(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors 172.30.11.1 vrf default", bgpNeighborDropStats)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(res.Message, w) {
+					t.Errorf("message %q should contain %q", res.Message, w)
+				}
+			}
+			for _, a := range tc.absent {
+				if strings.Contains(res.Message, a) {
+					t.Errorf("message %q should not mention %q", res.Message, a)
+				}
+			}
+		})
+	}
+}
+
+const bgpNeighborsAllVRFs = `{
+  "vrfs": {
+    "default": {"peerList": [
+      {"peerAddress": "10.0.0.1", "md5AuthEnabled": true,
+       "neighborCapabilities": {"fourOctetAsnCap": {"advertised": true, "received": true, "enabled": true},
+                                "routeRefreshCap": {"advertised": true, "received": true, "enabled": true}}}
+    ]},
+    "PROD": {"peerList": [
+      {"peerAddress": "172.16.1.1", "md5AuthEnabled": false,
+       "neighborCapabilities": {"fourOctetAsnCap": {"advertised": true, "received": true, "enabled": true},
+                                "routeRefreshCap": {"advertised": true, "received": false, "enabled": false}}}
+    ]}
+  }
+}`
+
+func TestBGPNeighborCapabilityTests_EnumerateAllPeers(t *testing.T) {
+	cases := []struct {
+		name    string
+		factory func(map[string]any) (test.Test, error)
+		status  test.TestStatus
+		want    string
+	}{
+		{"asn", NewVerifyBGPPeerASNCap, test.TestSuccess, "(2 peers)"},
+		{"route refresh", NewVerifyBGPPeerRouteRefreshCap, test.TestFailure,
+			"Peer 172.16.1.1 in VRF PROD missing route refresh capability: advertised=true, received=false"},
+		{"md5", NewVerifyBGPPeerMD5Auth, test.TestFailure,
+			"Peer 172.16.1.1 in VRF PROD does not have MD5 authentication enabled"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := tc.factory(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", bgpNeighborsAllVRFs)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestBGPNeighborCapabilityTests_ExplicitPeers(t *testing.T) {
+	inputs := map[string]any{"bgp_peers": []any{
+		map[string]any{"peer_address": "10.0.0.1"},
+		map[string]any{"peer_address": "10.9.9.9", "vrf": "PROD"},
+	}}
+	tst, err := NewVerifyBGPPeerMD5Auth(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", bgpNeighborsAllVRFs)
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "BGP peer 10.9.9.9 not found in VRF PROD") {
+		t.Errorf("missing not-found issue: %s", res.Message)
+	}
+	if strings.Contains(res.Message, "172.16.1.1") {
+		t.Errorf("unlisted peers must not be checked with an explicit list: %s", res.Message)
+	}
+}
+
+// TestBGPNeighborCapabilityTests_InterfacePeer checks that an interface
+// peer absent from the device fails instead of matching some other peer,
+// and that a peer entry naming neither address nor interface is rejected.
+func TestBGPNeighborCapabilityTests_InterfacePeer(t *testing.T) {
+	factories := map[string]func(map[string]any) (test.Test, error){
+		"asn":           NewVerifyBGPPeerASNCap,
+		"route refresh": NewVerifyBGPPeerRouteRefreshCap,
+		"md5":           NewVerifyBGPPeerMD5Auth,
+	}
+	for name, factory := range factories {
+		t.Run(name, func(t *testing.T) {
+			tst, err := factory(map[string]any{"bgp_peers": []any{map[string]any{"interface": "Ethernet9"}}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", bgpNeighborsAllVRFs)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != test.TestFailure || !strings.Contains(res.Message, "BGP peer interface Ethernet9 not found in VRF default") {
+				t.Errorf("got %v %q, want the missing interface peer reported", res.Status, res.Message)
+			}
+
+			tst, err = factory(map[string]any{"bgp_peers": []any{map[string]any{"peer_addres": "10.0.0.1"}}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), "one of peer_address or interface is required") {
+				t.Errorf("ValidateInput = %v, want the peer without an identity rejected", err)
+			}
+		})
+	}
+}
+
+func TestBGPNeighborCapabilityTests_NoPeers(t *testing.T) {
+	tst, _ := NewVerifyBGPPeerASNCap(nil)
+	dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", `{"vrfs": {}}`)
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, "No BGP peers found") {
+		t.Errorf("got %v %q, want failure for an empty neighbor table", res.Status, res.Message)
+	}
+}
+
+func TestVerifyBGPGracefulRestart(t *testing.T) {
+	const neighbors = `{
+  "vrfs": {"default": {"peerList": [
+    {"peerAddress": "10.0.0.1",
+     "neighborCapabilities": {"gracefulRestartCap": {"advertised": true, "received": true, "restartTime": 300},
+                              "gracefulRestartHelperCap": {"advertised": true, "received": true}}},
+    {"peerAddress": "10.0.0.2",
+     "neighborCapabilities": {"gracefulRestartCap": {"advertised": true, "received": false},
+                              "gracefulRestartHelperCap": {"advertised": true, "received": true}}},
+    {"peerAddress": "10.0.0.3",
+     "neighborCapabilities": {"gracefulRestartCap": {"advertised": true, "received": true, "restartTime": 60},
+                              "gracefulRestartHelperCap": {"advertised": true, "received": true}}}
+  ]}}
+}`
+	peer := func(addr string) map[string]any { return map[string]any{"peer_address": addr} }
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"negotiated", map[string]any{"bgp_peers": []any{peer("10.0.0.1")}, "minimum_restart_time": 120},
+			test.TestSuccess, "(1 peers)"},
+		{"not negotiated", map[string]any{"bgp_peers": []any{peer("10.0.0.2")}}, test.TestFailure,
+			"Peer 10.0.0.2 in VRF default missing graceful-restart capability: advertised=true, received=false"},
+		{"short restart time", map[string]any{"bgp_peers": []any{peer("10.0.0.3")}, "minimum_restart_time": 120},
+			test.TestFailure, "restart time 60s is below minimum 120s"},
+		{"all peers", nil, test.TestFailure, "Peer 10.0.0.2"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPGracefulRestart(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", neighbors)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyBGPGracefulRestartPeerCount(t *testing.T) {
+	tst, _ := NewVerifyBGPGracefulRestart(nil)
+	for _, tc := range []struct {
+		output string
+		want   int
+	}{
+		{`{"vrfs": {}}`, 0},
+		{`{"vrfs": {"default": {"peerList": [{"peerAddress": "10.0.0.1"}, {"peerAddress": "10.0.0.2"}]}}}`, 2},
+	} {
+		res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", tc.output))
+		if got, _ := res.Details["peer_count"].(int); got != tc.want {
+			t.Errorf("peer_count = %v, want %d (%s)", res.Details["peer_count"], tc.want, res.Message)
+		}
+	}
+}
+
+func TestVerifyBGPPeerFlapCount(t *testing.T) {
+	const neighbors = `{
+  "vrfs": {"default": {"peerList": [
+    {"peerAddress": "10.0.0.1", "state": "Established", "establishedTransitions": 1, "establishedTime": 86400},
+    {"peerAddress": "10.0.0.2", "state": "Established", "establishedTransitions": 12, "establishedTime": 90},
+    {"peerAddress": "10.0.0.3", "state": "Active", "establishedTransitions": 4}
+  ]}}
+}`
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   []string
+	}{
+		{"stable peer", map[string]any{"max_flaps": 0, "bgp_peers": []any{map[string]any{"peer_address": "10.0.0.1"}}},
+			test.TestSuccess, []string{"(1 peers)"}},
+		{"high flap peer", map[string]any{"max_flaps": 3}, test.TestFailure, []string{
+			"Peer 10.0.0.2 in VRF default flapped 11 times (maximum: 3), up 90 seconds",
+			"Peer 10.0.0.3 in VRF default flapped 4 times (maximum: 3), down",
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPPeerFlapCount(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", neighbors)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(res.Message, w) {
+					t.Errorf("message %q should contain %q", res.Message, w)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyBGPPeerFlapCount_RequiresMaxFlaps(t *testing.T) {
+	tst, _ := NewVerifyBGPPeerFlapCount(map[string]any{})
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected error when max_flaps is missing")
+	}
+}
+
+func TestVerifyBGPVrfAllPeersEstablished(t *testing.T) {
+	const summary = `{"vrfs": {
+  "default": {"peers": {"10.0.0.1": {"peerState": "Established"}, "10.0.0.2": {"peerState": "Established"}}},
+  "PROD": {"peers": {"172.16.0.1": {"peerState": "Established"}, "172.16.0.2": {"peerState": "Idle"}}}
+}}`
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"healthy vrf", map[string]any{"vrfs": []any{"default"}}, test.TestSuccess, "All 2 BGP peers Established across 1 VRFs"},
+		{"idle peer", map[string]any{"vrfs": []any{"default", "PROD"}}, test.TestFailure, "Peer 172.16.0.2 in VRF PROD is Idle"},
+		{"all vrfs", nil, test.TestFailure, "Peer 172.16.0.2 in VRF PROD is Idle"},
+		{"missing vrf", map[string]any{"vrfs": []any{"MGMT"}}, test.TestFailure, "VRF MGMT not found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPVrfAllPeersEstablished(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp summary vrf all", summary)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyBGPRoutePaths_IPv6(t *testing.T) {
+	dev := device.NewFakeDevice().OnJSON(t, "show ipv6 bgp vrf all", `{"vrfs": {"default": {"bgpRouteEntries": {
+  "2001:db8::/32": {"bgpRoutePaths": [{"nextHop": "fe80::1"}, {"nextHop": "fe80::2"}]}
+}}}}`)
+
+	tst, err := NewVerifyBGPRoutePaths(map[string]any{
+		"address_family": "ipv6",
+		"routes": []any{
+			map[string]any{"prefix": "2001:0db8:0::/32", "expected_paths": 2},
+			map[string]any{"prefix": "2001:db8:ffff::/48"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "BGP ipv6 route paths validation failed") ||
+		!strings.Contains(res.Message, "BGP route 2001:db8:ffff::/48 not found in VRF default") {
+		t.Errorf("unexpected message: %s", res.Message)
+	}
+	if strings.Contains(res.Message, "2001:0db8:0::/32") {
+		t.Errorf("expanded form of a present prefix should match: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPRoutePaths_MixedFamilies(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show ip bgp vrf all", `{"vrfs": {"default": {"bgpRouteEntries": {
+  "10.0.0.0/8": {"bgpRoutePaths": [{"nextHop": "10.1.1.1"}]}
+}}}}`).
+		OnJSON(t, "show ipv6 bgp vrf all", `{"vrfs": {"default": {"bgpRouteEntries": {
+  "2001:db8::/32": {"bgpRoutePaths": [{"nextHop": "fe80::1"}, {"nextHop": "fe80::2"}]}
+}}}}`)
+
+	tst, err := NewVerifyBGPRoutePaths(map[string]any{
+		"routes": []any{
+			map[string]any{"prefix": "10.0.0.0/8", "expected_paths": 1},
+			map[string]any{"prefix": "2001:db8::/32", "expected_paths": 2},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestSuccess {
+		t.Fatalf("status = %v, want success (%s)", res.Status, res.Message)
+	}
+	if res.Message != "All BGP route paths verified (2 routes)" {
+		t.Errorf("unexpected message: %s", res.Message)
+	}
+}
+
+func TestVerifyBGPRouteECMP_IPv6(t *testing.T) {
+	dev := device.NewFakeDevice().OnJSON(t, "show ipv6 route vrf default bgp detail", `{"vrfs": {"default": {"routes": {
+  "2001:db8:1::/48": {"vias": [{"nexthopAddr": "fe80::1"}, {"nexthopAddr": "fe80::2"}]}
+}}}}`)
+
+	tst, _ := NewVerifyBGPRouteECMP(map[string]any{
+		"address_family": "ipv6",
+		"routes":         []any{map[string]any{"prefix": "2001:db8:1::/48", "expected_paths": 2}},
+	})
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestSuccess {
+		t.Fatalf("status = %v, want success (%s)", res.Status, res.Message)
+	}
+	if !strings.Contains(res.Message, "All BGP ipv6 ECMP routes verified") {
+		t.Errorf("message should name the address family: %s", res.Message)
+	}
+}
+
+func TestValidateBgpRouteFamily(t *testing.T) {
+	v4 := []BgpRoute{{Prefix: "10.0.0.0/8"}}
+	if err := validateBgpRouteFamily("ipv6", v4); err == nil {
+		t.Error("IPv4 prefix with address_family ipv6 should be rejected")
+	}
+	if err := validateBgpRouteFamily("ipv4", v4); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validateBgpRouteFamily("", append(v4, BgpRoute{Prefix: "2001:db8::/32"})); err != nil {
+		t.Errorf("mixed families without address_family: unexpected error: %v", err)
+	}
+	if err := validateBgpRouteFamily("ipv5", v4); err == nil {
+		t.Error("unknown address family should be rejected")
+	}
+}
+
+func TestBGPPeerInputSchemas(t *testing.T) {
+	cases := []struct {
+		name   string
+		ctor   func(map[string]any) (test.Test, error)
+		inputs string
+		want   string
+	}{
+		{"peers sample", NewVerifyBGPPeers, `{"peers": [{"peer": "10.0.0.1", "state": "Established", "asn": 65001, "vrf": "default"}]}`, ""},
+		{"peers missing peer", NewVerifyBGPPeers, `{"peers": [{"state": "Established"}]}`, "peers[0].peer: required"},
+		{"peers empty", NewVerifyBGPPeers, `{"peers": []}`, "peers: expected at least 1 item(s)"},
+		{"peers address family", NewVerifyBGPPeers, `{"peers": [{"peer": "10.0.0.1", "address_family": {"afi": "evpn"}}]}`, ""},
+		{"peers address family without afi", NewVerifyBGPPeers, `{"peers": [{"peer": "10.0.0.1", "address_family": {"safi": "unicast"}}]}`, "peers[0].address_family.afi: required"},
+		{"peers asn as asdot string", NewVerifyBGPPeers, `{"peers": [{"peer": "10.0.0.1", "asn": "65000.1"}]}`, ""},
+		{"peers asn as bool", NewVerifyBGPPeers, `{"peers": [{"peer": "10.0.0.1", "asn": true}]}`, "peers[0].asn: expected integer, got bool"},
+		{"session sample", NewVerifyBGPPeerSession, `{"bgp_peers": [{"peer_address": "10.0.0.1", "vrf": "default"}, {"interface": "Ethernet1"}]}`, ""},
+		{"session nested typo", NewVerifyBGPPeerSession, `{"bgp_peers": [{"peer_adress": "10.0.0.1"}]}`, "bgp_peers[0].peer_adress: unknown key"},
+		{"session no identity", NewVerifyBGPPeerSession, `{"bgp_peers": [{"vrf": "default"}]}`, "bgp_peers[0].peer_address: required or bgp_peers[0].interface: required"},
+		{"timers sample", NewVerifyBGPTimers, `{"bgp_peers": [{"peer_address": "10.0.0.1", "hold_time": 180, "keep_alive_time": 60}]}`, ""},
+		{"timers wrong type", NewVerifyBGPTimers, `{"bgp_peers": [{"peer_address": "10.0.0.1", "hold_time": "180"}]}`, "bgp_peers[0].hold_time: expected integer, got string"},
+		{"drop stats values", NewVerifyBGPPeerDropStats, `{"bgp_peers": [{"peer_address": "10.0.0.1", "drop_stats": {"inDropAsloop": "x"}}]}`, "bgp_peers[0].drop_stats.inDropAsloop: expected integer"},
+		{"drop stats name list", NewVerifyBGPPeerDropStats, `{"bgp_peers": [{"peer_address": "10.0.0.1", "drop_stats": ["inDropAsloop", "inDropOrigId"]}]}`, ""},
+		{"drop stats list of maps", NewVerifyBGPPeerDropStats, `{"bgp_peers": [{"peer_address": "10.0.0.1", "drop_stats": [{"inDropAsloop": 0}, "inDropOrigId"]}]}`, ""},
+		{"drop stats list wrong value", NewVerifyBGPPeerDropStats, `{"bgp_peers": [{"peer_address": "10.0.0.1", "drop_stats": [{"inDropAsloop": "x"}]}]}`, "bgp_peers[0].drop_stats[0].inDropAsloop: expected integer"},
+		{"update errors name list", NewVerifyBGPPeerUpdateErrors, `{"bgp_peers": [{"peer_address": "10.0.0.1", "update_errors": ["inUpdErrWithdraw", {"disabledAfiSafi": "None"}]}]}`, ""},
+		{"update errors map", NewVerifyBGPPeerUpdateErrors, `{"bgp_peers": [{"peer_address": "10.0.0.1", "update_errors": {"inUpdErrWithdraw": 0}}]}`, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := tc.ctor(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			provider, ok := tst.(test.InputSchemaProvider)
+			if !ok {
+				t.Fatalf("%s has no input schema", tst.Name())
+			}
+			err = provider.InputSchema().Validate(device.DecodeJSON(t, tc.inputs))
+			if tc.want == "" {
+				if err != nil {
+					t.Fatalf("sample input rejected: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Fatalf("err = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestBGPTimersAcceptsCamelCaseInputs(t *testing.T) {
+	probe, _ := NewVerifyBGPTimers(nil)
+	// Shaped as yaml.v3 decodes a catalog, with int numbers.
+	inputs, err := test.NormalizeInputs(map[string]any{
+		"bgpPeers": []any{map[string]any{"peerAddress": "10.0.0.1", "vrf": "blue", "holdTime": 180, "keepAliveTime": 60}},
+	}, probe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tst, err := NewVerifyBGPTimers(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	peers := tst.(*VerifyBGPTimers).BGPPeers
+	if len(peers) != 1 {
+		t.Fatalf("got %d peers, want 1", len(peers))
+	}
+	if p := peers[0]; p.PeerAddress != "10.0.0.1" || p.VRF != "blue" || p.HoldTime != 180 || p.KeepAliveTime != 60 {
+		t.Errorf("peer = %+v", p)
+	}
+	if err := test.ValidateInputKeys(inputs, tst); err != nil {
+		t.Errorf("normalized keys rejected: %v", err)
+	}
+}
+
+func TestVerifyBGPPeersAddressFamily(t *testing.T) {
+	const ipv4Summary = `{"vrfs": {"default": {"peers": {
+		"10.0.0.1": {"peerState": "Established", "asn": "65001"},
+		"10.0.0.2": {"peerState": "Established", "asn": "65002"}}}}}`
+	const evpnSummary = `{"vrfs": {"default": {"peers": {
+		"10.0.0.1": {"peerState": "Idle", "asn": "65001"},
+		"10.0.0.2": {"peerState": "Established", "asn": "65002"}}}}}`
+	peer := func(addr string, af map[string]any) map[string]any {
+		p := map[string]any{"peer": addr}
+		if af != nil {
+			p["address_family"] = af
+		}
+		return p
+	}
+	ipv4 := map[string]any{"afi": "ipv4", "safi": "unicast"}
+	evpn := map[string]any{"afi": "evpn"}
+
+	cases := []struct {
+		name   string
+		peers  []any
+		status test.TestStatus
+		want   []string
+		calls  []string
+	}{
+		{"unscoped uses show bgp summary", []any{peer("10.0.0.1", nil)}, test.TestSuccess, nil,
+			[]string{"show bgp summary"}},
+		{"established for ipv4", []any{peer("10.0.0.1", ipv4)}, test.TestSuccess, nil,
+			[]string{"show bgp ipv4 unicast summary"}},
+		{"down for evpn", []any{peer("10.0.0.1", ipv4), peer("10.0.0.1", evpn)}, test.TestFailure,
+			[]string{"Peer 10.0.0.1 (evpn): expected state Established, got Idle"},
+			[]string{"show bgp ipv4 unicast summary", "show bgp evpn summary"}},
+		{"summary fetched once per family", []any{peer("10.0.0.1", ipv4), peer("10.0.0.2", evpn), peer("10.0.0.2", ipv4)}, test.TestSuccess, nil,
+			[]string{"show bgp ipv4 unicast summary", "show bgp evpn summary"}},
+		{"missing in family", []any{peer("10.0.0.3", evpn)}, test.TestFailure,
+			[]string{"Peer 10.0.0.3 not found in VRF default (evpn)"}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPPeers(map[string]any{"peers": tc.peers})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show bgp summary", ipv4Summary).
+				OnJSON(t, "show bgp ipv4 unicast summary", ipv4Summary).
+				OnJSON(t, "show bgp evpn summary", evpnSummary)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			for _, w := range tc.want {
+				if !strings.Contains(res.Message, w) {
+					t.Errorf("message %q should contain %q", res.Message, w)
+				}
+			}
+			if tc.calls != nil && strings.Join(dev.Calls(), ",") != strings.Join(tc.calls, ",") {
+				t.Errorf("calls = %v, want %v", dev.Calls(), tc.calls)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyBGPPeers(map[string]any{"peers": []any{peer("10.0.0.1", ipv4), peer("10.0.0.1", evpn)}})
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show bgp ipv4 unicast summary", ipv4Summary).
+		OnJSON(t, "show bgp evpn summary", evpnSummary)
+	res := test.RunWithFake(t, tst, dev)
+	got := fmt.Sprint(res.Details["evaluated"])
+	if want := "[10.0.0.1 vrf default: ipv4 unicast 10.0.0.1 vrf default: evpn]"; got != want {
+		t.Errorf("evaluated = %s, want %s", got, want)
+	}
+
+	tst, _ = NewVerifyBGPPeers(map[string]any{"peers": []any{peer("10.0.0.1", map[string]any{"safi": "unicast"})}})
+	if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), "address_family requires afi") {
+		t.Errorf("ValidateInput = %v, want afi required", err)
+	}
+
+	_, err := NewVerifyBGPPeers(map[string]any{"peers": []any{peer("10.0.0.1", map[string]any{"afi": 4})}})
+	if err == nil || !strings.Contains(err.Error(), "peers[0]: afi: expected string") {
+		t.Errorf("non-string afi: err = %v, want peers[0]: afi: expected string", err)
+	}
+}
+
+func TestParseASN(t *testing.T) {
+	cases := []struct {
+		in      any
+		want    uint32
+		wantErr bool
+	}{
+		{65001, 65001, false},
+		{float64(4200000000), 4200000000, false},
+		{"4200000000", 4200000000, false},
+		{"65000.1", 65000<<16 | 1, false},
+		{"1.0", 65536, false},
+		{" 65001 ", 65001, false},
+		{"65536.1", 0, true},
+		{"4294967296", 0, true},
+		{-1, 0, true},
+		{1.5, 0, true},
+		{"AS65001", 0, true},
+		{true, 0, true},
+	}
+	for _, tc := range cases {
+		got, err := parseASN(tc.in)
+		if (err != nil) != tc.wantErr || got != tc.want {
+			t.Errorf("parseASN(%#v) = %d, %v; want %d, err=%v", tc.in, got, err, tc.want, tc.wantErr)
+		}
+	}
+}
+
+func TestVerifyBGPPeersASNNotations(t *testing.T) {
+	const summary = `{"vrfs": {"default": {"peers": {
+		"10.0.0.1": {"peerState": "Established", "asn": "4200000000"},
+		"10.0.0.2": {"peerState": "Established", "asn": "4259840001"},
+		"10.0.0.3": {"peerState": "Established", "asn": 65003}}}}}`
+	cases := []struct {
+		name   string
+		peer   string
+		asn    any
+		status test.TestStatus
+		want   string
+	}{
+		{"large asplain string", "10.0.0.1", "4200000000", test.TestSuccess, ""},
+		{"large asplain number", "10.0.0.1", 4200000000, test.TestSuccess, ""},
+		{"asdot", "10.0.0.2", "65000.1", test.TestSuccess, ""},
+		{"numeric device output", "10.0.0.3", 65003, test.TestSuccess, ""},
+		{"asdot mismatch", "10.0.0.1", "65000.1", test.TestFailure,
+			"Peer 10.0.0.1 (all address families): expected ASN 4259840001 (65000.1), got 4200000000 (64086.59904)"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPPeers(map[string]any{"peers": []any{map[string]any{"peer": tc.peer, "asn": tc.asn}}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show bgp summary", summary))
+			if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+				t.Fatalf("got %v %q, want %v containing %q", res.Status, res.Message, tc.status, tc.want)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyBGPPeers(map[string]any{"peers": []any{map[string]any{"peer": "10.0.0.1", "asn": "65536.1"}}})
+	if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), `invalid asdot ASN "65536.1"`) {
+		t.Errorf("ValidateInput = %v, want invalid asdot", err)
+	}
+}
+
+// TestVerifyBGPExchangedRoutesStopsWhenCancelled covers the per-command
+// fallback after a rejected batch: a cancellation there must stop the
+// loop instead of sending the remaining route queries.
+func TestVerifyBGPExchangedRoutesStopsWhenCancelled(t *testing.T) {
+	var peers []any
+	fake := device.NewFakeDevice()
+	for _, addr := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"} {
+		peers = append(peers, map[string]any{"peer_address": addr, "advertised_routes": []any{"10.1.0.0/24"}})
+		fake.OnJSON(t, "show bgp neighbors "+addr+" advertised-routes vrf default", `{"vrfs": {"default": {"bgpRouteEntries": {}}}}`)
+	}
+	fake.BatchError = errors.New("CLI command 3 of 4 failed: invalid command")
+	tst, err := NewVerifyBGPExchangedRoutes(map[string]any{"bgp_peers": peers})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fake.CancelAfter(2, cancel)
+
+	res, err := tst.Execute(ctx, fake)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fake.Batches()); got != 1 {
+		t.Errorf("device received %d batches, want 1", got)
+	}
+	if got := len(fake.Calls()); got != 2 {
+		t.Errorf("device received %d fallback commands after cancellation, want 2", got)
+	}
+	if res.Status != test.TestSkipped {
+		t.Fatalf("status = %v, want skipped (%s)", res.Status, res.Message)
+	}
+	if want := "Cancelled after checking 2 of 4 route queries"; !strings.Contains(res.Message, want) {
+		t.Errorf("message %q should contain %q", res.Message, want)
+	}
+}
+
+func TestVerifyBGPPeerMPCapsStopsWhenCancelled(t *testing.T) {
+	var peers []any
+	fake := device.NewFakeDevice()
+	for _, addr := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"} {
+		peers = append(peers, map[string]any{"peer_address": addr, "capabilities": []any{"ipv4 unicast"}})
+		fake.OnJSON(t, "show bgp neighbors "+addr+" vrf default", `{"vrfs": {"default": {"peerList": []}}}`)
+	}
+	tst, err := NewVerifyBGPPeerMPCaps(map[string]any{"bgp_peers": peers})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fake.CancelAfter(2, cancel)
+
+	res, err := tst.Execute(ctx, fake)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(fake.Calls()); got != 2 {
+		t.Errorf("device received %d commands after cancellation, want 2", got)
+	}
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure for the issues found before cancelling (%s)", res.Status, res.Message)
+	}
+	for _, want := range []string{"Cancelled after checking 2 of 4 peers", "issues so far: BGP peer 10.0.0.1 not found in VRF default"} {
+		if !strings.Contains(res.Message, want) {
+			t.Errorf("message %q should contain %q", res.Message, want)
+		}
+	}
+}
+
+func TestVerifyBGPExchangedRoutesBatchesPeerCommands(t *testing.T) {
+	const routes = `{"vrfs": {"default": {"bgpRouteEntries": {"10.1.0.0/24": {"bgpRoutePaths": [{"routeType": {"valid": true, "active": true}}]}}}}}`
+	var peers []any
+	fake := device.NewFakeDevice()
+	addrs := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}
+	for _, addr := range addrs {
+		peers = append(peers, map[string]any{
+			"peer_address":      addr,
+			"advertised_routes": []any{"10.1.0.0/24"},
+			"received_routes":   []any{"10.1.0.0/24"},
+		})
+		fake.OnJSON(t, "show bgp neighbors "+addr+" advertised-routes vrf default", routes)
+		fake.OnJSON(t, "show bgp neighbors "+addr+" received-routes vrf default", routes)
+	}
+	newTest := func() test.Test {
+		tst, err := NewVerifyBGPExchangedRoutes(map[string]any{"bgp_peers": peers})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tst
+	}
+
+	res := test.RunWithFake(t, newTest(), fake)
+	if res.Status != test.TestSuccess {
+		t.Fatalf("status = %v, want success (%s)", res.Status, res.Message)
+	}
+	batches := fake.Batches()
+	if len(batches) != 1 || len(batches[0]) != 2*len(addrs) {
+		t.Fatalf("batches = %v, want one batch of %d commands", batches, 2*len(addrs))
+	}
+	if len(fake.Calls()) != 2*len(addrs) {
+		t.Errorf("calls = %v", fake.Calls())
+	}
+
+	// A per-command failure inside the batch is reported against its peer.
+	fake = device.NewFakeDevice()
+	for _, addr := range addrs {
+		fake.OnJSON(t, "show bgp neighbors "+addr+" advertised-routes vrf default", routes)
+		fake.OnJSON(t, "show bgp neighbors "+addr+" received-routes vrf default", routes)
+	}
+	fake.OnError("show bgp neighbors 10.0.0.2 received-routes vrf default", fmt.Errorf("timeout"))
+	res = test.RunWithFake(t, newTest(), fake)
+	if want := "Failed to get received routes for peer 10.0.0.2: timeout"; res.Status != test.TestFailure || res.Message != want {
+		t.Errorf("got %v %q, want failure %q", res.Status, res.Message, want)
+	}
+
+	// When the whole request is rejected the commands are retried one by
+	// one, so the failure still lands on the right peer.
+	fake.BatchError = fmt.Errorf("eAPI error 1002: invalid command")
+	res = test.RunWithFake(t, newTest(), fake)
+	if want := "Failed to get received routes for peer 10.0.0.2: timeout"; res.Status != test.TestFailure || res.Message != want {
+		t.Errorf("fallback got %v %q, want failure %q", res.Status, res.Message, want)
+	}
+	if got := len(fake.Batches()); got != 2 {
+		t.Errorf("got %d batch calls over both runs, want 2", got)
+	}
+}
+
+func TestVerifyEVPNMacMobility(t *testing.T) {
+	const macIP = `{"evpnRoutes": {
+  "RD: 10.0.0.1:10010 mac-ip 001c.7300.0001": {
+    "routeKeyDetail": {"rd": "10.0.0.1:10010", "nlriType": "mac-ip", "mac": "001c.7300.0001"},
+    "evpnRoutePaths": [{"nextHop": "10.0.0.1", "routeDetail": {"extCommunities": ["Route-Target-AS:10010:10010", "TunnelEncap:tunnelTypeVxlan"]}}]},
+  "RD: 10.0.0.2:10010 mac-ip 001c.7300.0002": {
+    "routeKeyDetail": {"rd": "10.0.0.2:10010", "nlriType": "mac-ip", "mac": "001c.7300.0002"},
+    "evpnRoutePaths": [{"nextHop": "10.0.0.2", "routeDetail": {"extCommunities": ["Route-Target-AS:10010:10010", "EvpnMacMobility:2"]}}]},
+  "RD: 10.0.0.3:10010 mac-ip 001c.7300.0002 10.10.10.2": {
+    "routeKeyDetail": {"rd": "10.0.0.3:10010", "nlriType": "mac-ip", "mac": "001c.7300.0002", "ip": "10.10.10.2"},
+    "evpnRoutePaths": [{"nextHop": "10.0.0.3", "routeDetail": {"extCommunities": ["EvpnMacMobility:17"]}}]},
+  "RD: 10.0.0.4:10010 mac-ip 001c.7300.0004": {
+    "routeKeyDetail": {"rd": "10.0.0.4:10010", "nlriType": "mac-ip", "mac": "001c.7300.0004"},
+    "evpnRoutePaths": [{"nextHop": "10.0.0.4", "routeDetail": {"extCommunities": ["EvpnMacMobility:Sticky:40"]}}]}
+}}`
+
+	cases := []struct {
+		name   string
+		max    int
+		status test.TestStatus
+		want   string
+	}{
+		{"flapping mac", 5, test.TestFailure,
+			"EVPN MAC mobility validation failed: MAC 001c.7300.0002 mobility sequence 17 (maximum: 5), last advertised by VTEP 10.0.0.3"},
+		{"within threshold", 20, test.TestSuccess, "No EVPN MAC above mobility sequence 20 (4 MAC-IP routes)"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyEVPNMacMobility(map[string]any{"max_mobility_seq": tc.max})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show bgp evpn route-type mac-ip", macIP))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+			if strings.Contains(res.Message, "001c.7300.0004") {
+				t.Errorf("sticky MAC should not be reported: %s", res.Message)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyEVPNMacMobility(nil)
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected error when max_mobility_seq is missing")
+	}
+}
+
+func TestVerifyBGPPeerTtlMultiHops(t *testing.T) {
+	const neighbors = `{"vrfs": {"default": {"peerList": [
+  {"peerAddress": "10.0.0.1", "ttl": 255, "maxTtlHops": 2},
+  {"peerAddress": "10.255.0.9", "ttl": 3, "maxTtlHops": 3}
+]}}}`
+	cases := []struct {
+		name   string
+		peer   map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"ttl security matches", map[string]any{"peer_address": "10.0.0.1", "ttl_security": 254},
+			test.TestSuccess, "verified successfully for 1 peers"},
+		{"ttl security mismatch", map[string]any{"peer_address": "10.0.0.1", "ttl_security": 255},
+			test.TestFailure, "Peer 10.0.0.1 in VRF default: TTL security minimum TTL 254, expected 255"},
+		{"ttl security on a multihop peer", map[string]any{"peer_address": "10.255.0.9", "ttl_security": 254},
+			test.TestFailure, "Peer 10.255.0.9 in VRF default: TTL security not enabled, expected minimum TTL 254"},
+		{"multihop matches", map[string]any{"peer_address": "10.255.0.9", "max_hops": 3},
+			test.TestSuccess, "verified successfully"},
+		{"multihop mismatch", map[string]any{"peer_address": "10.255.0.9", "max_hops": 5},
+			test.TestFailure, "Peer 10.255.0.9 in VRF default: multihop limit 3, expected 5"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPPeerTtlMultiHops(map[string]any{"bgp_peers": []any{tc.peer}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", neighbors))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+			if tc.status == test.TestFailure && strings.Count(res.Message, "Peer ") != 1 {
+				t.Errorf("only the mismatched setting should be reported: %s", res.Message)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyBGPPeerTtlMultiHops(nil)
+	if err := tst.(test.InputSchemaProvider).InputSchema().Validate(map[string]any{
+		"bgp_peers": []any{map[string]any{"peer_address": "10.0.0.1", "expected_ttl": 255}},
+	}); err == nil || !strings.Contains(err.Error(), "expected_ttl: unknown key") {
+		t.Errorf("the removed expected_ttl input should be rejected, got %v", err)
+	}
+}
+
+func TestVerifyBGPPeerConnectedCheck(t *testing.T) {
+	const neighbors = `{"vrfs": {"default": {"peerList": [
+  {"peerAddress": "10.0.1.0", "ttl": 1},
+  {"peerAddress": "10.0.2.0", "ttl": 1},
+  {"peerAddress": "10.0.3.0", "ttl": 255, "maxTtlHops": 1},
+  {"peerAddress": "10.255.0.9", "ttl": 3},
+  {"peerAddress": "fe80::1%Ethernet4", "ttl": 1, "ifName": "Ethernet4"}
+]}}}`
+	routes := map[string]string{
+		"10.0.1.0": `{"vrfs": {"default": {"routes": {"10.0.1.0/31": {"routeType": "connected"}}}}}`,
+		"10.0.2.0": `{"vrfs": {"default": {"routes": {"10.0.0.0/16": {"routeType": "eBGP"}}}}}`,
+		"10.0.3.0": `{"vrfs": {"default": {"routes": {"10.0.3.0/31": {"routeType": "connected"}}}}}`,
+	}
+	cases := []struct {
+		name   string
+		peer   map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"connected single hop", map[string]any{"peer_address": "10.0.1.0"},
+			test.TestSuccess, "All 1 BGP peers are directly connected"},
+		{"ttl security single hop", map[string]any{"peer_address": "10.0.3.0"},
+			test.TestSuccess, "directly connected"},
+		{"interface peer", map[string]any{"interface": "Ethernet4"},
+			test.TestSuccess, "directly connected"},
+		{"not on a connected subnet", map[string]any{"peer_address": "10.0.2.0"},
+			test.TestFailure, "Peer 10.0.2.0 in VRF default is not on a connected subnet (reached via eBGP route 10.0.0.0/16)"},
+		{"multihop", map[string]any{"peer_address": "10.255.0.9"},
+			test.TestFailure, "Peer 10.255.0.9 in VRF default requires multihop (3 hops)"},
+		{"missing peer", map[string]any{"peer_address": "10.9.9.9"},
+			test.TestFailure, "BGP peer 10.9.9.9 not found in VRF default"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPPeerConnectedCheck(map[string]any{"bgp_peers": []any{tc.peer}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", neighbors)
+			for addr, body := range routes {
+				dev.OnJSON(t, "show ip route vrf default "+addr, body)
+			}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyBGPPeerConnectedCheck(map[string]any{
+		"bgp_peers": []any{map[string]any{"peer_address": "leaf1"}},
+	})
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected error for a non-IP peer_address")
+	}
+}
+
+func TestVerifyBGPConfederation(t *testing.T) {
+	const instance = `{"vrfs": {"default": {"localAs": "65101", "confederationId": "65000",
+  "confederationPeers": ["65102", "65103"]}}}`
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"matches", map[string]any{"confed_id": 65000, "member_as": []any{65102, "65103"}}, instance,
+			test.TestSuccess, "BGP confederation 65000 verified with 2 member ASes"},
+		{"wrong confed id", map[string]any{"confed_id": 64512, "member_as": []any{65102}}, instance,
+			test.TestFailure, "BGP confederation validation failed in VRF default: confederation identifier is 65000, expected 64512"},
+		{"missing member", map[string]any{"confed_id": 65000, "member_as": []any{65102, "1.10"}}, instance,
+			test.TestFailure, "member AS not configured as confederation peers: 65546 (1.10)"},
+		{"no confederation", map[string]any{"confed_id": 65000}, `{"vrfs": {"default": {"localAs": "65101"}}}`,
+			test.TestFailure, "No BGP confederation configured in VRF default, expected identifier 65000"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPConfederation(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show bgp instance", tc.body))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyBGPConfederation(nil)
+	schema := tst.(test.InputSchemaProvider).InputSchema()
+	if err := schema.Validate(map[string]any{"confed_id": "65000", "member_as": []any{65101, "1.10"}}); err != nil {
+		t.Errorf("asplain and asdot ASNs should be accepted: %v", err)
+	}
+	if err := schema.Validate(map[string]any{"member_as": []any{65101}}); err == nil {
+		t.Error("confed_id should be required")
+	}
+}
+
+func TestVerifyBGPAdditionalPaths(t *testing.T) {
+	const neighbors = `{"vrfs": {"default": {"peerList": [
+  {"peerAddress": "10.0.0.1", "neighborCapabilities": {
+    "addPathsRecvCaps": {"ipv4Unicast": {"advertised": true, "received": true}},
+    "addPathsSendCaps": {"ipv4Unicast": {"advertised": true, "received": false}}}},
+  {"peerAddress": "10.0.0.2", "neighborCapabilities": {
+    "multiprotocolCaps": {"ipv4Unicast": {"advertised": true, "received": true}}}}
+]}}}`
+	cases := []struct {
+		name   string
+		peer   map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"receive negotiated", map[string]any{"peer_address": "10.0.0.1", "direction": "receive"},
+			test.TestSuccess, "BGP add-paths negotiated with all 1 peers"},
+		{"send not negotiated", map[string]any{"peer_address": "10.0.0.1", "direction": "both"},
+			test.TestFailure, "Peer 10.0.0.1 in VRF default: add-paths send not negotiated for ipv4Unicast: advertised=true, received=false"},
+		{"no add-paths", map[string]any{"peer_address": "10.0.0.2", "direction": "receive"},
+			test.TestFailure, "Peer 10.0.0.2 in VRF default: add-paths receive not configured for ipv4Unicast"},
+		{"other family", map[string]any{"peer_address": "10.0.0.1", "direction": "receive", "address_family": "evpn"},
+			test.TestFailure, "add-paths receive not configured for l2VpnEvpn"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBGPAdditionalPaths(map[string]any{"bgp_peers": []any{tc.peer}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show bgp neighbors vrf all", neighbors))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+
+	tst, _ := NewVerifyBGPAdditionalPaths(map[string]any{"bgp_peers": []any{map[string]any{"peer_address": "10.0.0.1"}}})
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("a peer without direction should be rejected")
+	}
+}
+
+func TestVerifyBGPRouteAttributes(t *testing.T) {
+	const route = `{"vrfs": {"default": {"bgpRouteEntries": {"10.100.0.0/16": {"bgpRoutePaths": [
+  {"asPathEntry": {"asPath": "65002 65010"}, "med": 0, "localPreference": 100,
+   "routeType": {"active": false, "valid": true}, "routeDetail": {"origin": "Igp"}},
+  {"asPathEntry": {"asPath": "65001 65010"}, "med": 20, "localPreference": 150,
+   "routeType": {"active": true, "valid": true}, "routeDetail": {"origin": "Igp"}}
+]}}}}}`
+	cases := []struct {
+		name   string
+		route  map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"all attributes match", map[string]any{"origin": "igp", "med": 20, "local_pref": 150, "as_path_regex": "^65001( |$)"},
+			test.TestSuccess, "All 1 BGP routes carry the expected attributes"},
+		{"wrong local pref", map[string]any{"local_pref": 200},
+			test.TestFailure, "Route 10.100.0.0/16 in VRF default: local preference 150, expected 200"},
+		{"first mismatch only", map[string]any{"origin": "egp", "local_pref": 200},
+			test.TestFailure, "origin igp, expected egp"},
+		{"zero med", map[string]any{"med": 0},
+			test.TestFailure, "MED 20, expected 0"},
+		{"as path", map[string]any{"as_path_regex": "^65002 "},
+			test.TestFailure, `AS path "65001 65010" does not match "^65002 "`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.route["prefix"] = "10.100.0.0/16"
+			tst, err := NewVerifyBGPRouteAttributes(map[string]any{"routes": []any{tc.route}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show ip bgp 10.100.0.0/16 vrf default", route))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+			if strings.Count(res.Message, "expected") > 1 {
+				t.Errorf("only the first mismatch should be reported: %s", res.Message)
+			}
+		})
+	}
+}
+
+// TestVerifyBGPRouteAttributesASPathWithoutValidate checks the AS-path
+// regex is compiled by the constructor, so Execute checks it even when
+// ValidateInput was never called, and a bad regex fails construction.
+func TestVerifyBGPRouteAttributesASPathWithoutValidate(t *testing.T) {
+	const route = `{"vrfs": {"default": {"bgpRouteEntries": {"10.100.0.0/16": {"bgpRoutePaths": [
+  {"asPathEntry": {"asPath": "65001 65010"}, "routeType": {"active": true, "valid": true}}
+]}}}}}`
+	tst, err := NewVerifyBGPRouteAttributes(map[string]any{"routes": []any{
+		map[string]any{"prefix": "10.100.0.0/16", "as_path_regex": "^65002 "},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev := device.NewFakeDevice().OnJSON(t, "show ip bgp 10.100.0.0/16 vrf default", route)
+	res, err := tst.Execute(context.Background(), dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, `does not match "^65002 "`) {
+		t.Errorf("got %v %q, want the AS path mismatch reported", res.Status, res.Message)
+	}
+
+	_, err = NewVerifyBGPRouteAttributes(map[string]any{"routes": []any{
+		map[string]any{"prefix": "10.100.0.0/16", "as_path_regex": "^(65001"},
+	}})
+	if err == nil || !strings.Contains(err.Error(), "routes[0]: invalid as_path_regex") {
+		t.Errorf("err = %v, want the invalid regex rejected", err)
+	}
+}
@@ -0,0 +1,151 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/internal/rib"
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyVrfRouteLeaking verifies prefixes are leaked between VRFs.
+//
+// Route-target import/export policies decide which routes of one VRF are
+// installed in another; a typo in a route target or route-map silently
+// stops the leak. For each specified prefix the test:
+//  1. Confirms the prefix is in the routing table of source_vrf, so a
+//     missing origin is not mistaken for a leaking problem.
+//  2. Confirms the prefix is in the routing table of dest_vrf.
+//
+// Expected Results:
+//   - Success: Every prefix is present in both its source and destination VRF.
+//   - Failure: A prefix is missing from its source VRF or failed to leak into its destination VRF.
+//   - Error: Unable to retrieve a routing table.
+//
+// Example YAML configuration:
+//   - name: "VerifyVrfRouteLeaking"
+//     module: "routing"
+//     inputs:
+//     routes:
+//   - prefix: "10.50.0.0/16"
+//     source_vrf: "SHARED"
+//     dest_vrf: "PROD"
+//   - prefix: "2001:db8:50::/48"
+//     source_vrf: "SHARED"
+//     dest_vrf: "DEV"
+type VerifyVrfRouteLeaking struct {
+	test.BaseTest
+	Routes []LeakedRoute `yaml:"routes" json:"routes"`
+}
+
+type LeakedRoute struct {
+	Prefix    string `yaml:"prefix" json:"prefix"`
+	SourceVRF string `yaml:"source_vrf" json:"source_vrf"`
+	DestVRF   string `yaml:"dest_vrf" json:"dest_vrf"`
+}
+
+func NewVerifyVrfRouteLeaking(inputs map[string]any) (test.Test, error) {
+	t := &VerifyVrfRouteLeaking{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyVrfRouteLeaking",
+			TestDescription: "Verify prefixes are leaked between VRFs",
+			TestCategories:  []string{"routing", "vrf"},
+		},
+	}
+
+	if inputs != nil {
+		if routes, ok := inputs["routes"].([]any); ok {
+			for i, item := range routes {
+				routeMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("routes[%d]: expected map, got %T", i, item)
+				}
+				var route LeakedRoute
+				if err := test.GetString(routeMap, "prefix", &route.Prefix); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "source_vrf", &route.SourceVRF); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				if err := test.GetString(routeMap, "dest_vrf", &route.DestVRF); err != nil {
+					return nil, fmt.Errorf("routes[%d]: %w", i, err)
+				}
+				t.Routes = append(t.Routes, route)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyVrfRouteLeaking) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	issues := []string{}
+	for i, route := range t.Routes {
+		if test.Cancelled(ctx, result, i, len(t.Routes), "routes", issues) {
+			return result, nil
+		}
+
+		inSource, err := rib.InVRF(ctx, dev, route.SourceVRF, route.Prefix)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get route %s in VRF %s: %v", route.Prefix, route.SourceVRF, err)
+			return result, nil
+		}
+		if !inSource {
+			issues = append(issues, fmt.Sprintf("%s not present in source VRF %s", route.Prefix, route.SourceVRF))
+			continue
+		}
+
+		inDest, err := rib.InVRF(ctx, dev, route.DestVRF, route.Prefix)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get route %s in VRF %s: %v", route.Prefix, route.DestVRF, err)
+			return result, nil
+		}
+		if !inDest {
+			issues = append(issues, fmt.Sprintf("%s not leaked from VRF %s into VRF %s", route.Prefix, route.SourceVRF, route.DestVRF))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("VRF route leaking issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d prefixes leaked into their destination VRFs", len(t.Routes))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyVrfRouteLeaking) ValidateInput(input any) error {
+	if len(t.Routes) == 0 {
+		return fmt.Errorf("at least one route must be specified")
+	}
+	for i, route := range t.Routes {
+		if _, _, err := net.ParseCIDR(route.Prefix); err != nil {
+			return fmt.Errorf("routes[%d]: invalid prefix %q", i, route.Prefix)
+		}
+		if route.SourceVRF == "" || route.DestVRF == "" {
+			return fmt.Errorf("routes[%d]: source_vrf and dest_vrf are required", i)
+		}
+		if route.SourceVRF == route.DestVRF {
+			return fmt.Errorf("routes[%d]: source_vrf and dest_vrf are both %s", i, route.SourceVRF)
+		}
+		for _, vrf := range []string{route.SourceVRF, route.DestVRF} {
+			if _, err := rib.VRFRouteCommand(vrf, route.Prefix); err != nil {
+				return fmt.Errorf("routes[%d]: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package routing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyVrfRouteLeaking(t *testing.T) {
+	routeIn := func(vrf, prefix string) string {
+		return `{"vrfs": {"` + vrf + `": {"routes": {"` + prefix + `": {"routeType": "eBGP",
+		  "vias": [{"nexthopAddr": "10.0.0.1", "interface": "Ethernet1"}]}}}}}`
+	}
+	empty := func(vrf string) string { return `{"vrfs": {"` + vrf + `": {"routes": {}}}}` }
+
+	cases := []struct {
+		name   string
+		source string
+		dest   string
+		status test.TestStatus
+		want   string
+	}{
+		{"leaked", routeIn("SHARED", "10.50.0.0/16"), routeIn("PROD", "10.50.0.0/16"),
+			test.TestSuccess, "All 1 prefixes leaked into their destination VRFs"},
+		{"missing in destination", routeIn("SHARED", "10.50.0.0/16"), empty("PROD"),
+			test.TestFailure, "10.50.0.0/16 not leaked from VRF SHARED into VRF PROD"},
+		{"missing in source", empty("SHARED"), empty("PROD"),
+			test.TestFailure, "10.50.0.0/16 not present in source VRF SHARED"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyVrfRouteLeaking(map[string]any{"routes": []any{
+				map[string]any{"prefix": "10.50.0.0/16", "source_vrf": "SHARED", "dest_vrf": "PROD"},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show ip route vrf SHARED 10.50.0.0/16", tc.source).
+				OnJSON(t, "show ip route vrf PROD 10.50.0.0/16", tc.dest)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+
+	for _, bad := range []map[string]any{
+		{"prefix": "10.50.0.0/16", "source_vrf": "SHARED", "dest_vrf": "SHARED"},
+		{"prefix": "10.50.0.0/16", "source_vrf": "SHARED", "dest_vrf": "PROD | json"},
+		{"prefix": "10.50.0.0", "source_vrf": "SHARED", "dest_vrf": "PROD"},
+	} {
+		tst, _ := NewVerifyVrfRouteLeaking(map[string]any{"routes": []any{bad}})
+		if err := tst.ValidateInput(nil); err == nil {
+			t.Errorf("ValidateInput(%v) should fail", bad)
+		}
+	}
+}
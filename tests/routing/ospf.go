@@ -50,6 +50,7 @@ func NewVerifyOSPFNeighbors(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyOSPFNeighbors",
 			TestDescription: "Verify OSPF neighbor adjacencies",
 			TestCategories:  []string{"routing", "ospf"},
+			TestSeverity:    test.SeverityCritical,
 		},
 		Instance: "1",
 	}
@@ -3,12 +3,19 @@ package routing
 import (
 	"context"
 	"fmt"
+	"net"
+	"sort"
 	"strings"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
 	"github.com/fluidstackio/go-anta/pkg/test"
 )
 
+// pathStateIPsecEstablished is the DPS path state EOS reports for a
+// healthy AVT path. `routeResolved` means the underlay route exists but the
+// IPsec tunnel never came up.
+const pathStateIPsecEstablished = "ipsecEstablished"
+
 // VerifyPathsHealth verifies the path and telemetry state of all paths under router path-selection.
 //
 // This test validates that all configured dynamic path selection (DPS) paths are in a healthy state
@@ -16,12 +23,13 @@ import (
 //
 // The test performs the following checks:
 //  1. Verifies that at least one path is configured in the path-selection configuration.
-//  2. Validates that all path states are in acceptable conditions ('IPsec established' or 'Resolved').
-//  3. Confirms that telemetry state is 'active' for all paths to ensure monitoring is functional.
+//  2. Validates that every path state is 'ipsecEstablished'; paths that are down or
+//     only 'routeResolved' are reported.
+//  3. Confirms that at least one DPS session is active for every path to ensure telemetry is alive.
 //
 // Expected Results:
-//   - Success: The test will pass if all paths have acceptable states and active telemetry.
-//   - Failure: The test will fail if no paths are configured, any path has an unacceptable state, or telemetry is inactive.
+//   - Success: The test will pass if all paths are IPsec established with active telemetry.
+//   - Failure: The test will fail if no paths are configured, any path is not established, or telemetry is inactive.
 //   - Error: The test will report an error if path-selection information cannot be retrieved.
 //
 // Examples:
@@ -57,49 +65,13 @@ func (t *VerifyPathsHealth) Execute(ctx context.Context, dev device.Device) (*te
 		Categories: t.Categories(),
 	}
 
-	cmd := device.Command{
-		Template: "show path-selection paths",
-		Format:   "json",
-		UseCache: false,
-		Revision: 1, // Using revision 1 as specified in Python implementation
-	}
-
-	cmdResult, err := dev.Execute(ctx, cmd)
+	paths, err := showPathSelectionPaths(ctx, dev)
 	if err != nil {
 		result.Status = test.TestError
 		result.Message = fmt.Sprintf("Failed to get path-selection paths: %v", err)
 		return result, nil
 	}
 
-	var paths []PathInfo
-	if data, ok := cmdResult.Output.(map[string]any); ok {
-		if pathData, ok := data["paths"].(map[string]any); ok {
-			for pathName, pathInfo := range pathData {
-				if info, ok := pathInfo.(map[string]any); ok {
-					path := PathInfo{Name: pathName}
-
-					if state, ok := info["state"].(string); ok {
-						path.State = state
-					}
-					if telemetryState, ok := info["telemetryState"].(string); ok {
-						path.TelemetryState = telemetryState
-					}
-					if sourceAddr, ok := info["sourceAddress"].(string); ok {
-						path.SourceAddress = sourceAddr
-					}
-					if destAddr, ok := info["destinationAddress"].(string); ok {
-						path.DestinationAddress = destAddr
-					}
-					if pathGroup, ok := info["pathGroup"].(string); ok {
-						path.PathGroup = pathGroup
-					}
-
-					paths = append(paths, path)
-				}
-			}
-		}
-	}
-
 	if len(paths) == 0 {
 		result.Status = test.TestFailure
 		result.Message = "No paths configured under router path-selection"
@@ -108,41 +80,19 @@ func (t *VerifyPathsHealth) Execute(ctx context.Context, dev device.Device) (*te
 
 	failures := []string{}
 	for _, path := range paths {
-		// Check path state - acceptable states are 'IPsec established' or 'Resolved'
-		if !t.isAcceptablePathState(path.State) {
-			failures = append(failures, fmt.Sprintf("Path %s: unacceptable state '%s'", path.Name, path.State))
-		}
-
-		// Check telemetry state - must be 'active'
-		if !strings.EqualFold(path.TelemetryState, "active") {
-			failures = append(failures, fmt.Sprintf("Path %s: telemetry state '%s' (expected 'active')", path.Name, path.TelemetryState))
-		}
+		failures = append(failures, path.issues()...)
 	}
 
 	if len(failures) > 0 {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("Path health failures: %v", failures)
+		result.Message = fmt.Sprintf("Path health failures: %s", strings.Join(failures, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d paths IPsec established with active telemetry", len(paths))
 	}
 
 	return result, nil
 }
 
-func (t *VerifyPathsHealth) isAcceptablePathState(state string) bool {
-	acceptableStates := []string{
-		"IPsec established",
-		"ipsecEstablished", // Alternative format
-		"Resolved",
-		"routeResolved", // Alternative format
-	}
-
-	for _, acceptable := range acceptableStates {
-		if strings.EqualFold(state, acceptable) {
-			return true
-		}
-	}
-	return false
-}
-
 func (t *VerifyPathsHealth) ValidateInput(input any) error {
 	// No input validation required for this test
 	return nil
@@ -154,14 +104,16 @@ func (t *VerifyPathsHealth) ValidateInput(input any) error {
 // checking the path group, addresses, connection state, and telemetry status.
 //
 // The test performs the following checks:
-//  1. Verifies that the specified peer and path group exist in the configuration.
-//  2. Validates that the source and destination addresses match the expected values.
-//  3. Confirms that the path state is acceptable ('ipsecEstablished' or 'routeResolved').
-//  4. Ensures that telemetry state is 'active' for proper monitoring.
+//  1. Verifies that a path to the peer exists in the path group with the given source and destination.
+//  2. Confirms that the path state is 'ipsecEstablished'.
+//  3. Ensures that at least one DPS session is active for proper monitoring.
+//
+// `source` and `destination` are accepted as aliases for `source_address`
+// and `destination_address`.
 //
 // Expected Results:
-//   - Success: The test will pass if the specific path exists with correct configuration and healthy state.
-//   - Failure: The test will fail if the path doesn't exist, addresses don't match, state is unacceptable, or telemetry is inactive.
+//   - Success: The test will pass if the specific path exists and is healthy.
+//   - Failure: The test will fail if the path doesn't exist, is down or only route-resolved, or telemetry is inactive.
 //   - Error: The test will report an error if path-selection information cannot be retrieved.
 //
 // Examples:
@@ -177,8 +129,8 @@ func (t *VerifyPathsHealth) ValidateInput(input any) error {
 //     VerifySpecificPath:
 //     peer: "192.168.1.100"
 //     path_group: "mpls-primary"
-//     source_address: "192.168.1.1"
-//     destination_address: "192.168.1.100"
+//     source: "192.168.1.1"
+//     destination: "192.168.1.100"
 type VerifySpecificPath struct {
 	test.BaseTest
 	Peer               string `yaml:"peer" json:"peer"`
@@ -205,15 +157,24 @@ func NewVerifySpecificPath(inputs map[string]any) (test.Test, error) {
 		}
 		if sourceAddr, ok := inputs["source_address"].(string); ok {
 			t.SourceAddress = sourceAddr
+		} else if sourceAddr, ok := inputs["source"].(string); ok {
+			t.SourceAddress = sourceAddr
 		}
 		if destAddr, ok := inputs["destination_address"].(string); ok {
 			t.DestinationAddress = destAddr
+		} else if destAddr, ok := inputs["destination"].(string); ok {
+			t.DestinationAddress = destAddr
 		}
 	}
 
 	return t, nil
 }
 
+// InputKeys reports the `source`/`destination` aliases.
+func (t *VerifySpecificPath) InputKeys() []string {
+	return []string{"source", "destination"}
+}
+
 func (t *VerifySpecificPath) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
 	result := &test.TestResult{
 		TestName:   t.Name(),
@@ -222,14 +183,7 @@ func (t *VerifySpecificPath) Execute(ctx context.Context, dev device.Device) (*t
 		Categories: t.Categories(),
 	}
 
-	cmd := device.Command{
-		Template: "show path-selection paths",
-		Format:   "json",
-		UseCache: false,
-		Revision: 1,
-	}
-
-	cmdResult, err := dev.Execute(ctx, cmd)
+	paths, err := showPathSelectionPaths(ctx, dev)
 	if err != nil {
 		result.Status = test.TestError
 		result.Message = fmt.Sprintf("Failed to get path-selection paths: %v", err)
@@ -237,93 +191,33 @@ func (t *VerifySpecificPath) Execute(ctx context.Context, dev device.Device) (*t
 	}
 
 	var targetPath *PathInfo
-	if data, ok := cmdResult.Output.(map[string]any); ok {
-		if pathData, ok := data["paths"].(map[string]any); ok {
-			for pathName, pathInfo := range pathData {
-				if info, ok := pathInfo.(map[string]any); ok {
-					path := PathInfo{Name: pathName}
-
-					if state, ok := info["state"].(string); ok {
-						path.State = state
-					}
-					if telemetryState, ok := info["telemetryState"].(string); ok {
-						path.TelemetryState = telemetryState
-					}
-					if sourceAddr, ok := info["sourceAddress"].(string); ok {
-						path.SourceAddress = sourceAddr
-					}
-					if destAddr, ok := info["destinationAddress"].(string); ok {
-						path.DestinationAddress = destAddr
-					}
-					if pathGroup, ok := info["pathGroup"].(string); ok {
-						path.PathGroup = pathGroup
-					}
-
-					// Check if this is the path we're looking for
-					if t.matchesTargetPath(&path) {
-						targetPath = &path
-						break
-					}
-				}
-			}
+	for i := range paths {
+		if t.matchesTargetPath(&paths[i]) {
+			targetPath = &paths[i]
+			break
 		}
 	}
 
 	if targetPath == nil {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("Path not found for peer %s in path group %s", t.Peer, t.PathGroup)
+		result.Message = fmt.Sprintf("Path %s -> %s not found for peer %s in path group %s",
+			t.SourceAddress, t.DestinationAddress, t.Peer, t.PathGroup)
 		return result, nil
 	}
 
-	failures := []string{}
-
-	// Validate source address
-	if targetPath.SourceAddress != t.SourceAddress {
-		failures = append(failures, fmt.Sprintf("Source address mismatch: expected %s, got %s", t.SourceAddress, targetPath.SourceAddress))
-	}
-
-	// Validate destination address
-	if targetPath.DestinationAddress != t.DestinationAddress {
-		failures = append(failures, fmt.Sprintf("Destination address mismatch: expected %s, got %s", t.DestinationAddress, targetPath.DestinationAddress))
-	}
-
-	// Check path state - acceptable states are 'ipsecEstablished' or 'routeResolved'
-	if !t.isAcceptablePathState(targetPath.State) {
-		failures = append(failures, fmt.Sprintf("Unacceptable path state: %s", targetPath.State))
-	}
-
-	// Check telemetry state - must be 'active'
-	if !strings.EqualFold(targetPath.TelemetryState, "active") {
-		failures = append(failures, fmt.Sprintf("Telemetry state: expected 'active', got '%s'", targetPath.TelemetryState))
-	}
-
-	if len(failures) > 0 {
+	if failures := targetPath.issues(); len(failures) > 0 {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("Specific path validation failures: %v", failures)
+		result.Message = fmt.Sprintf("Specific path validation failures: %s", strings.Join(failures, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Path %s to peer %s is IPsec established with active telemetry", targetPath.Name, t.Peer)
 	}
 
 	return result, nil
 }
 
 func (t *VerifySpecificPath) matchesTargetPath(path *PathInfo) bool {
-	// Match by peer (destination address) and path group
-	return path.DestinationAddress == t.Peer && path.PathGroup == t.PathGroup
-}
-
-func (t *VerifySpecificPath) isAcceptablePathState(state string) bool {
-	acceptableStates := []string{
-		"ipsecEstablished",
-		"IPsec established", // Alternative format
-		"routeResolved",
-		"Resolved", // Alternative format
-	}
-
-	for _, acceptable := range acceptableStates {
-		if strings.EqualFold(state, acceptable) {
-			return true
-		}
-	}
-	return false
+	return path.Peer == t.Peer && path.PathGroup == t.PathGroup &&
+		path.SourceAddress == t.SourceAddress && path.DestinationAddress == t.DestinationAddress
 }
 
 func (t *VerifySpecificPath) ValidateInput(input any) error {
@@ -340,45 +234,113 @@ func (t *VerifySpecificPath) ValidateInput(input any) error {
 		return fmt.Errorf("destination_address must be specified")
 	}
 
-	// Basic IP address format validation (simplified)
-	if !t.isValidIPv4(t.Peer) {
+	if !isIPv4(t.Peer) {
 		return fmt.Errorf("peer must be a valid IPv4 address")
 	}
-	if !t.isValidIPv4(t.SourceAddress) {
+	if !isIPv4(t.SourceAddress) {
 		return fmt.Errorf("source_address must be a valid IPv4 address")
 	}
-	if !t.isValidIPv4(t.DestinationAddress) {
+	if !isIPv4(t.DestinationAddress) {
 		return fmt.Errorf("destination_address must be a valid IPv4 address")
 	}
 
 	return nil
 }
 
-func (t *VerifySpecificPath) isValidIPv4(ip string) bool {
-	// Simple IPv4 validation - checks for basic format
-	parts := strings.Split(ip, ".")
-	if len(parts) != 4 {
-		return false
-	}
-	for _, part := range parts {
-		if len(part) == 0 || len(part) > 3 {
-			return false
-		}
-		for _, char := range part {
-			if char < '0' || char > '9' {
-				return false
-			}
-		}
-	}
-	return true
+func isIPv4(s string) bool {
+	ip := net.ParseIP(s)
+	return ip != nil && ip.To4() != nil
 }
 
 // PathInfo represents information about a path-selection path
 type PathInfo struct {
 	Name               string
+	Peer               string
+	PathGroup          string
 	State              string
-	TelemetryState     string
 	SourceAddress      string
 	DestinationAddress string
-	PathGroup          string
+	TelemetryActive    bool
+}
+
+func (p PathInfo) issues() []string {
+	issues := []string{}
+	label := fmt.Sprintf("Path %s (peer %s, group %s)", p.Name, p.Peer, p.PathGroup)
+	if p.State != pathStateIPsecEstablished {
+		state := p.State
+		if state == "" {
+			state = "down"
+		}
+		issues = append(issues, fmt.Sprintf("%s: state '%s' (expected '%s')", label, state, pathStateIPsecEstablished))
+	}
+	if !p.TelemetryActive {
+		issues = append(issues, fmt.Sprintf("%s: telemetry inactive", label))
+	}
+	return issues
+}
+
+// showPathSelectionPaths runs `show path-selection paths` and flattens the
+// dpsPeers -> dpsGroups -> dpsPaths tree into a sorted path list.
+func showPathSelectionPaths(ctx context.Context, dev device.Device) ([]PathInfo, error) {
+	cmd := device.Command{
+		Template: "show path-selection paths",
+		Format:   "json",
+		UseCache: false,
+		Revision: 1, // Using revision 1 as specified in Python implementation
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		return nil, err
+	}
+	return parsePathSelectionPaths(data), nil
+}
+
+func parsePathSelectionPaths(data map[string]any) []PathInfo {
+	var paths []PathInfo
+	peers, _ := data["dpsPeers"].(map[string]any)
+	for peerAddr, rawPeer := range peers {
+		peer, _ := rawPeer.(map[string]any)
+		groups, _ := peer["dpsGroups"].(map[string]any)
+		for groupName, rawGroup := range groups {
+			group, _ := rawGroup.(map[string]any)
+			dpsPaths, _ := group["dpsPaths"].(map[string]any)
+			for pathName, rawPath := range dpsPaths {
+				info, ok := rawPath.(map[string]any)
+				if !ok {
+					continue
+				}
+				path := PathInfo{Name: pathName, Peer: peerAddr, PathGroup: groupName}
+				path.State, _ = info["state"].(string)
+				path.SourceAddress, _ = info["source"].(string)
+				path.DestinationAddress, _ = info["destination"].(string)
+				sessions, _ := info["dpsSessions"].(map[string]any)
+				for _, rawSession := range sessions {
+					if session, ok := rawSession.(map[string]any); ok {
+						if active, _ := session["active"].(bool); active {
+							path.TelemetryActive = true
+							break
+						}
+					}
+				}
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		if paths[i].Peer != paths[j].Peer {
+			return paths[i].Peer < paths[j].Peer
+		}
+		if paths[i].PathGroup != paths[j].PathGroup {
+			return paths[i].PathGroup < paths[j].PathGroup
+		}
+		return paths[i].Name < paths[j].Name
+	})
+	return paths
 }
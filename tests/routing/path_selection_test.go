@@ -0,0 +1,90 @@
+package routing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const pathSelectionPaths = `{"dpsPeers": {
+  "10.255.0.1": {"dpsGroups": {
+    "internet": {"dpsPaths": {
+      "path3": {"state": "ipsecEstablished", "source": "100.64.3.2", "destination": "100.64.1.2",
+                "dpsSessions": {"0": {"active": true}}}
+    }},
+    "mpls": {"dpsPaths": {
+      "path4": {"state": "routeResolved", "source": "172.18.13.2", "destination": "172.18.15.2",
+                "dpsSessions": {"0": {"active": true}}}
+    }}
+  }},
+  "10.255.0.2": {"dpsGroups": {
+    "internet": {"dpsPaths": {
+      "path1": {"state": "ipsecPending", "source": "100.64.3.2", "destination": "100.64.2.2",
+                "dpsSessions": {"0": {"active": false}}}
+    }}
+  }}
+}}`
+
+func TestVerifyPathsHealth(t *testing.T) {
+	tst, _ := NewVerifyPathsHealth(nil)
+	res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show path-selection paths", pathSelectionPaths))
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	for _, want := range []string{
+		"Path path4 (peer 10.255.0.1, group mpls): state 'routeResolved'",
+		"Path path1 (peer 10.255.0.2, group internet): state 'ipsecPending'",
+		"Path path1 (peer 10.255.0.2, group internet): telemetry inactive",
+	} {
+		if !strings.Contains(res.Message, want) {
+			t.Errorf("message %q should contain %q", res.Message, want)
+		}
+	}
+	if strings.Contains(res.Message, "path3") {
+		t.Errorf("path3 is healthy: %s", res.Message)
+	}
+
+	res = test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show path-selection paths", `{"dpsPeers": {}}`))
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, "No paths configured") {
+		t.Errorf("empty: got %v %q", res.Status, res.Message)
+	}
+}
+
+func TestVerifySpecificPath(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"established", map[string]any{"peer": "10.255.0.1", "path_group": "internet",
+			"source_address": "100.64.3.2", "destination_address": "100.64.1.2"}, test.TestSuccess, "path3"},
+		{"dead path", map[string]any{"peer": "10.255.0.2", "path_group": "internet",
+			"source": "100.64.3.2", "destination": "100.64.2.2"}, test.TestFailure, "telemetry inactive"},
+		{"not found", map[string]any{"peer": "10.255.0.1", "path_group": "internet",
+			"source": "100.64.3.2", "destination": "100.64.9.9"}, test.TestFailure, "not found for peer 10.255.0.1"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifySpecificPath(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := test.ValidateInputKeys(tc.inputs, tst); err != nil {
+				t.Fatalf("ValidateInputKeys: %v", err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show path-selection paths", pathSelectionPaths))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
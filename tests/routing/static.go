@@ -3,7 +3,9 @@ package routing
 import (
 	"context"
 	"fmt"
+	"net"
 
+	"github.com/fluidstackio/go-anta/internal/rib"
 	"github.com/fluidstackio/go-anta/pkg/device"
 	"github.com/fluidstackio/go-anta/pkg/test"
 )
@@ -14,6 +16,9 @@ import (
 // routing table with the correct next-hop addresses. Static routes provide
 // explicit routing paths and are important for network connectivity.
 //
+// Each route is looked up in the table of its own family, `show ip route`
+// or `show ipv6 route`, so IPv4 and IPv6 routes can share one list.
+//
 // Expected Results:
 //   - Success: All specified static routes are found with correct next-hops and are active.
 //   - Failure: A static route is missing, has incorrect next-hop, or is not active.
@@ -30,6 +35,8 @@ import (
 //   - prefix: "172.16.0.0/16"
 //     next_hop: "10.0.0.2"
 //     vrf: "PROD"
+//   - prefix: "2001:db8:100::/48"
+//     next_hop: "2001:db8::1"
 type VerifyStaticRoutes struct {
 	test.BaseTest
 	Routes []StaticRoute `yaml:"routes" json:"routes"`
@@ -91,28 +98,36 @@ func (t *VerifyStaticRoutes) Execute(ctx context.Context, dev device.Device) (*t
 
 	issues := []string{}
 
-	// Group routes by VRF to minimize API calls
-	routesByVrf := make(map[string][]StaticRoute)
+	// Group routes by family and VRF to minimize API calls
+	type routeTable struct{ family, vrf string }
+	routesByVrf := make(map[routeTable][]StaticRoute)
 	for _, route := range t.Routes {
 		vrfName := route.VRF
 		if vrfName == "" {
 			vrfName = "default"
 		}
-		routesByVrf[vrfName] = append(routesByVrf[vrfName], route)
+		key := routeTable{rib.Family(route.Prefix), vrfName}
+		routesByVrf[key] = append(routesByVrf[key], route)
 	}
 
 	// Query each VRF separately
-	for vrfName, routes := range routesByVrf {
+	checked := 0
+	for key, routes := range routesByVrf {
+		if test.Cancelled(ctx, result, checked, len(routesByVrf), "VRFs", issues) {
+			return result, nil
+		}
+		checked++
+		vrfName := key.vrf
 		var cmd device.Command
 		if vrfName == "default" {
 			cmd = device.Command{
-				Template: "show ip route",
+				Template: fmt.Sprintf("show %s route", key.family),
 				Format:   "json",
 				UseCache: false,
 			}
 		} else {
 			cmd = device.Command{
-				Template: fmt.Sprintf("show ip route vrf %s", vrfName),
+				Template: fmt.Sprintf("show %s route vrf %s", key.family, vrfName),
 				Format:   "json",
 				UseCache: false,
 			}
@@ -147,10 +162,15 @@ func (t *VerifyStaticRoutes) Execute(ctx context.Context, dev device.Device) (*t
 			issues = append(issues, fmt.Sprintf("VRF %s data malformed", vrfName))
 			continue
 		}
-		vrfRoutes, _ := vrf["routes"].(map[string]any)
+		vrfRoutes := map[string]any{}
+		if entries, ok := vrf["routes"].(map[string]any); ok {
+			for prefix, entry := range entries {
+				vrfRoutes[rib.Canonical(prefix)] = entry
+			}
+		}
 
 		for _, expectedRoute := range routes {
-			routeRaw, routeExists := vrfRoutes[expectedRoute.Prefix]
+			routeRaw, routeExists := vrfRoutes[rib.Canonical(expectedRoute.Prefix)]
 			if !routeExists {
 				issues = append(issues, fmt.Sprintf("Route %s not found in VRF %s",
 					expectedRoute.Prefix, vrfName))
@@ -176,7 +196,7 @@ func (t *VerifyStaticRoutes) Execute(ctx context.Context, dev device.Device) (*t
 				if !ok {
 					continue
 				}
-				if nexthopAddr, _ := viaData["nexthopAddr"].(string); nexthopAddr == expectedRoute.NextHop {
+				if nexthopAddr, _ := viaData["nexthopAddr"].(string); sameAddress(nexthopAddr, expectedRoute.NextHop) {
 					found = true
 					break
 				}
@@ -196,6 +216,15 @@ func (t *VerifyStaticRoutes) Execute(ctx context.Context, dev device.Device) (*t
 	return result, nil
 }
 
+// sameAddress reports whether two IP address strings are equal, so IPv6
+// next hops match however they are written.
+func sameAddress(a, b string) bool {
+	if ipA, ipB := net.ParseIP(a), net.ParseIP(b); ipA != nil && ipB != nil {
+		return ipA.Equal(ipB)
+	}
+	return a == b
+}
+
 func (t *VerifyStaticRoutes) ValidateInput(input any) error {
 	if len(t.Routes) == 0 {
 		return fmt.Errorf("at least one static route must be specified")
@@ -0,0 +1,53 @@
+package routing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyStaticRoutes(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show ip route", `{"vrfs": {"default": {"routes": {
+  "192.168.1.0/24": {"routeType": "static", "vias": [{"nexthopAddr": "10.0.0.1"}]}
+}}}}`).
+		OnJSON(t, "show ipv6 route", `{"vrfs": {"default": {"routes": {
+  "2001:db8:100::/48": {"routeType": "static", "vias": [{"nexthopAddr": "2001:db8::1"}]}
+}}}}`).
+		OnJSON(t, "show ipv6 route vrf PROD", `{"vrfs": {"PROD": {"routes": {}}}}`)
+
+	cases := []struct {
+		name   string
+		routes []any
+		status test.TestStatus
+		want   string
+	}{
+		{"ipv4 and ipv6", []any{
+			map[string]any{"prefix": "192.168.1.0/24", "next_hop": "10.0.0.1"},
+			map[string]any{"prefix": "2001:0db8:0100::/48", "next_hop": "2001:db8:0::1"},
+		}, test.TestSuccess, ""},
+		{"ipv6 wrong next hop", []any{
+			map[string]any{"prefix": "2001:db8:100::/48", "next_hop": "2001:db8::2"},
+		}, test.TestFailure, "Route 2001:db8:100::/48: next-hop 2001:db8::2 not found"},
+		{"ipv6 missing in vrf", []any{
+			map[string]any{"prefix": "2001:db8:200::/48", "next_hop": "2001:db8::1", "vrf": "PROD"},
+		}, test.TestFailure, "Route 2001:db8:200::/48 not found in VRF PROD"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyStaticRoutes(map[string]any{"routes": tc.routes})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+				t.Errorf("got %v %q, want %v containing %q", res.Status, res.Message, tc.status, tc.want)
+			}
+		})
+	}
+}
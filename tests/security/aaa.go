@@ -3,6 +3,7 @@ package security
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
@@ -389,6 +390,175 @@ func (t *VerifyTacacsServerGroups) ValidateInput(input any) error {
 	return nil
 }
 
+// VerifyTacacsReachability verifies configured TACACS+ servers are answering requests.
+//
+// The configuration tests above only prove a server is configured. This test reads
+// the per-server counters in `show tacacs` and treats a server that has been
+// tried (messages sent, timeouts or connection failures) without ever answering
+// as unreachable. A server with every counter at zero has simply never been
+// used, typically a backup behind a healthy primary; it is listed in the
+// message and in Details["unused"] but does not fail the test.
+//
+// The test performs the following checks:
+//  1. Retrieves the TACACS server counters from the device.
+//  2. Limits the check to `servers` when given; otherwise every configured server is checked.
+//  3. Verifies each server that has been tried has received at least one message.
+//
+// Expected Results:
+//   - Success: The test will pass if every checked server that has been tried has completed at least one exchange.
+//   - Failure: The test will fail if a server is not configured or has only timeouts/failures.
+//   - Error: The test will report an error if TACACS information cannot be retrieved.
+//
+// Examples:
+//
+//   - name: VerifyTacacsReachability all servers
+//     VerifyTacacsReachability: {}
+//
+//   - name: VerifyTacacsReachability specific servers
+//     VerifyTacacsReachability:
+//     servers:
+//
+//   - "10.1.1.10"
+type VerifyTacacsReachability struct {
+	test.BaseTest
+	Servers []string `yaml:"servers,omitempty" json:"servers,omitempty"`
+}
+
+func NewVerifyTacacsReachability(inputs map[string]any) (test.Test, error) {
+	t := &VerifyTacacsReachability{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyTacacsReachability",
+			TestDescription: "Verify TACACS servers are reachable",
+			TestCategories:  []string{"security", "aaa"},
+		},
+	}
+
+	if err := test.GetStringSlice(inputs, "servers", &t.Servers); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyTacacsReachability) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show tacacs",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get TACACS configuration: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected output: %v", err)
+		return result, nil
+	}
+
+	counters := parseTacacsCounters(data)
+	scope := t.Servers
+	if len(scope) == 0 {
+		for host := range counters {
+			scope = append(scope, host)
+		}
+		sort.Strings(scope)
+	}
+	if len(scope) == 0 {
+		result.Status = test.TestFailure
+		result.Message = "No TACACS servers configured"
+		return result, nil
+	}
+
+	unreachable := []string{}
+	unused := []string{}
+	for _, host := range scope {
+		c, ok := counters[host]
+		if !ok {
+			unreachable = append(unreachable, fmt.Sprintf("%s not configured", host))
+			continue
+		}
+		if c.MessagesReceived > 0 {
+			continue
+		}
+		if c.MessagesSent == 0 && c.ConnectionTimeouts == 0 && c.ConnectionFailures == 0 {
+			unused = append(unused, host)
+			continue
+		}
+		unreachable = append(unreachable, fmt.Sprintf("%s unreachable (%d sent, 0 received, %d timeouts, %d failures)",
+			host, c.MessagesSent, c.ConnectionTimeouts, c.ConnectionFailures))
+	}
+
+	if len(unreachable) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("TACACS servers not reachable: %s", strings.Join(unreachable, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d TACACS servers reachable", len(scope)-len(unused))
+	}
+	if len(unused) > 0 {
+		result.Message += fmt.Sprintf(" (never used: %s)", strings.Join(unused, ", "))
+		result.Details = map[string]any{"unused": unused}
+	}
+
+	return result, nil
+}
+
+func (t *VerifyTacacsReachability) ValidateInput(input any) error {
+	return nil
+}
+
+type tacacsCounters struct {
+	MessagesSent       int
+	MessagesReceived   int
+	ConnectionTimeouts int
+	ConnectionFailures int
+}
+
+// parseTacacsCounters indexes the tacacsServers list of `show tacacs` by
+// server hostname.
+func parseTacacsCounters(data map[string]any) map[string]tacacsCounters {
+	counters := map[string]tacacsCounters{}
+	servers, _ := data["tacacsServers"].([]any)
+	for _, server := range servers {
+		serverMap, ok := server.(map[string]any)
+		if !ok {
+			continue
+		}
+		info, _ := serverMap["serverInfo"].(map[string]any)
+		host, _ := info["hostname"].(string)
+		if host == "" {
+			continue
+		}
+		c := tacacsCounters{}
+		if v, ok := serverMap["messagesSent"].(float64); ok {
+			c.MessagesSent = int(v)
+		}
+		if v, ok := serverMap["messagesReceived"].(float64); ok {
+			c.MessagesReceived = int(v)
+		}
+		if v, ok := serverMap["connectionTimeouts"].(float64); ok {
+			c.ConnectionTimeouts = int(v)
+		}
+		if v, ok := serverMap["connectionFailures"].(float64); ok {
+			c.ConnectionFailures = int(v)
+		}
+		counters[host] = c
+	}
+	return counters
+}
+
 // VerifyAuthenMethods verifies AAA authentication method configurations.
 //
 // This test validates that the specified authentication methods are configured
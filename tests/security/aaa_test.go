@@ -0,0 +1,75 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showTacacsCounters = `{"tacacsServers": [
+  {"serverInfo": {"hostname": "10.1.1.10", "port": 49, "vrf": "MGMT"},
+   "messagesSent": 120, "messagesReceived": 120, "connectionOpens": 60, "connectionTimeouts": 0, "connectionFailures": 0},
+  {"serverInfo": {"hostname": "10.1.1.11", "port": 49, "vrf": "MGMT"},
+   "messagesSent": 0, "messagesReceived": 0, "connectionOpens": 0, "connectionTimeouts": 42, "connectionFailures": 3},
+  {"serverInfo": {"hostname": "10.1.1.13", "port": 49, "vrf": "MGMT"},
+   "messagesSent": 0, "messagesReceived": 0, "connectionOpens": 0, "connectionTimeouts": 0, "connectionFailures": 0},
+  {"serverInfo": {"hostname": "10.1.1.14", "port": 49, "vrf": "MGMT"},
+   "messagesSent": 5, "messagesReceived": 0, "connectionOpens": 5, "connectionTimeouts": 0, "connectionFailures": 0}
+]}`
+
+func TestVerifyTacacsReachability(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"all servers", nil, test.TestFailure,
+			"10.1.1.11 unreachable (0 sent, 0 received, 42 timeouts, 3 failures)"},
+		{"scoped to healthy server", map[string]any{"servers": []any{"10.1.1.10"}}, test.TestSuccess,
+			"All 1 TACACS servers reachable"},
+		{"tried without answers", nil, test.TestFailure,
+			"10.1.1.14 unreachable (5 sent, 0 received, 0 timeouts, 0 failures)"},
+		{"never used server", map[string]any{"servers": []any{"10.1.1.10", "10.1.1.13"}}, test.TestSuccess,
+			"All 1 TACACS servers reachable (never used: 10.1.1.13)"},
+		{"scoped to unknown server", map[string]any{"servers": []any{"10.1.1.12"}}, test.TestFailure,
+			"10.1.1.12 not configured"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyTacacsReachability(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show tacacs", showTacacsCounters))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyTacacsReachabilityUnusedNotFailed(t *testing.T) {
+	tst, _ := NewVerifyTacacsReachability(nil)
+	res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show tacacs", showTacacsCounters))
+	if strings.Contains(res.Message, "10.1.1.13 unreachable") {
+		t.Errorf("never-used server reported unreachable: %s", res.Message)
+	}
+	unused, _ := res.Details["unused"].([]string)
+	if len(unused) != 1 || unused[0] != "10.1.1.13" {
+		t.Errorf("Details[unused] = %v, want [10.1.1.13]", res.Details["unused"])
+	}
+}
+
+func TestVerifyTacacsReachabilityNoServers(t *testing.T) {
+	tst, _ := NewVerifyTacacsReachability(nil)
+	res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show tacacs", `{"tacacsServers": []}`))
+	if res.Status != test.TestFailure || res.Message != "No TACACS servers configured" {
+		t.Errorf("got %v %q", res.Status, res.Message)
+	}
+}
@@ -0,0 +1,165 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyCoppStatus verifies control-plane policing is not dropping traffic
+// for critical protocols.
+//
+// CoPP drops are silent from the protocol's point of view: a policer that
+// is too tight shows up as flapping BGP sessions, ARP timeouts or LACP
+// members going out of sync. The test reads the drop counters of
+// copp-system-policy from `show policy-map copp-system-policy` and fails
+// when a checked class has dropped more packets than its threshold.
+//
+// `thresholds` maps class names to the maximum number of dropped packets
+// allowed. When omitted, the BGP, ARP and LACP classes are checked with a
+// threshold of zero.
+//
+// Expected Results:
+//   - Success: No checked class has dropped more packets than its threshold.
+//   - Failure: A class is over its threshold or missing from the policy.
+//   - Error: Unable to retrieve the CoPP policy counters.
+//
+// Examples:
+//
+//   - name: VerifyCoppStatus default classes
+//     VerifyCoppStatus: {}
+//
+//   - name: VerifyCoppStatus with thresholds
+//     VerifyCoppStatus:
+//     thresholds:
+//     copp-system-bgp: 0
+//     copp-system-arp: 1000
+//     copp-system-lacp: 0
+type VerifyCoppStatus struct {
+	test.BaseTest
+	Thresholds map[string]int `yaml:"thresholds,omitempty" json:"thresholds,omitempty"`
+}
+
+// coppPolicy is the CoPP policy EOS applies to the control plane.
+const coppPolicy = "copp-system-policy"
+
+// defaultCoppThresholds are the classes carrying protocols that break
+// first when policed.
+var defaultCoppThresholds = map[string]int{
+	"copp-system-bgp":  0,
+	"copp-system-arp":  0,
+	"copp-system-lacp": 0,
+}
+
+func NewVerifyCoppStatus(inputs map[string]any) (test.Test, error) {
+	t := &VerifyCoppStatus{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyCoppStatus",
+			TestDescription: "Verify CoPP is not dropping critical control-plane traffic",
+			TestCategories:  []string{"security", "copp"},
+		},
+		Thresholds: defaultCoppThresholds,
+	}
+
+	if raw, ok := inputs["thresholds"]; ok {
+		thresholds, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("thresholds: expected map, got %T", raw)
+		}
+		t.Thresholds = make(map[string]int, len(thresholds))
+		for class := range thresholds {
+			var limit int
+			if err := test.GetInt(thresholds, class, &limit); err != nil {
+				return nil, fmt.Errorf("thresholds.%w", err)
+			}
+			t.Thresholds[class] = limit
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyCoppStatus) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show policy-map " + coppPolicy,
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get CoPP policy counters: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected CoPP policy output: %v", err)
+		return result, nil
+	}
+
+	policyMaps, _ := data["policyMaps"].(map[string]any)
+	policy, ok := policyMaps[coppPolicy].(map[string]any)
+	if !ok {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("CoPP policy %s not found", coppPolicy)
+		return result, nil
+	}
+	classMaps, _ := policy["classMaps"].(map[string]any)
+
+	classes := make([]string, 0, len(t.Thresholds))
+	for class := range t.Thresholds {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+
+	issues := []string{}
+	drops := map[string]any{}
+	for _, class := range classes {
+		classData, ok := classMaps[class].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("class %s not found in %s", class, coppPolicy))
+			continue
+		}
+		dropped, _ := classData["droppedPackets"].(float64)
+		drops[class] = int64(dropped)
+		if limit := t.Thresholds[class]; int64(dropped) > int64(limit) {
+			issues = append(issues, fmt.Sprintf("class %s dropped %d packets (threshold %d)", class, int64(dropped), limit))
+		}
+	}
+
+	result.Details = map[string]any{"dropped_packets": drops}
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("CoPP drops on critical classes: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("CoPP drops within thresholds for %d classes", len(classes))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyCoppStatus) ValidateInput(input any) error {
+	if len(t.Thresholds) == 0 {
+		return fmt.Errorf("at least one class threshold must be specified")
+	}
+	for class, limit := range t.Thresholds {
+		if limit < 0 {
+			return fmt.Errorf("thresholds.%s must not be negative", class)
+		}
+	}
+	return nil
+}
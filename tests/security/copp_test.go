@@ -0,0 +1,48 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showCoppPolicy = `{"policyMaps": {"copp-system-policy": {"name": "copp-system-policy", "classMaps": {
+  "copp-system-bgp": {"name": "copp-system-bgp", "conformedPackets": 120344, "droppedPackets": 0},
+  "copp-system-arp": {"name": "copp-system-arp", "conformedPackets": 88221, "droppedPackets": 412},
+  "copp-system-lacp": {"name": "copp-system-lacp", "conformedPackets": 5120, "droppedPackets": 0}
+}}}}`
+
+func TestVerifyCoppStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"default classes", nil, test.TestFailure,
+			"CoPP drops on critical classes: class copp-system-arp dropped 412 packets (threshold 0)"},
+		{"within threshold", map[string]any{"thresholds": map[string]any{"copp-system-arp": 1000, "copp-system-bgp": 0}},
+			test.TestSuccess, "CoPP drops within thresholds for 2 classes"},
+		{"over threshold", map[string]any{"thresholds": map[string]any{"copp-system-arp": 100}},
+			test.TestFailure, "class copp-system-arp dropped 412 packets (threshold 100)"},
+		{"missing class", map[string]any{"thresholds": map[string]any{"copp-system-ospf": 0}},
+			test.TestFailure, "class copp-system-ospf not found in copp-system-policy"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyCoppStatus(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show policy-map copp-system-policy", showCoppPolicy))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyRadiusSourceIntf verifies RADIUS source interface configuration.
+//
+// This test validates that RADIUS is configured to use a specific source interface
+// in the given VRF, mirroring VerifyTacacsSourceIntf.
+//
+// Expected Results:
+//   - Success: The test will pass if the specified interface is configured as the RADIUS source in the given VRF.
+//   - Failure: The test will fail if no source interface is configured for the VRF or it differs.
+//   - Error: The test will report an error if RADIUS configuration cannot be retrieved.
+//
+// Examples:
+//
+//   - name: VerifyRadiusSourceIntf management interface
+//     VerifyRadiusSourceIntf:
+//     interface: "Management1"
+//     vrf: "MGMT"
+type VerifyRadiusSourceIntf struct {
+	test.BaseTest
+	Interface string `yaml:"interface" json:"interface"`
+	VRF       string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyRadiusSourceIntf(inputs map[string]any) (test.Test, error) {
+	t := &VerifyRadiusSourceIntf{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyRadiusSourceIntf",
+			TestDescription: "Verify RADIUS source interface configuration",
+			TestCategories:  []string{"security", "aaa"},
+		},
+		VRF: "default", // Default VRF
+	}
+
+	if err := test.GetString(inputs, "interface", &t.Interface); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "vrf", &t.VRF); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyRadiusSourceIntf) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	data, err := showRadius(ctx, dev)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get RADIUS configuration: %v", err)
+		return result, nil
+	}
+
+	srcIntf, _ := data["srcIntf"].(map[string]any)
+	sourceIntf, ok := srcIntf[t.VRF].(string)
+	switch {
+	case !ok:
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("No RADIUS source interface configured for VRF %s", t.VRF)
+	case sourceIntf != t.Interface:
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("RADIUS source interface for VRF %s: expected '%s', got '%s'", t.VRF, t.Interface, sourceIntf)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyRadiusSourceIntf) ValidateInput(input any) error {
+	if t.Interface == "" {
+		return fmt.Errorf("interface must be specified")
+	}
+	return nil
+}
+
+// VerifyRadiusServers verifies RADIUS server configurations.
+//
+// This test validates that specific RADIUS servers are configured in the specified VRF,
+// mirroring VerifyTacacsServers.
+//
+// Expected Results:
+//   - Success: The test will pass if all specified servers are configured in the VRF.
+//   - Failure: The test will fail if any server is missing from the configuration.
+//   - Error: The test will report an error if RADIUS configuration cannot be retrieved.
+//
+// Examples:
+//
+//   - name: VerifyRadiusServers production servers
+//     VerifyRadiusServers:
+//     servers:
+//
+//   - "10.1.1.20"
+//
+//   - "10.1.1.21"
+//     vrf: "MGMT"
+type VerifyRadiusServers struct {
+	test.BaseTest
+	Servers []string `yaml:"servers" json:"servers"`
+	VRF     string   `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyRadiusServers(inputs map[string]any) (test.Test, error) {
+	t := &VerifyRadiusServers{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyRadiusServers",
+			TestDescription: "Verify RADIUS servers are configured",
+			TestCategories:  []string{"security", "aaa"},
+		},
+		VRF: "default", // Default VRF
+	}
+
+	if err := test.GetStringSlice(inputs, "servers", &t.Servers); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "vrf", &t.VRF); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyRadiusServers) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	data, err := showRadius(ctx, dev)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get RADIUS configuration: %v", err)
+		return result, nil
+	}
+
+	configured := map[string]bool{}
+	servers, _ := data["radiusServers"].([]any)
+	for _, server := range servers {
+		serverMap, ok := server.(map[string]any)
+		if !ok {
+			continue
+		}
+		info, _ := serverMap["serverInfo"].(map[string]any)
+		host, _ := info["hostname"].(string)
+		vrf, _ := info["vrf"].(string)
+		if vrf == "" {
+			vrf = "default"
+		}
+		if host != "" && vrf == t.VRF {
+			configured[host] = true
+		}
+	}
+
+	missingServers := []string{}
+	for _, expectedServer := range t.Servers {
+		if !configured[expectedServer] {
+			missingServers = append(missingServers, expectedServer)
+		}
+	}
+
+	if len(missingServers) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("RADIUS servers not configured in VRF %s: %v", t.VRF, missingServers)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyRadiusServers) ValidateInput(input any) error {
+	if len(t.Servers) == 0 {
+		return fmt.Errorf("at least one server must be specified")
+	}
+	return nil
+}
+
+// VerifyRadiusServerGroups verifies RADIUS server group configurations.
+//
+// This test validates that specific RADIUS server groups are configured on the device,
+// mirroring VerifyTacacsServerGroups. Only groups of type "radius" are considered.
+//
+// Expected Results:
+//   - Success: The test will pass if all specified server groups are configured.
+//   - Failure: The test will fail if any server group is missing from the configuration.
+//   - Error: The test will report an error if RADIUS configuration cannot be retrieved.
+//
+// Examples:
+//
+//   - name: VerifyRadiusServerGroups production groups
+//     VerifyRadiusServerGroups:
+//     groups:
+//
+//   - "RADIUS_PRIMARY"
+type VerifyRadiusServerGroups struct {
+	test.BaseTest
+	Groups []string `yaml:"groups" json:"groups"`
+}
+
+func NewVerifyRadiusServerGroups(inputs map[string]any) (test.Test, error) {
+	t := &VerifyRadiusServerGroups{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyRadiusServerGroups",
+			TestDescription: "Verify RADIUS server groups are configured",
+			TestCategories:  []string{"security", "aaa"},
+		},
+	}
+
+	if err := test.GetStringSlice(inputs, "groups", &t.Groups); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyRadiusServerGroups) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	data, err := showRadius(ctx, dev)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get RADIUS configuration: %v", err)
+		return result, nil
+	}
+
+	configured := map[string]bool{}
+	groups, _ := data["groups"].(map[string]any)
+	for name, raw := range groups {
+		group, _ := raw.(map[string]any)
+		if kind, ok := group["serverGroup"].(string); ok && kind != "radius" {
+			continue
+		}
+		configured[name] = true
+	}
+
+	missingGroups := []string{}
+	for _, expectedGroup := range t.Groups {
+		if !configured[expectedGroup] {
+			missingGroups = append(missingGroups, expectedGroup)
+		}
+	}
+	sort.Strings(missingGroups)
+
+	if len(missingGroups) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("RADIUS server groups not configured: %v", missingGroups)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyRadiusServerGroups) ValidateInput(input any) error {
+	if len(t.Groups) == 0 {
+		return fmt.Errorf("at least one group must be specified")
+	}
+	return nil
+}
+
+func showRadius(ctx context.Context, dev device.Device) (map[string]any, error) {
+	cmd := device.Command{
+		Template: "show radius",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return test.AsMap(cmdResult.Output)
+}
@@ -0,0 +1,88 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showRadiusOutput = `{
+  "radiusServers": [
+    {"serverInfo": {"hostname": "10.1.1.20", "authport": 1812, "acctport": 1813, "vrf": "MGMT"}},
+    {"serverInfo": {"hostname": "10.1.1.21", "authport": 1812, "acctport": 1813, "vrf": "MGMT"}},
+    {"serverInfo": {"hostname": "192.168.1.50", "authport": 1812, "acctport": 1813}}
+  ],
+  "groups": {
+    "RADIUS_PRIMARY": {"serverGroup": "radius", "members": [{"hostname": "10.1.1.20", "vrf": "MGMT"}]},
+    "TACACS_PRIMARY": {"serverGroup": "tacacs+", "members": []}
+  },
+  "srcIntf": {"MGMT": "Management1"}
+}`
+
+func TestVerifyRadiusServers(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"all present", map[string]any{"servers": []any{"10.1.1.20", "10.1.1.21"}, "vrf": "MGMT"}, test.TestSuccess, ""},
+		{"default vrf", map[string]any{"servers": []any{"192.168.1.50"}}, test.TestSuccess, ""},
+		{"missing server", map[string]any{"servers": []any{"10.1.1.20", "10.1.1.22"}, "vrf": "MGMT"}, test.TestFailure,
+			"RADIUS servers not configured in VRF MGMT: [10.1.1.22]"},
+		{"wrong vrf", map[string]any{"servers": []any{"10.1.1.20"}}, test.TestFailure,
+			"RADIUS servers not configured in VRF default: [10.1.1.20]"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyRadiusServers(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show radius", showRadiusOutput))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRadiusServerGroups(t *testing.T) {
+	tst, _ := NewVerifyRadiusServerGroups(map[string]any{"groups": []any{"RADIUS_PRIMARY", "TACACS_PRIMARY"}})
+	res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show radius", showRadiusOutput))
+	if res.Status != test.TestFailure || !strings.Contains(res.Message, "RADIUS server groups not configured: [TACACS_PRIMARY]") {
+		t.Errorf("got %v %q, want failure for the tacacs+ group", res.Status, res.Message)
+	}
+}
+
+func TestVerifyRadiusSourceIntf(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"match", map[string]any{"interface": "Management1", "vrf": "MGMT"}, test.TestSuccess, ""},
+		{"wrong interface", map[string]any{"interface": "Loopback0", "vrf": "MGMT"}, test.TestFailure,
+			"expected 'Loopback0', got 'Management1'"},
+		{"no source for vrf", map[string]any{"interface": "Loopback0"}, test.TestFailure,
+			"No RADIUS source interface configured for VRF default"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, _ := NewVerifyRadiusSourceIntf(tc.inputs)
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show radius", showRadiusOutput))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
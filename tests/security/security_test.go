@@ -0,0 +1,44 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showAPIHttpCommands = `{"enabled": true, "httpServer": {"configured": false, "running": false, "port": 80},
+  "httpsServer": {"configured": true, "running": true, "port": 443},
+  "vrfs": {
+    "MGMT": {"servers": ["https"], "serverState": "active"},
+    "PROD": {"servers": ["https"], "serverState": "active"},
+    "LAB": {"servers": ["https"], "serverState": "inactive"}
+  }}`
+
+func TestVerifyAPIEnabledVRFs(t *testing.T) {
+	cases := []struct {
+		name    string
+		allowed []any
+		status  test.TestStatus
+		want    string
+	}{
+		{"disallowed vrf", []any{"MGMT"}, test.TestFailure, "eAPI is enabled on disallowed VRFs: [PROD]"},
+		{"all allowed", []any{"MGMT", "PROD"}, test.TestSuccess, "eAPI enabled only on allowed VRFs: [MGMT PROD]"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyAPIEnabledVRFs(map[string]any{"allowed_vrfs": tc.allowed})
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show management api http-commands", showAPIHttpCommands))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
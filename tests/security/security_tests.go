@@ -3,6 +3,7 @@ package security
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
 	"github.com/fluidstackio/go-anta/pkg/test"
@@ -743,3 +744,108 @@ func (t *VerifyAPIIPv6Acl) ValidateInput(input any) error {
 	}
 	return nil
 }
+
+// VerifyAPIEnabledVRFs verifies eAPI is only enabled on the allowed VRFs.
+//
+// Exposing eAPI on data-plane VRFs is a common audit finding; management should
+// normally be reachable only through a dedicated management VRF.
+//
+// Expected Results:
+//   - Success: The test will pass if eAPI is active only on VRFs in `allowed_vrfs`.
+//   - Failure: The test will fail if eAPI is active on any other VRF.
+//   - Error: The test will report an error if eAPI status cannot be retrieved.
+//
+// Examples:
+//   - name: VerifyAPIEnabledVRFs
+//     VerifyAPIEnabledVRFs:
+//     allowed_vrfs:
+//   - "MGMT"
+type VerifyAPIEnabledVRFs struct {
+	test.BaseTest
+	AllowedVRFs []string `yaml:"allowed_vrfs" json:"allowed_vrfs"`
+}
+
+func NewVerifyAPIEnabledVRFs(inputs map[string]any) (test.Test, error) {
+	t := &VerifyAPIEnabledVRFs{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyAPIEnabledVRFs",
+			TestDescription: "Verify eAPI is only enabled on the allowed VRFs",
+			TestCategories:  []string{"security", "api"},
+		},
+	}
+
+	if err := test.GetStringSlice(inputs, "allowed_vrfs", &t.AllowedVRFs); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyAPIEnabledVRFs) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show management api http-commands",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get API HTTP commands status: %v", err)
+		return result, nil
+	}
+
+	apiData, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected output: %v", err)
+		return result, nil
+	}
+
+	allowed := map[string]bool{}
+	for _, vrf := range t.AllowedVRFs {
+		allowed[vrf] = true
+	}
+
+	// A VRF listed without a serverState is treated as active: EOS only
+	// lists VRFs that have the API server configured.
+	enabled := []string{}
+	disallowed := []string{}
+	vrfs, _ := apiData["vrfs"].(map[string]any)
+	for name, raw := range vrfs {
+		vrf, _ := raw.(map[string]any)
+		if state, ok := vrf["serverState"].(string); ok && state != "active" {
+			continue
+		}
+		enabled = append(enabled, name)
+		if !allowed[name] {
+			disallowed = append(disallowed, name)
+		}
+	}
+	sort.Strings(enabled)
+	sort.Strings(disallowed)
+
+	result.Details = map[string]any{"enabled_vrfs": enabled}
+	if len(disallowed) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("eAPI is enabled on disallowed VRFs: %v (allowed: %v)", disallowed, t.AllowedVRFs)
+	} else {
+		result.Message = fmt.Sprintf("eAPI enabled only on allowed VRFs: %v", enabled)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyAPIEnabledVRFs) ValidateInput(input any) error {
+	if len(t.AllowedVRFs) == 0 {
+		return fmt.Errorf("at least one allowed VRF must be specified")
+	}
+	return nil
+}
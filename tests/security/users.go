@@ -0,0 +1,189 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyLocalUsers verifies the expected local user accounts and their privileges.
+//
+// The test performs the following checks:
+//  1. Retrieves local accounts from `show user-account`.
+//  2. Verifies each expected user exists.
+//  3. Validates the privilege level and role, when specified.
+//  4. With `strict`, reports any other privileged account (privilege 15 or the
+//     network-admin role) that is not in the expected list.
+//
+// Expected Results:
+//   - Success: The test will pass if every expected user exists with the expected privilege and role.
+//   - Failure: The test will fail if a user is missing or misconfigured, or (strict) an unexpected privileged user exists.
+//   - Error: The test will report an error if user accounts cannot be retrieved.
+//
+// Examples:
+//
+//   - name: VerifyLocalUsers admin accounts
+//     VerifyLocalUsers:
+//     strict: true
+//     users:
+//
+//   - username: "admin"
+//     privilege: 15
+//     role: "network-admin"
+//
+//   - username: "monitor"
+//     privilege: 1
+//     role: "network-operator"
+type VerifyLocalUsers struct {
+	test.BaseTest
+	Users  []LocalUser `yaml:"users" json:"users"`
+	Strict bool        `yaml:"strict,omitempty" json:"strict,omitempty"`
+}
+
+type LocalUser struct {
+	Username  string `yaml:"username" json:"username"`
+	Privilege int    `yaml:"privilege,omitempty" json:"privilege,omitempty"`
+	Role      string `yaml:"role,omitempty" json:"role,omitempty"`
+}
+
+// privilegedLevel is the EOS privilege level that grants full access.
+const privilegedLevel = 15
+
+func NewVerifyLocalUsers(inputs map[string]any) (test.Test, error) {
+	t := &VerifyLocalUsers{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyLocalUsers",
+			TestDescription: "Verify local user accounts and privileges",
+			TestCategories:  []string{"security", "aaa"},
+		},
+	}
+
+	if err := test.GetBool(inputs, "strict", &t.Strict); err != nil {
+		return nil, err
+	}
+	if inputs != nil {
+		if users, ok := inputs["users"].([]any); ok {
+			for i, item := range users {
+				userMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("users[%d]: expected map, got %T", i, item)
+				}
+				user := LocalUser{}
+				if err := test.GetString(userMap, "username", &user.Username); err != nil {
+					return nil, fmt.Errorf("users[%d]: %w", i, err)
+				}
+				if err := test.GetInt(userMap, "privilege", &user.Privilege); err != nil {
+					return nil, fmt.Errorf("users[%d]: %w", i, err)
+				}
+				if err := test.GetString(userMap, "role", &user.Role); err != nil {
+					return nil, fmt.Errorf("users[%d]: %w", i, err)
+				}
+				t.Users = append(t.Users, user)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyLocalUsers) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show user-account",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get user accounts: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected output: %v", err)
+		return result, nil
+	}
+
+	accounts := map[string]LocalUser{}
+	users, _ := data["users"].(map[string]any)
+	for name, raw := range users {
+		userData, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		account := LocalUser{Username: name}
+		if priv, ok := userData["privLevel"].(float64); ok {
+			account.Privilege = int(priv)
+		}
+		account.Role, _ = userData["userRole"].(string)
+		accounts[name] = account
+	}
+
+	issues := []string{}
+	expected := map[string]bool{}
+	for _, user := range t.Users {
+		expected[user.Username] = true
+		account, ok := accounts[user.Username]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("user %s not configured", user.Username))
+			continue
+		}
+		if user.Privilege > 0 && account.Privilege != user.Privilege {
+			issues = append(issues, fmt.Sprintf("user %s has privilege %d, expected %d", user.Username, account.Privilege, user.Privilege))
+		}
+		if user.Role != "" && account.Role != user.Role {
+			issues = append(issues, fmt.Sprintf("user %s has role '%s', expected '%s'", user.Username, account.Role, user.Role))
+		}
+	}
+
+	if t.Strict {
+		extra := []string{}
+		for name, account := range accounts {
+			if !expected[name] && (account.Privilege >= privilegedLevel || account.Role == "network-admin") {
+				extra = append(extra, name)
+			}
+		}
+		sort.Strings(extra)
+		for _, name := range extra {
+			issues = append(issues, fmt.Sprintf("unexpected privileged user %s (privilege %d, role '%s')", name, accounts[name].Privilege, accounts[name].Role))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Local user issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d local users verified", len(t.Users))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyLocalUsers) ValidateInput(input any) error {
+	if len(t.Users) == 0 {
+		return fmt.Errorf("at least one user must be specified")
+	}
+	for i, user := range t.Users {
+		if user.Username == "" {
+			return fmt.Errorf("users[%d]: username must be specified", i)
+		}
+		if user.Privilege < 0 || user.Privilege > privilegedLevel {
+			return fmt.Errorf("users[%d]: privilege must be between 0 and %d, got %d", i, privilegedLevel, user.Privilege)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package security
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showUserAccount = `{"users": {
+  "admin": {"username": "admin", "privLevel": 15, "userRole": "network-admin"},
+  "monitor": {"username": "monitor", "privLevel": 1, "userRole": "network-operator"},
+  "backdoor": {"username": "backdoor", "privLevel": 15, "userRole": "network-admin"}
+}}`
+
+func TestVerifyLocalUsers(t *testing.T) {
+	user := func(name string, priv int, role string) map[string]any {
+		return map[string]any{"username": name, "privilege": priv, "role": role}
+	}
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		body   string
+		status test.TestStatus
+		want   []string
+	}{
+		{"non-strict ignores extra users", map[string]any{"users": []any{user("admin", 15, "network-admin")}}, showUserAccount,
+			test.TestSuccess, []string{"All 1 local users verified"}},
+		{"missing admin", map[string]any{"users": []any{user("admin", 15, "network-admin")}},
+			`{"users": {"monitor": {"privLevel": 1, "userRole": "network-operator"}}}`,
+			test.TestFailure, []string{"user admin not configured"}},
+		{"wrong privilege", map[string]any{"users": []any{user("monitor", 15, "")}}, showUserAccount,
+			test.TestFailure, []string{"user monitor has privilege 1, expected 15"}},
+		{"strict flags extra privileged user", map[string]any{"strict": true, "users": []any{
+			user("admin", 15, "network-admin"), user("monitor", 1, "network-operator"),
+		}}, showUserAccount, test.TestFailure, []string{
+			"unexpected privileged user backdoor (privilege 15, role 'network-admin')",
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyLocalUsers(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show user-account", tc.body))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(res.Message, want) {
+					t.Errorf("message %q should contain %q", res.Message, want)
+				}
+			}
+		})
+	}
+}
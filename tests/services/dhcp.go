@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyDhcpRelayStatus verifies DHCP relay is active and forwards to the expected servers.
+//
+// This test performs the following checks:
+//  1. Confirms the DHCP relay agent is active.
+//  2. Verifies each expected server is configured as a relay destination on at
+//     least one interface in the given VRF (default VRF when omitted).
+//
+// Expected Results:
+//   - Success: The relay is active and every expected server is configured.
+//   - Failure: The relay is inactive or a server is missing.
+//   - Error: Unable to retrieve DHCP relay information from the device.
+//
+// Examples:
+//   - name: VerifyDhcpRelayStatus
+//     VerifyDhcpRelayStatus:
+//     servers:
+//   - "10.100.0.10"
+//   - "10.100.0.11"
+//     vrf: "default"
+type VerifyDhcpRelayStatus struct {
+	test.BaseTest
+	Servers []string `yaml:"servers" json:"servers"`
+	VRF     string   `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyDhcpRelayStatus(inputs map[string]any) (test.Test, error) {
+	t := &VerifyDhcpRelayStatus{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyDhcpRelayStatus",
+			TestDescription: "Verify DHCP relay is active with the expected servers",
+			TestCategories:  []string{"services", "dhcp"},
+		},
+		VRF: "default",
+	}
+
+	if err := test.GetStringSlice(inputs, "servers", &t.Servers); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "vrf", &t.VRF); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyDhcpRelayStatus) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show ip dhcp relay",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get DHCP relay status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected DHCP relay output: %v", err)
+		return result, nil
+	}
+
+	if active, _ := data["activeState"].(bool); !active {
+		result.Status = test.TestFailure
+		result.Message = "DHCP relay is not active"
+		return result, nil
+	}
+
+	configured := dhcpRelayServers(data, t.VRF)
+	missing := []string{}
+	for _, server := range t.Servers {
+		if !configured[server] {
+			missing = append(missing, server)
+		}
+	}
+
+	if len(missing) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("DHCP relay servers not configured in VRF %s: %s", t.VRF, strings.Join(missing, ", "))
+	} else {
+		result.Message = fmt.Sprintf("DHCP relay active with %d expected servers in VRF %s", len(t.Servers), t.VRF)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyDhcpRelayStatus) ValidateInput(input any) error {
+	if len(t.Servers) == 0 {
+		return fmt.Errorf("at least one DHCP server must be specified")
+	}
+	for i, server := range t.Servers {
+		if net.ParseIP(server) == nil {
+			return fmt.Errorf("servers[%d]: invalid IP address %q", i, server)
+		}
+	}
+	return nil
+}
+
+// dhcpRelayServers collects the relay destinations configured on every
+// interface in vrf. Server entries may be plain strings or {"address": ...}.
+// Interfaces without a "vrf" key are in the default VRF.
+func dhcpRelayServers(data map[string]any, vrf string) map[string]bool {
+	servers := map[string]bool{}
+	interfaces, _ := data["interfaces"].(map[string]any)
+	for _, intfData := range interfaces {
+		intf, ok := intfData.(map[string]any)
+		if !ok {
+			continue
+		}
+		intfVRF, _ := intf["vrf"].(string)
+		if intfVRF == "" {
+			intfVRF = "default"
+		}
+		if intfVRF != vrf {
+			continue
+		}
+		list, _ := intf["dhcpServersV4"].([]any)
+		for _, item := range list {
+			switch v := item.(type) {
+			case string:
+				servers[v] = true
+			case map[string]any:
+				if addr, ok := v["address"].(string); ok {
+					servers[addr] = true
+				}
+			}
+		}
+	}
+	return servers
+}
+
+// VerifyDhcpServerEnabled verifies the local DHCPv4 server is enabled in a VRF.
+//
+// Expected Results:
+//   - Success: The DHCP server is enabled in the VRF.
+//   - Failure: The DHCP server is disabled (the reason is reported when available) or the VRF is unknown.
+//   - Error: Unable to retrieve DHCP server information from the device.
+//
+// Examples:
+//   - name: VerifyDhcpServerEnabled
+//     VerifyDhcpServerEnabled:
+//     vrf: "MGMT"
+type VerifyDhcpServerEnabled struct {
+	test.BaseTest
+	VRF string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifyDhcpServerEnabled(inputs map[string]any) (test.Test, error) {
+	t := &VerifyDhcpServerEnabled{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyDhcpServerEnabled",
+			TestDescription: "Verify the DHCP server is enabled",
+			TestCategories:  []string{"services", "dhcp"},
+		},
+		VRF: "default",
+	}
+
+	if err := test.GetString(inputs, "vrf", &t.VRF); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyDhcpServerEnabled) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: fmt.Sprintf("show dhcp server vrf %s", t.VRF),
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get DHCP server status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected DHCP server output: %v", err)
+		return result, nil
+	}
+
+	vrfs, _ := data["vrfs"].(map[string]any)
+	vrfData, ok := vrfs[t.VRF].(map[string]any)
+	if !ok {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("DHCP server not configured in VRF %s", t.VRF)
+		return result, nil
+	}
+
+	if disabled, _ := vrfData["ipv4ServerDisabled"].(bool); disabled {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("DHCP server is disabled in VRF %s", t.VRF)
+		if reasons := dhcpDisabledReasons(vrfData["ipv4DisabledReasons"]); len(reasons) > 0 {
+			result.Message += fmt.Sprintf(" (%s)", strings.Join(reasons, ", "))
+		}
+		return result, nil
+	}
+
+	result.Message = fmt.Sprintf("DHCP server is enabled in VRF %s", t.VRF)
+	return result, nil
+}
+
+func (t *VerifyDhcpServerEnabled) ValidateInput(input any) error {
+	if t.VRF == "" {
+		return fmt.Errorf("vrf must not be empty")
+	}
+	return nil
+}
+
+// dhcpDisabledReasons accepts either a list of reason strings or a map of
+// reason -> bool and returns the active reasons, sorted.
+func dhcpDisabledReasons(raw any) []string {
+	reasons := []string{}
+	switch v := raw.(type) {
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				reasons = append(reasons, s)
+			}
+		}
+	case map[string]any:
+		for reason, set := range v {
+			if b, _ := set.(bool); b {
+				reasons = append(reasons, reason)
+			}
+		}
+	}
+	sort.Strings(reasons)
+	return reasons
+}
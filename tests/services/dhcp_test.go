@@ -0,0 +1,80 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const dhcpRelay = `{"activeState": true, "alwaysOn": false, "interfaces": {
+  "Vlan10": {"dhcpServersV4": ["10.100.0.10"]},
+  "Vlan20": {"dhcpServersV4": [{"address": "10.100.0.11"}]},
+  "Vlan30": {"vrf": "PROD", "dhcpServersV4": ["10.200.0.10"]}
+}}`
+
+func TestVerifyDhcpRelayStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"all servers", map[string]any{"servers": []any{"10.100.0.10", "10.100.0.11"}}, dhcpRelay,
+			test.TestSuccess, "2 expected servers in VRF default"},
+		{"missing server", map[string]any{"servers": []any{"10.100.0.10", "10.100.0.12"}}, dhcpRelay,
+			test.TestFailure, "DHCP relay servers not configured in VRF default: 10.100.0.12"},
+		{"server in other vrf", map[string]any{"servers": []any{"10.200.0.10"}}, dhcpRelay,
+			test.TestFailure, "not configured in VRF default: 10.200.0.10"},
+		{"vrf scoped", map[string]any{"servers": []any{"10.200.0.10"}, "vrf": "PROD"}, dhcpRelay,
+			test.TestSuccess, "in VRF PROD"},
+		{"relay inactive", map[string]any{"servers": []any{"10.100.0.10"}}, `{"activeState": false, "interfaces": {}}`,
+			test.TestFailure, "DHCP relay is not active"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyDhcpRelayStatus(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show ip dhcp relay", tc.body))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyDhcpServerEnabled(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"enabled", `{"vrfs": {"MGMT": {"ipv4ServerDisabled": false}}}`, test.TestSuccess, "enabled in VRF MGMT"},
+		{"disabled", `{"vrfs": {"MGMT": {"ipv4ServerDisabled": true, "ipv4DisabledReasons": ["noIpAddressOnInterface"]}}}`,
+			test.TestFailure, "DHCP server is disabled in VRF MGMT (noIpAddressOnInterface)"},
+		{"no vrf", `{"vrfs": {}}`, test.TestFailure, "not configured in VRF MGMT"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyDhcpServerEnabled(map[string]any{"vrf": "MGMT"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show dhcp server vrf MGMT", tc.body))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
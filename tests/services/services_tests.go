@@ -32,6 +32,7 @@ func NewVerifyHostname(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyHostname",
 			TestDescription: "Verify the hostname of a device",
 			TestCategories:  []string{"services", "hostname"},
+			TestSeverity:    test.SeverityMinor,
 		},
 	}
 
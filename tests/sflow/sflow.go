@@ -0,0 +1,370 @@
+package sflow
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifySflowStatus verifies sFlow is enabled and exporting to the expected collectors.
+//
+// This test performs the following checks:
+//  1. Verifies that sFlow is globally enabled.
+//  2. If `sampling_rate` is set, validates the global sampling rate.
+//  3. For each entry in `collectors`, confirms the collector is configured
+//     on the given VRF (default VRF when omitted) and, when set, port.
+//
+// Expected Results:
+//   - Success: sFlow is enabled with the expected sampling rate and collectors.
+//   - Failure: sFlow is disabled, the sampling rate differs, or a collector is missing.
+//   - Error: Unable to retrieve sFlow information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifySflowStatus"
+//     module: "sflow"
+//     inputs:
+//     sampling_rate: 16384
+//     collectors:
+//   - address: "10.0.0.10"
+//     port: 6343
+//     vrf: "MGMT"
+type VerifySflowStatus struct {
+	test.BaseTest
+	SamplingRate int         `yaml:"sampling_rate,omitempty" json:"sampling_rate,omitempty"`
+	Collectors   []Collector `yaml:"collectors,omitempty" json:"collectors,omitempty"`
+}
+
+type Collector struct {
+	Address string `yaml:"address" json:"address"`
+	Port    int    `yaml:"port,omitempty" json:"port,omitempty"`
+	VRF     string `yaml:"vrf,omitempty" json:"vrf,omitempty"`
+}
+
+func NewVerifySflowStatus(inputs map[string]any) (test.Test, error) {
+	t := &VerifySflowStatus{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifySflowStatus",
+			TestDescription: "Verify sFlow is enabled with expected sampling rate and collectors",
+			TestCategories:  []string{"sflow"},
+		},
+	}
+
+	if err := test.GetInt(inputs, "sampling_rate", &t.SamplingRate); err != nil {
+		return nil, err
+	}
+
+	if inputs != nil {
+		if collectors, ok := inputs["collectors"].([]any); ok {
+			for i, item := range collectors {
+				collectorMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("collectors[%d]: expected map, got %T", i, item)
+				}
+				collector := Collector{VRF: "default"}
+				if err := test.GetString(collectorMap, "address", &collector.Address); err != nil {
+					return nil, fmt.Errorf("collectors[%d]: %w", i, err)
+				}
+				if err := test.GetInt(collectorMap, "port", &collector.Port); err != nil {
+					return nil, fmt.Errorf("collectors[%d]: %w", i, err)
+				}
+				if err := test.GetString(collectorMap, "vrf", &collector.VRF); err != nil {
+					return nil, fmt.Errorf("collectors[%d]: %w", i, err)
+				}
+				t.Collectors = append(t.Collectors, collector)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifySflowStatus) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show sflow",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get sFlow status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected sFlow output: %v", err)
+		return result, nil
+	}
+
+	if enabled, _ := data["enabled"].(bool); !enabled {
+		result.Status = test.TestFailure
+		result.Message = "sFlow is disabled"
+		return result, nil
+	}
+
+	issues := []string{}
+
+	if t.SamplingRate > 0 {
+		if rate, _ := data["sampleRate"].(float64); int(rate) != t.SamplingRate {
+			issues = append(issues, fmt.Sprintf("sampling rate is %d, expected %d", int(rate), t.SamplingRate))
+		}
+	}
+
+	destinations := sflowDestinations(data)
+	for _, collector := range t.Collectors {
+		if !hasSflowCollector(destinations[collector.VRF], collector) {
+			label := collector.Address
+			if collector.Port > 0 {
+				label = fmt.Sprintf("%s:%d", collector.Address, collector.Port)
+			}
+			issues = append(issues, fmt.Sprintf("collector %s not configured in VRF %s", label, collector.VRF))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("sFlow issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("sFlow enabled with %d collectors verified", len(t.Collectors))
+	}
+
+	return result, nil
+}
+
+func (t *VerifySflowStatus) ValidateInput(input any) error {
+	if t.SamplingRate < 0 {
+		return fmt.Errorf("sampling_rate must be positive")
+	}
+	for i, collector := range t.Collectors {
+		if collector.Address == "" {
+			return fmt.Errorf("collectors[%d]: address is required", i)
+		}
+		if collector.Port < 0 || collector.Port > 65535 {
+			return fmt.Errorf("collectors[%d]: invalid port %d", i, collector.Port)
+		}
+	}
+	return nil
+}
+
+// sflowDestination is a single collector entry from `show sflow`.
+type sflowDestination struct {
+	Address string
+	Port    int
+}
+
+// sflowDestinations returns configured collectors keyed by VRF. EOS reports
+// per-VRF destinations under `vrfs`; older releases only list default-VRF
+// destinations at the top level.
+func sflowDestinations(data map[string]any) map[string][]sflowDestination {
+	destinations := map[string][]sflowDestination{}
+	collect := func(vrf string, m map[string]any) {
+		for _, key := range []string{"ipv4Destinations", "ipv6Destinations"} {
+			list, _ := m[key].([]any)
+			for _, item := range list {
+				entry, ok := item.(map[string]any)
+				if !ok {
+					continue
+				}
+				dest := sflowDestination{}
+				if addr, ok := entry["ipv4Address"].(string); ok {
+					dest.Address = addr
+				} else if addr, ok := entry["ipv6Address"].(string); ok {
+					dest.Address = addr
+				}
+				if port, ok := entry["port"].(float64); ok {
+					dest.Port = int(port)
+				}
+				destinations[vrf] = append(destinations[vrf], dest)
+			}
+		}
+	}
+
+	collect("default", data)
+	if vrfs, ok := data["vrfs"].(map[string]any); ok {
+		for vrf, raw := range vrfs {
+			if vrfData, ok := raw.(map[string]any); ok {
+				collect(vrf, vrfData)
+			}
+		}
+	}
+	return destinations
+}
+
+func hasSflowCollector(destinations []sflowDestination, collector Collector) bool {
+	for _, dest := range destinations {
+		if dest.Address == collector.Address && (collector.Port == 0 || dest.Port == collector.Port) {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifySflowSamplingRate verifies sFlow is running on interfaces at the expected sampling rate.
+//
+// This test performs the following checks for each specified interface:
+//  1. Confirms sFlow is enabled on the interface.
+//  2. Validates the interface sampling rate equals its `sampling_rate`, or
+//     the top-level `sampling_rate` when the interface does not set one.
+//
+// Expected Results:
+//   - Success: sFlow samples every interface at the expected rate.
+//   - Failure: sFlow is disabled, or an interface is missing, not sampling, or sampling at a different rate.
+//   - Error: Unable to retrieve sFlow interface information from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifySflowSamplingRate"
+//     module: "sflow"
+//     inputs:
+//     sampling_rate: 16384
+//     interfaces:
+//   - name: "Ethernet1"
+//   - name: "Ethernet2"
+//     sampling_rate: 4096
+type VerifySflowSamplingRate struct {
+	test.BaseTest
+	SamplingRate int                 `yaml:"sampling_rate,omitempty" json:"sampling_rate,omitempty"`
+	Interfaces   []SamplingInterface `yaml:"interfaces" json:"interfaces"`
+}
+
+type SamplingInterface struct {
+	Name         string `yaml:"name" json:"name"`
+	SamplingRate int    `yaml:"sampling_rate,omitempty" json:"sampling_rate,omitempty"`
+}
+
+func NewVerifySflowSamplingRate(inputs map[string]any) (test.Test, error) {
+	t := &VerifySflowSamplingRate{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifySflowSamplingRate",
+			TestDescription: "Verify per-interface sFlow sampling rates",
+			TestCategories:  []string{"sflow"},
+		},
+	}
+
+	if err := test.GetInt(inputs, "sampling_rate", &t.SamplingRate); err != nil {
+		return nil, err
+	}
+
+	if inputs != nil {
+		if intfs, ok := inputs["interfaces"].([]any); ok {
+			for i, item := range intfs {
+				intfMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("interfaces[%d]: expected map, got %T", i, item)
+				}
+				intf := SamplingInterface{}
+				if err := test.GetString(intfMap, "name", &intf.Name); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				if err := test.GetInt(intfMap, "sampling_rate", &intf.SamplingRate); err != nil {
+					return nil, fmt.Errorf("interfaces[%d]: %w", i, err)
+				}
+				t.Interfaces = append(t.Interfaces, intf)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifySflowSamplingRate) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show sflow interfaces",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get sFlow interface status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected sFlow interface output: %v", err)
+		return result, nil
+	}
+
+	if enabled, ok := data["enabled"].(bool); ok && !enabled {
+		result.Status = test.TestFailure
+		result.Message = "sFlow is disabled"
+		return result, nil
+	}
+
+	interfaces, _ := data["interfaces"].(map[string]any)
+	issues := []string{}
+
+	for _, intf := range t.Interfaces {
+		expected := intf.SamplingRate
+		if expected == 0 {
+			expected = t.SamplingRate
+		}
+
+		info, ok := interfaces[intf.Name].(map[string]any)
+		if !ok {
+			issues = append(issues, fmt.Sprintf("%s: sFlow not enabled", intf.Name))
+			continue
+		}
+		if enabled, ok := info["enabled"].(bool); ok && !enabled {
+			issues = append(issues, fmt.Sprintf("%s: sFlow not enabled", intf.Name))
+			continue
+		}
+		if rate, _ := info["sampleRate"].(float64); int(rate) != expected {
+			issues = append(issues, fmt.Sprintf("%s: sampling rate is %d, expected %d", intf.Name, int(rate), expected))
+		}
+	}
+
+	if len(issues) > 0 {
+		sort.Strings(issues)
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("sFlow sampling issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d interfaces sampling at expected rate", len(t.Interfaces))
+	}
+
+	return result, nil
+}
+
+func (t *VerifySflowSamplingRate) ValidateInput(input any) error {
+	if len(t.Interfaces) == 0 {
+		return fmt.Errorf("at least one interface must be specified")
+	}
+	if t.SamplingRate < 0 {
+		return fmt.Errorf("sampling_rate must be positive")
+	}
+	for i, intf := range t.Interfaces {
+		if intf.Name == "" {
+			return fmt.Errorf("interfaces[%d]: name is required", i)
+		}
+		if intf.SamplingRate < 0 {
+			return fmt.Errorf("interfaces[%d]: sampling_rate must be positive", i)
+		}
+		if intf.SamplingRate == 0 && t.SamplingRate == 0 {
+			return fmt.Errorf("interfaces[%d]: sampling_rate is required when no default is set", i)
+		}
+	}
+	return nil
+}
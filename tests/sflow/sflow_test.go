@@ -0,0 +1,93 @@
+package sflow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const showSflow = `{
+  "enabled": true,
+  "sampleRate": 16384,
+  "ipv4Destinations": [{"ipv4Address": "10.0.0.10", "port": 6343}],
+  "vrfs": {"MGMT": {"ipv4Destinations": [{"ipv4Address": "192.0.2.5", "port": 6343}]}}
+}`
+
+func TestVerifySflowStatus(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{"healthy", map[string]any{
+			"sampling_rate": 16384,
+			"collectors": []any{
+				map[string]any{"address": "10.0.0.10", "port": 6343},
+				map[string]any{"address": "192.0.2.5", "vrf": "MGMT"},
+			},
+		}, showSflow, test.TestSuccess, "2 collectors verified"},
+		{"wrong sampling rate", map[string]any{"sampling_rate": 4096}, showSflow, test.TestFailure,
+			"sampling rate is 16384, expected 4096"},
+		{"missing collector", map[string]any{
+			"collectors": []any{map[string]any{"address": "192.0.2.5"}},
+		}, showSflow, test.TestFailure, "collector 192.0.2.5 not configured in VRF default"},
+		{"disabled", map[string]any{}, `{"enabled": false}`, test.TestFailure, "sFlow is disabled"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifySflowStatus(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show sflow", tc.output))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifySflowSamplingRate(t *testing.T) {
+	dev := device.NewFakeDevice().OnJSON(t, "show sflow interfaces", `{"enabled": true, "interfaces": {
+  "Ethernet1": {"enabled": true, "sampleRate": 16384},
+  "Ethernet2": {"enabled": true, "sampleRate": 16384}
+}}`)
+
+	tst, err := NewVerifySflowSamplingRate(map[string]any{
+		"sampling_rate": 16384,
+		"interfaces": []any{
+			map[string]any{"name": "Ethernet1"},
+			map[string]any{"name": "Ethernet2", "sampling_rate": 4096},
+			map[string]any{"name": "Ethernet3"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err != nil {
+		t.Fatalf("ValidateInput: %v", err)
+	}
+
+	res := test.RunWithFake(t, tst, dev)
+	if res.Status != test.TestFailure {
+		t.Fatalf("status = %v, want failure (%s)", res.Status, res.Message)
+	}
+	for _, want := range []string{"Ethernet2: sampling rate is 16384, expected 4096", "Ethernet3: sFlow not enabled"} {
+		if !strings.Contains(res.Message, want) {
+			t.Errorf("message %q should contain %q", res.Message, want)
+		}
+	}
+	if strings.Contains(res.Message, "Ethernet1") {
+		t.Errorf("Ethernet1 should pass: %s", res.Message)
+	}
+}
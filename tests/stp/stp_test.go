@@ -0,0 +1,51 @@
+package stp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyStpPvstSimulation(t *testing.T) {
+	const inconsistent = `{"spanningTreeInstances": {
+  "MST0": {"interfaces": {
+    "Ethernet48": {"inconsistency": "PVST simulation inconsistent"},
+    "Port-Channel10": {"inconsistency": "PVST simulation inconsistent"},
+    "Ethernet5": {"inconsistency": "Loop inconsistent"}
+  }}
+}}`
+	cases := []struct {
+		name   string
+		inputs map[string]interface{}
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"no inconsistent ports", nil, `{"spanningTreeInstances": {}}`, test.TestSuccess, ""},
+		{"pvst inconsistent port", nil, inconsistent, test.TestFailure,
+			"PVST simulation inconsistent ports: Ethernet48 (MST0): PVST simulation inconsistent; " +
+				"Port-Channel10 (MST0): PVST simulation inconsistent"},
+		{"allowed port", map[string]interface{}{"allowed_interfaces": []interface{}{"ethernet48"}}, inconsistent,
+			test.TestFailure, "PVST simulation inconsistent ports: Port-Channel10 (MST0): PVST simulation inconsistent"},
+		{"only other inconsistencies", map[string]interface{}{"allowed_interfaces": []interface{}{"Ethernet48", "Port-Channel10"}},
+			inconsistent, test.TestSuccess, ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyStpPvstSimulation(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show spanning-tree inconsistentports", tc.body)
+			res, err := tst.Execute(context.Background(), dev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Status != tc.status || res.Message != tc.want {
+				t.Errorf("result = %v %q, want %v %q", res.Status, res.Message, tc.status, tc.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package stp
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/fluidstackio/go-anta/pkg/device"
@@ -757,3 +758,126 @@ func contains(slice []string, item string) bool {
 	}
 	return false
 }
+
+// VerifyStpPvstSimulation verifies no interface is blocked by a PVST simulation inconsistency.
+//
+// When an RSTP or MSTP bridge meets a PVST+ domain, PVST simulation keeps
+// the two consistent by checking the per-VLAN BPDUs against the CIST.
+// If they disagree, for example a PVST+ bridge claiming to be root for
+// one VLAN but not another, the port is put in an inconsistent, blocked
+// state until the BPDUs agree again. This test reads
+// `show spanning-tree inconsistentports` and reports every interface
+// whose inconsistency is PVST related, with its type.
+//
+// Expected Results:
+//   - Success: The test will pass if no interface outside `allowed_interfaces` is PVST inconsistent.
+//   - Failure: The test will fail if any other interface is PVST inconsistent.
+//   - Error: The test will report an error if STP inconsistent ports cannot be retrieved.
+//
+// Examples:
+//   - name: VerifyStpPvstSimulation tolerating a known legacy uplink
+//     VerifyStpPvstSimulation:
+//     allowed_interfaces: ["Ethernet48"]
+type VerifyStpPvstSimulation struct {
+	test.BaseTest
+	AllowedInterfaces []string `yaml:"allowed_interfaces,omitempty" json:"allowed_interfaces,omitempty"`
+}
+
+func NewVerifyStpPvstSimulation(inputs map[string]interface{}) (test.Test, error) {
+	t := &VerifyStpPvstSimulation{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyStpPvstSimulation",
+			TestDescription: "Verify no interface is PVST simulation inconsistent",
+			TestCategories:  []string{"stp", "pvst"},
+		},
+	}
+
+	if err := test.GetStringSlice(inputs, "allowed_interfaces", &t.AllowedInterfaces); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyStpPvstSimulation) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show spanning-tree inconsistentports",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get STP inconsistent ports: %v", err)
+		return result, nil
+	}
+
+	stpData, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected STP inconsistent-ports output: %v", err)
+		return result, nil
+	}
+
+	allowed := map[string]bool{}
+	for _, intf := range t.AllowedInterfaces {
+		allowed[strings.ToLower(intf)] = true
+	}
+
+	// Like blockedports, spanningTreeInstances is keyed by instance label
+	// ("MST0", "VL10") and lists the affected interfaces of each.
+	issues := []string{}
+	if instances, ok := stpData["spanningTreeInstances"].(map[string]interface{}); ok {
+		for _, instName := range sortedKeys(instances) {
+			inst, ok := instances[instName].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			interfaces, ok := inst["interfaces"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, intfName := range sortedKeys(interfaces) {
+				intf, ok := interfaces[intfName].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				inconsistency, _ := intf["inconsistency"].(string)
+				if !strings.Contains(strings.ToLower(inconsistency), "pvst") || allowed[strings.ToLower(intfName)] {
+					continue
+				}
+				issues = append(issues, fmt.Sprintf("%s (%s): %s", intfName, instName, inconsistency))
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("PVST simulation inconsistent ports: %s", strings.Join(issues, "; "))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyStpPvstSimulation) ValidateInput(input interface{}) error {
+	return nil
+}
+
+// sortedKeys returns the keys of m in order, so messages list instances
+// and interfaces the same way on every run.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
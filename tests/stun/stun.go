@@ -0,0 +1,255 @@
+package stun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyStunClient verifies the STUN client has learned the expected public address mappings.
+//
+// This test performs the following checks for each specified translation:
+//  1. Confirms a binding exists for the source address and port.
+//  2. If `public_address` is set, validates the learned public IP.
+//  3. If `public_port` is set, validates the learned public port.
+//
+// Expected Results:
+//   - Success: Every source address/port has a binding with the expected public mapping.
+//   - Failure: A binding is missing or its public address or port differs.
+//   - Error: Unable to retrieve STUN client translations from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyStunClient"
+//     module: "stun"
+//     inputs:
+//     stun_clients:
+//   - source_address: "172.18.3.2"
+//     source_port: 4500
+//     public_address: "192.0.2.10"
+//     public_port: 6006
+type VerifyStunClient struct {
+	test.BaseTest
+	StunClients []StunTranslation `yaml:"stun_clients" json:"stun_clients"`
+}
+
+type StunTranslation struct {
+	SourceAddress string `yaml:"source_address" json:"source_address"`
+	SourcePort    int    `yaml:"source_port,omitempty" json:"source_port,omitempty"`
+	PublicAddress string `yaml:"public_address,omitempty" json:"public_address,omitempty"`
+	PublicPort    int    `yaml:"public_port,omitempty" json:"public_port,omitempty"`
+}
+
+func NewVerifyStunClient(inputs map[string]any) (test.Test, error) {
+	t := &VerifyStunClient{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyStunClient",
+			TestDescription: "Verify STUN client public address translations",
+			TestCategories:  []string{"stun"},
+		},
+	}
+
+	if inputs != nil {
+		if clients, ok := inputs["stun_clients"].([]any); ok {
+			for i, item := range clients {
+				clientMap, ok := item.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("stun_clients[%d]: expected map, got %T", i, item)
+				}
+				tr := StunTranslation{SourcePort: 4500}
+				if err := test.GetString(clientMap, "source_address", &tr.SourceAddress); err != nil {
+					return nil, fmt.Errorf("stun_clients[%d]: %w", i, err)
+				}
+				if err := test.GetInt(clientMap, "source_port", &tr.SourcePort); err != nil {
+					return nil, fmt.Errorf("stun_clients[%d]: %w", i, err)
+				}
+				if err := test.GetString(clientMap, "public_address", &tr.PublicAddress); err != nil {
+					return nil, fmt.Errorf("stun_clients[%d]: %w", i, err)
+				}
+				if err := test.GetInt(clientMap, "public_port", &tr.PublicPort); err != nil {
+					return nil, fmt.Errorf("stun_clients[%d]: %w", i, err)
+				}
+				t.StunClients = append(t.StunClients, tr)
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyStunClient) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	issues := []string{}
+
+	for i, expected := range t.StunClients {
+		if test.Cancelled(ctx, result, i, len(t.StunClients), "clients", issues) {
+			return result, nil
+		}
+		label := fmt.Sprintf("%s:%d", expected.SourceAddress, expected.SourcePort)
+
+		cmd := device.Command{
+			Template: fmt.Sprintf("show stun client translations %s %d", expected.SourceAddress, expected.SourcePort),
+			Format:   "json",
+			UseCache: false,
+		}
+
+		cmdResult, err := dev.Execute(ctx, cmd)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get STUN translations for %s: %v", label, err)
+			return result, nil
+		}
+
+		data, err := test.AsMap(cmdResult.Output)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Unexpected STUN translation output: %v", err)
+			return result, nil
+		}
+
+		binding := findStunBinding(data, expected.SourceAddress, expected.SourcePort)
+		if binding == nil {
+			issues = append(issues, fmt.Sprintf("Source %s: no STUN translation found", label))
+			continue
+		}
+
+		public, _ := binding["publicAddress"].(map[string]any)
+		publicIP, _ := public["ip"].(string)
+		publicPort, _ := public["port"].(float64)
+		if expected.PublicAddress != "" && publicIP != expected.PublicAddress {
+			issues = append(issues, fmt.Sprintf("Source %s: public address is %s, expected %s", label, publicIP, expected.PublicAddress))
+		}
+		if expected.PublicPort != 0 && int(publicPort) != expected.PublicPort {
+			issues = append(issues, fmt.Sprintf("Source %s: public port is %d, expected %d", label, int(publicPort), expected.PublicPort))
+		}
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("STUN client issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("All %d STUN translations verified", len(t.StunClients))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyStunClient) ValidateInput(input any) error {
+	if len(t.StunClients) == 0 {
+		return fmt.Errorf("at least one STUN client must be specified")
+	}
+	for i, tr := range t.StunClients {
+		if tr.SourceAddress == "" {
+			return fmt.Errorf("stun_clients[%d]: source_address is required", i)
+		}
+		if tr.SourcePort < 1 || tr.SourcePort > 65535 {
+			return fmt.Errorf("stun_clients[%d]: invalid source_port %d", i, tr.SourcePort)
+		}
+		if tr.PublicPort < 0 || tr.PublicPort > 65535 {
+			return fmt.Errorf("stun_clients[%d]: invalid public_port %d", i, tr.PublicPort)
+		}
+	}
+	return nil
+}
+
+// findStunBinding returns the binding whose sourceAddress matches; EOS keys
+// `bindings` by an opaque transaction ID.
+func findStunBinding(data map[string]any, address string, port int) map[string]any {
+	bindings, _ := data["bindings"].(map[string]any)
+	for _, raw := range bindings {
+		binding, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		source, _ := binding["sourceAddress"].(map[string]any)
+		ip, _ := source["ip"].(string)
+		p, _ := source["port"].(float64)
+		if ip == address && int(p) == port {
+			return binding
+		}
+	}
+	return nil
+}
+
+// VerifyStunServer verifies the STUN server is enabled and running.
+//
+// Expected Results:
+//   - Success: The STUN server is enabled and its process is running.
+//   - Failure: The STUN server is disabled or not running.
+//   - Error: Unable to retrieve STUN server status from the device.
+//
+// Example YAML configuration:
+//   - name: "VerifyStunServer"
+//     module: "stun"
+//     inputs: {}
+type VerifyStunServer struct {
+	test.BaseTest
+}
+
+func NewVerifyStunServer(inputs map[string]any) (test.Test, error) {
+	t := &VerifyStunServer{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyStunServer",
+			TestDescription: "Verify the STUN server is enabled and running",
+			TestCategories:  []string{"stun"},
+		},
+	}
+
+	return t, nil
+}
+
+func (t *VerifyStunServer) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show stun server status",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get STUN server status: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected STUN server output: %v", err)
+		return result, nil
+	}
+
+	enabled, _ := data["enabled"].(bool)
+	pid, _ := data["pid"].(float64)
+	switch {
+	case !enabled:
+		result.Status = test.TestFailure
+		result.Message = "STUN server is disabled"
+	case pid == 0:
+		result.Status = test.TestFailure
+		result.Message = "STUN server is enabled but not running"
+	default:
+		result.Message = fmt.Sprintf("STUN server running (pid %d)", int(pid))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyStunServer) ValidateInput(input any) error {
+	return nil
+}
@@ -0,0 +1,69 @@
+package stun
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyStunClient(t *testing.T) {
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show stun client translations 172.18.3.2 4500", `{"bindings": {
+  "000000010a64ff0100000000": {
+    "sourceAddress": {"ip": "172.18.3.2", "port": 4500},
+    "publicAddress": {"ip": "192.0.2.10", "port": 6006}
+  }
+}}`).
+		OnJSON(t, "show stun client translations 172.18.4.2 4500", `{"bindings": {}}`)
+
+	cases := []struct {
+		name   string
+		client map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"expected mapping", map[string]any{"source_address": "172.18.3.2", "public_address": "192.0.2.10",
+			"public_port": 6006}, test.TestSuccess, "1 STUN translations verified"},
+		{"wrong public mapping", map[string]any{"source_address": "172.18.3.2", "source_port": 4500,
+			"public_address": "192.0.2.20", "public_port": 7007}, test.TestFailure,
+			"Source 172.18.3.2:4500: public address is 192.0.2.10, expected 192.0.2.20; Source 172.18.3.2:4500: public port is 6006, expected 7007"},
+		{"missing binding", map[string]any{"source_address": "172.18.4.2"}, test.TestFailure,
+			"Source 172.18.4.2:4500: no STUN translation found"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyStunClient(map[string]any{"stun_clients": []any{tc.client}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyStunServer(t *testing.T) {
+	cases := []struct {
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{`{"enabled": true, "pid": 1234}`, test.TestSuccess, "pid 1234"},
+		{`{"enabled": false, "pid": 0}`, test.TestFailure, "STUN server is disabled"},
+		{`{"enabled": true, "pid": 0}`, test.TestFailure, "not running"},
+	}
+	for _, tc := range cases {
+		tst, _ := NewVerifyStunServer(nil)
+		res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show stun server status", tc.output))
+		if res.Status != tc.status || !strings.Contains(res.Message, tc.want) {
+			t.Errorf("%s: got %v %q, want %v containing %q", tc.output, res.Status, res.Message, tc.status, tc.want)
+		}
+	}
+}
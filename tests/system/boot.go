@@ -0,0 +1,161 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyBootImage verifies the next-boot image is the one expected and is
+// present on flash.
+//
+// The test performs the following checks:
+//  1. Reads the configured image from `show boot-config`.
+//  2. Confirms it matches `expected_image`, when given.
+//  3. Compares the EOS version in the image file name with the running
+//     version from `show version`, so a device that would come back on a
+//     different release after reload is caught before the reload. Image
+//     names without a version (e.g. flash:/EOS.swi) skip this check.
+//  4. Confirms the image file is listed by `dir flash:`.
+//
+// Expected Results:
+//   - Success: The boot image is set, expected, matches the running version and exists on flash.
+//   - Failure: No boot image is set, it differs from the expected or running image, or it is missing.
+//   - Error: Unable to retrieve the boot configuration, version or flash listing.
+//
+// Examples:
+//   - name: VerifyBootImage
+//     VerifyBootImage:
+//     expected_image: flash:/EOS-4.30.1F.swi
+type VerifyBootImage struct {
+	test.BaseTest
+	ExpectedImage string `yaml:"expected_image,omitempty" json:"expected_image,omitempty"`
+}
+
+func NewVerifyBootImage(inputs map[string]any) (test.Test, error) {
+	t := &VerifyBootImage{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyBootImage",
+			TestDescription: "Verify the boot image matches the running image and exists on flash",
+			TestCategories:  []string{"system", "software"},
+		},
+	}
+
+	if err := test.GetString(inputs, "expected_image", &t.ExpectedImage); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// eosImageVersion matches the release in an image name such as
+// EOS-4.30.1F.swi or EOS64-4.31.2.1M.swi.
+var eosImageVersion = regexp.MustCompile(`\d+\.\d+\.\d+(?:\.\d+)?[A-Z]*`)
+
+func (t *VerifyBootImage) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	bootResult, err := dev.Execute(ctx, device.Command{Template: "show boot-config", Format: "json", UseCache: false})
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get boot configuration: %v", err)
+		return result, nil
+	}
+	bootData, err := test.AsMap(bootResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected boot configuration output: %v", err)
+		return result, nil
+	}
+
+	image, _ := bootData["softwareImage"].(string)
+	if image == "" {
+		result.Status = test.TestFailure
+		result.Message = "No boot image configured"
+		return result, nil
+	}
+	imageFile := bootImageFile(image)
+
+	versionResult, err := dev.Execute(ctx, device.Command{Template: "show version", Format: "json", UseCache: false})
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get version: %v", err)
+		return result, nil
+	}
+	versionData, err := test.AsMap(versionResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected version output: %v", err)
+		return result, nil
+	}
+	running, _ := versionData["version"].(string)
+
+	dirResult, err := dev.Execute(ctx, device.Command{Template: "dir flash:", Format: "text", UseCache: false})
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to list flash: %v", err)
+		return result, nil
+	}
+
+	issues := []string{}
+	if t.ExpectedImage != "" && imageFile != bootImageFile(t.ExpectedImage) {
+		issues = append(issues, fmt.Sprintf("boot image is %s, expected %s", image, t.ExpectedImage))
+	}
+	bootVersion := eosImageVersion.FindString(imageFile)
+	if bootVersion != "" && running != "" && !strings.HasPrefix(running, bootVersion) {
+		issues = append(issues, fmt.Sprintf("next-boot image %s (%s) differs from running version %s", image, bootVersion, running))
+	}
+	flash, _ := device.TextOutput(dirResult.Output)
+	if !flashListsFile(flash, imageFile) {
+		issues = append(issues, fmt.Sprintf("boot image %s not found on flash", image))
+	}
+
+	result.Details = map[string]any{
+		"boot_image":      image,
+		"boot_version":    bootVersion,
+		"running_version": running,
+	}
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Boot image issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Boot image %s is present and matches running version %s", image, running)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyBootImage) ValidateInput(input any) error {
+	return nil
+}
+
+// bootImageFile strips the filesystem prefix from a boot image, so
+// "flash:/EOS.swi", "flash:EOS.swi" and "EOS.swi" compare equal.
+func bootImageFile(image string) string {
+	if _, rest, ok := strings.Cut(image, ":"); ok {
+		image = rest
+	}
+	return path.Base(image)
+}
+
+// flashListsFile reports whether a `dir flash:` listing has an entry
+// named file. The name is the last field of each entry line.
+func flashListsFile(listing, file string) bool {
+	for _, line := range strings.Split(listing, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[len(fields)-1] == file {
+			return true
+		}
+	}
+	return false
+}
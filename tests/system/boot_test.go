@@ -0,0 +1,58 @@
+package system
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const flashListing = `Directory of flash:/
+
+       -rwx  1001145462            Jan 10 09:12  EOS-4.30.1F.swi
+       -rwx  1012841472            Mar  2 14:40  EOS-4.31.2F.swi
+       -rwx          29            Mar  2 14:41  boot-config
+       drwx        4096            Mar  2 14:45  persist
+
+3957907456 bytes total (1542762496 bytes free)
+`
+
+func TestVerifyBootImage(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		image  string
+		status test.TestStatus
+		want   string
+	}{
+		{"matches running", nil, "flash:/EOS-4.30.1F.swi", test.TestSuccess,
+			"Boot image flash:/EOS-4.30.1F.swi is present and matches running version 4.30.1F"},
+		{"next boot differs", nil, "flash:/EOS-4.31.2F.swi", test.TestFailure,
+			"next-boot image flash:/EOS-4.31.2F.swi (4.31.2F) differs from running version 4.30.1F"},
+		{"unexpected image", map[string]any{"expected_image": "flash:EOS-4.31.2F.swi"}, "flash:/EOS-4.30.1F.swi", test.TestFailure,
+			"boot image is flash:/EOS-4.30.1F.swi, expected flash:EOS-4.31.2F.swi"},
+		{"missing from flash", nil, "flash:/EOS-4.30.1F-new.swi", test.TestFailure,
+			"not found on flash"},
+		{"not configured", nil, "", test.TestFailure, "No boot image configured"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyBootImage(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				On("show boot-config", map[string]any{"softwareImage": tc.image}).
+				On("show version", map[string]any{"version": "4.30.1F"}).
+				OnText("dir flash:", flashListing)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
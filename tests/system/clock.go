@@ -0,0 +1,139 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyClock verifies the device clock is synchronized and set to the
+// expected timezone.
+//
+// The test performs the following checks using `show clock`:
+//  1. The clock source is an NTP server rather than the local clock.
+//  2. The configured timezone matches `timezone`, when given.
+//  3. The device's UTC time is within `max_drift_seconds` of the time on
+//     the host running go-anta, when the device reports it.
+//
+// Expected Results:
+//   - Success: The clock is NTP-sourced, in the expected timezone and within the drift tolerance.
+//   - Failure: The clock is local, the timezone differs, or the drift exceeds the tolerance.
+//   - Error: Unable to retrieve the clock.
+//
+// Examples:
+//   - name: VerifyClock
+//     VerifyClock:
+//     timezone: UTC
+//     max_drift_seconds: 10
+type VerifyClock struct {
+	test.BaseTest
+	Timezone        string `yaml:"timezone,omitempty" json:"timezone,omitempty"`
+	MaxDriftSeconds int    `yaml:"max_drift_seconds,omitempty" json:"max_drift_seconds,omitempty"`
+}
+
+func NewVerifyClock(inputs map[string]any) (test.Test, error) {
+	t := &VerifyClock{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyClock",
+			TestDescription: "Verify the clock is synchronized and in the expected timezone",
+			TestCategories:  []string{"system", "time"},
+		},
+		MaxDriftSeconds: 5,
+	}
+
+	if err := test.GetString(inputs, "timezone", &t.Timezone); err != nil {
+		return nil, err
+	}
+	if err := test.GetInt(inputs, "max_drift_seconds", &t.MaxDriftSeconds); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyClock) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show clock",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get clock: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected clock output: %v", err)
+		return result, nil
+	}
+
+	issues := []string{}
+	details := map[string]any{}
+
+	source, _ := data["clockSource"].(map[string]any)
+	ntpServer, _ := source["ntpServer"].(string)
+	if local, _ := source["local"].(bool); local || ntpServer == "" {
+		issues = append(issues, "clock is not synchronized (source: local)")
+	} else {
+		details["ntp_server"] = ntpServer
+	}
+
+	timezone, _ := data["timezone"].(string)
+	details["timezone"] = timezone
+	if t.Timezone != "" && !strings.EqualFold(timezone, t.Timezone) {
+		issues = append(issues, fmt.Sprintf("timezone is %s, expected %s", timezone, t.Timezone))
+	}
+
+	if utc, ok := data["utcTime"].(float64); ok {
+		drift := clockDrift(utc, time.Now())
+		details["drift_seconds"] = math.Round(drift.Seconds()*1000) / 1000
+		if drift > time.Duration(t.MaxDriftSeconds)*time.Second {
+			issues = append(issues, fmt.Sprintf("clock drift %s exceeds %ds", drift.Round(time.Millisecond), t.MaxDriftSeconds))
+		}
+	}
+	result.Details = details
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("Clock issues: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("Clock synchronized to %s, timezone %s", ntpServer, timezone)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyClock) ValidateInput(input any) error {
+	if t.MaxDriftSeconds < 0 {
+		return fmt.Errorf("max_drift_seconds must be non-negative, got %d", t.MaxDriftSeconds)
+	}
+	return nil
+}
+
+// clockDrift is the absolute difference between a device's UTC time, in
+// epoch seconds as `show clock` reports it, and now.
+func clockDrift(utc float64, now time.Time) time.Duration {
+	deviceTime := time.Unix(0, int64(utc*float64(time.Second)))
+	drift := now.Sub(deviceTime)
+	if drift < 0 {
+		drift = -drift
+	}
+	return drift
+}
@@ -0,0 +1,59 @@
+package system
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func showClock(utc time.Time, timezone, source string) string {
+	return fmt.Sprintf(`{"utcTime": %f, "timezone": %q, "clockSource": %s}`,
+		float64(utc.UnixNano())/1e9, timezone, source)
+}
+
+func TestVerifyClock(t *testing.T) {
+	const ntp = `{"local": false, "ntpServer": "10.0.0.1"}`
+	now := time.Now()
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"synchronized", map[string]any{"timezone": "UTC"}, showClock(now, "UTC", ntp),
+			test.TestSuccess, "synchronized to 10.0.0.1, timezone UTC"},
+		{"wrong timezone", map[string]any{"timezone": "UTC"}, showClock(now, "America/Los_Angeles", ntp),
+			test.TestFailure, "timezone is America/Los_Angeles, expected UTC"},
+		{"local source", nil, showClock(now, "UTC", `{"local": true}`),
+			test.TestFailure, "clock is not synchronized"},
+		{"drift", map[string]any{"max_drift_seconds": 30}, showClock(now.Add(-2*time.Minute), "UTC", ntp),
+			test.TestFailure, "exceeds 30s"},
+		{"no utc time", map[string]any{"timezone": "utc"}, `{"timezone": "UTC", "clockSource": ` + ntp + `}`,
+			test.TestSuccess, "timezone UTC"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyClock(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(tc.inputs); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show clock", tc.body)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ func NewVerifyZeroTouch(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyZeroTouch",
 			TestDescription: "Verify ZeroTouch is disabled",
 			TestCategories:  []string{"system", "configuration"},
+			TestSeverity:    test.SeverityMinor,
 		},
 	}
 
@@ -116,6 +117,8 @@ func (t *VerifyZeroTouch) Execute(ctx context.Context, dev device.Device) (*test
 	} else if zeroTouchMode == "" {
 		result.Status = test.TestError
 		result.Message = "Unable to determine ZeroTouch status from device response"
+	} else {
+		result.Message = fmt.Sprintf("ZeroTouch is %s", zeroTouchMode)
 	}
 
 	return result, nil
@@ -134,26 +137,38 @@ func (t *VerifyZeroTouch) ValidateInput(input any) error {
 //
 // The test performs the following checks:
 //  1. Compares the running configuration against the startup configuration.
-//  2. Identifies any differences between the two configurations.
-//  3. Reports specific configuration lines that differ.
+//  2. Collects the added and removed lines from the unified diff, dropping any
+//     that match an `ignore_lines` regex (volatile lines such as timestamps).
+//  3. Reports the number of differing lines and the first few of them.
 //
 // Expected Results:
-//   - Success: The test will pass if running and startup configurations are identical.
+//   - Success: The test will pass if running and startup configurations are identical,
+//     apart from ignored lines.
 //   - Failure: The test will fail if any differences exist between the configurations.
-//   - Error: The test will report an error if configuration comparison cannot be performed.
+//   - Error: The test will report an error if configuration comparison cannot be performed
+//     or an `ignore_lines` pattern is invalid.
 //
 // Examples:
 //
 //   - name: VerifyRunningConfigDiffs basic check
 //     VerifyRunningConfigDiffs: {}
 //
-//   - name: VerifyRunningConfigDiffs ensure saved
+//   - name: VerifyRunningConfigDiffs ignoring volatile lines
 //     VerifyRunningConfigDiffs:
-//     # No parameters needed - validates configs are synchronized
+//     ignore_lines:
+//
+//   - "^! Startup-config last modified"
+//
+//   - "^ntp clock-period"
 type VerifyRunningConfigDiffs struct {
 	test.BaseTest
+	IgnoreLines []string `yaml:"ignore_lines,omitempty" json:"ignore_lines,omitempty"`
 }
 
+// runningConfigDiffSnippetLines caps how many differing lines are quoted
+// in the failure message.
+const runningConfigDiffSnippetLines = 5
+
 func NewVerifyRunningConfigDiffs(inputs map[string]any) (test.Test, error) {
 	t := &VerifyRunningConfigDiffs{
 		BaseTest: test.BaseTest{
@@ -163,7 +178,10 @@ func NewVerifyRunningConfigDiffs(inputs map[string]any) (test.Test, error) {
 		},
 	}
 
-	// No input parameters required for this test
+	if err := test.GetStringSlice(inputs, "ignore_lines", &t.IgnoreLines); err != nil {
+		return nil, err
+	}
+
 	return t, nil
 }
 
@@ -175,6 +193,17 @@ func (t *VerifyRunningConfigDiffs) Execute(ctx context.Context, dev device.Devic
 		Categories: t.Categories(),
 	}
 
+	ignore := make([]*regexp.Regexp, 0, len(t.IgnoreLines))
+	for _, pattern := range t.IgnoreLines {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Invalid ignore_lines pattern '%s': %v", pattern, err)
+			return result, nil
+		}
+		ignore = append(ignore, re)
+	}
+
 	cmd := device.Command{
 		Template: "show running-config diffs",
 		Format:   "text",
@@ -188,58 +217,68 @@ func (t *VerifyRunningConfigDiffs) Execute(ctx context.Context, dev device.Devic
 		return result, nil
 	}
 
-	// Check the output for differences
-	var configDiffs string
-	switch output := cmdResult.Output.(type) {
-	case string:
-		configDiffs = strings.TrimSpace(output)
-	case map[string]any:
-		// If JSON output, look for differences field
-		if diffs, ok := output["differences"].(string); ok {
-			configDiffs = strings.TrimSpace(diffs)
-		} else if diffs, ok := output["diffs"].(string); ok {
-			configDiffs = strings.TrimSpace(diffs)
-		} else if message, ok := output["message"].(string); ok {
-			configDiffs = strings.TrimSpace(message)
-		}
+	diffText, _ := device.TextOutput(cmdResult.Output)
+	diffs := changedConfigLines(diffText, ignore)
+	if len(diffs) == 0 {
+		result.Message = "Running and startup configurations match"
+		return result, nil
 	}
 
-	// Check if there are differences
-	if configDiffs != "" && !strings.Contains(strings.ToLower(configDiffs), "no difference") && !strings.Contains(configDiffs, "---") {
-		// If output contains actual differences (not just empty diff markers)
-		lines := strings.Split(configDiffs, "\n")
-		significantDiffs := []string{}
-
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			// Filter out empty lines and diff markers
-			if line != "" && !strings.HasPrefix(line, "---") && !strings.HasPrefix(line, "+++") && !strings.HasPrefix(line, "@@") {
-				significantDiffs = append(significantDiffs, line)
-			}
-		}
-
-		if len(significantDiffs) > 0 {
-			result.Status = test.TestFailure
-			result.Message = fmt.Sprintf("Configuration differences found: %d lines differ between running and startup config", len(significantDiffs))
-
-			// Include first few differences in the message for context
-			if len(significantDiffs) > 5 {
-				result.Message += fmt.Sprintf(". First 5 differences: %v", significantDiffs[:5])
-			} else {
-				result.Message += fmt.Sprintf(". Differences: %v", significantDiffs)
-			}
-		}
+	result.Status = test.TestFailure
+	result.Message = fmt.Sprintf("Configuration differences found: %d lines differ between running and startup config", len(diffs))
+	if len(diffs) > runningConfigDiffSnippetLines {
+		result.Message += fmt.Sprintf(". First %d differences: %s", runningConfigDiffSnippetLines,
+			strings.Join(diffs[:runningConfigDiffSnippetLines], "; "))
+	} else {
+		result.Message += fmt.Sprintf(". Differences: %s", strings.Join(diffs, "; "))
 	}
+	result.Details = map[string]any{"differing_lines": diffs}
 
 	return result, nil
 }
 
 func (t *VerifyRunningConfigDiffs) ValidateInput(input any) error {
-	// No input validation required for this test
+	for i, pattern := range t.IgnoreLines {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("ignore_lines[%d]: invalid regex: %w", i, err)
+		}
+	}
 	return nil
 }
 
-// VerifyRunningConfigLines verifies that specific configuration lines exist in the running configuration.
+// changedConfigLines returns the added ("+") and removed ("-") lines of a
+// unified diff, keeping the marker and skipping file headers, hunk headers
+// and lines whose content matches an ignore pattern.
+func changedConfigLines(diff string, ignore []*regexp.Regexp) []string {
+	changed := []string{}
+	for _, line := range strings.Split(diff, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "---") {
+			continue
+		}
+		if !strings.HasPrefix(line, "+") && !strings.HasPrefix(line, "-") {
+			continue
+		}
+		content := strings.TrimSpace(line[1:])
+		if content == "" {
+			continue
+		}
+		ignored := false
+		for _, re := range ignore {
+			if re.MatchString(content) {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			changed = append(changed, line[:1]+content)
+		}
+	}
+	return changed
+}
+
+// VerifyRunningConfigLines verifies that required configuration lines are present, and forbidden
+// ones absent, in the running configuration.
 //
 // This test searches the running configuration for specified regular expression patterns,
 // allowing validation of critical configuration elements, security settings, or
@@ -247,25 +286,31 @@ func (t *VerifyRunningConfigDiffs) ValidateInput(input any) error {
 //
 // The test performs the following checks:
 //  1. Retrieves the complete running configuration from the device.
-//  2. Searches for each specified regex pattern in the configuration.
-//  3. Reports which patterns are found and which are missing.
+//  2. Confirms each `present` pattern (and each legacy `regex_patterns` entry) matches at least one line.
+//  3. Confirms no `absent` pattern matches any line.
+//
+// Patterns are Go regular expressions matched against individual lines, so a
+// plain configuration line works as-is and `^`/`$` anchor to the line.
 //
 // Expected Results:
-//   - Success: The test will pass if all specified regex patterns are found in the configuration.
-//   - Failure: The test will fail if any specified pattern is missing from the configuration.
+//   - Success: The test will pass if every required pattern is found and no forbidden pattern matches.
+//   - Failure: The test will fail if a required pattern is missing or a forbidden pattern is found.
 //   - Error: The test will report an error if configuration cannot be retrieved or regex compilation fails.
 //
 // Examples:
 //
 //   - name: VerifyRunningConfigLines security settings
 //     VerifyRunningConfigLines:
-//     regex_patterns:
+//     present:
 //
 //   - "^aaa authentication login default"
 //
 //   - "^ip ssh version 2"
+//     absent:
+//
+//   - "^username admin .*nopassword"
 //
-//   - "^banner motd"
+//   - "^management telnet"
 //
 //   - name: VerifyRunningConfigLines NTP configuration
 //     VerifyRunningConfigLines:
@@ -276,31 +321,38 @@ func (t *VerifyRunningConfigDiffs) ValidateInput(input any) error {
 //   - "^clock timezone"
 type VerifyRunningConfigLines struct {
 	test.BaseTest
-	RegexPatterns []string `yaml:"regex_patterns" json:"regex_patterns"`
+	RegexPatterns []string `yaml:"regex_patterns,omitempty" json:"regex_patterns,omitempty"`
+	Present       []string `yaml:"present,omitempty" json:"present,omitempty"`
+	Absent        []string `yaml:"absent,omitempty" json:"absent,omitempty"`
 }
 
 func NewVerifyRunningConfigLines(inputs map[string]any) (test.Test, error) {
 	t := &VerifyRunningConfigLines{
 		BaseTest: test.BaseTest{
 			TestName:        "VerifyRunningConfigLines",
-			TestDescription: "Verify specific configuration lines exist in running config",
+			TestDescription: "Verify required configuration lines are present and forbidden lines absent in running config",
 			TestCategories:  []string{"system", "configuration"},
 		},
 	}
 
-	if inputs != nil {
-		if patterns, ok := inputs["regex_patterns"].([]any); ok {
-			for _, pattern := range patterns {
-				if patternStr, ok := pattern.(string); ok {
-					t.RegexPatterns = append(t.RegexPatterns, patternStr)
-				}
-			}
-		}
+	if err := test.GetStringSlice(inputs, "regex_patterns", &t.RegexPatterns); err != nil {
+		return nil, err
+	}
+	if err := test.GetStringSlice(inputs, "present", &t.Present); err != nil {
+		return nil, err
+	}
+	if err := test.GetStringSlice(inputs, "absent", &t.Absent); err != nil {
+		return nil, err
 	}
 
 	return t, nil
 }
 
+// requiredPatterns returns `present` plus the legacy `regex_patterns`.
+func (t *VerifyRunningConfigLines) requiredPatterns() []string {
+	return append(append([]string{}, t.RegexPatterns...), t.Present...)
+}
+
 func (t *VerifyRunningConfigLines) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
 	result := &test.TestResult{
 		TestName:   t.Name(),
@@ -309,95 +361,94 @@ func (t *VerifyRunningConfigLines) Execute(ctx context.Context, dev device.Devic
 		Categories: t.Categories(),
 	}
 
-	if len(t.RegexPatterns) == 0 {
+	required := t.requiredPatterns()
+	if len(required) == 0 && len(t.Absent) == 0 {
 		result.Status = test.TestError
 		result.Message = "No regex patterns specified for verification"
 		return result, nil
 	}
 
-	cmd := device.Command{
-		Template: "show running-config",
-		Format:   "text",
-		UseCache: false,
-	}
-
-	cmdResult, err := dev.Execute(ctx, cmd)
+	runningConfig, err := device.RunningConfig(ctx, dev)
 	if err != nil {
 		result.Status = test.TestError
 		result.Message = fmt.Sprintf("Failed to get running configuration: %v", err)
 		return result, nil
 	}
 
-	// Get the configuration text
-	var configText string
-	switch output := cmdResult.Output.(type) {
-	case string:
-		configText = output
-	case map[string]any:
-		// If JSON output, look for configuration field
-		if config, ok := output["configuration"].(string); ok {
-			configText = config
-		} else if config, ok := output["config"].(string); ok {
-			configText = config
-		} else if config, ok := output["runningConfig"].(string); ok {
-			configText = config
-		}
-	}
-
-	if configText == "" {
+	if runningConfig == "" {
 		result.Status = test.TestError
 		result.Message = "Unable to retrieve running configuration text"
 		return result, nil
 	}
 
 	// Split configuration into lines for line-by-line matching
-	configLines := strings.Split(configText, "\n")
+	configLines := strings.Split(runningConfig, "\n")
 
-	// Check each regex pattern
-	missingPatterns := []string{}
-	for _, pattern := range t.RegexPatterns {
-		// Compile the regex
+	issues := []string{}
+	for _, pattern := range required {
 		re, err := regexp.Compile(pattern)
 		if err != nil {
 			result.Status = test.TestError
 			result.Message = fmt.Sprintf("Invalid regex pattern '%s': %v", pattern, err)
 			return result, nil
 		}
-
-		// Search for the pattern in configuration lines
-		found := false
-		for _, line := range configLines {
-			if re.MatchString(line) {
-				found = true
-				break
-			}
+		if _, found := firstMatchingLine(re, configLines); !found {
+			issues = append(issues, fmt.Sprintf("required pattern '%s' not found", pattern))
 		}
-
-		if !found {
-			missingPatterns = append(missingPatterns, pattern)
+	}
+	for _, pattern := range t.Absent {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Invalid regex pattern '%s': %v", pattern, err)
+			return result, nil
+		}
+		if line, found := firstMatchingLine(re, configLines); found {
+			issues = append(issues, fmt.Sprintf("forbidden pattern '%s' present: %s", pattern, line))
 		}
 	}
 
-	if len(missingPatterns) > 0 {
+	if len(issues) > 0 {
 		result.Status = test.TestFailure
-		result.Message = fmt.Sprintf("Configuration patterns not found: %v", missingPatterns)
+		result.Message = fmt.Sprintf("Running configuration compliance failures: %s", strings.Join(issues, "; "))
+	} else {
+		result.Message = fmt.Sprintf("%d required and %d forbidden patterns verified", len(required), len(t.Absent))
 	}
 
 	return result, nil
 }
 
+func firstMatchingLine(re *regexp.Regexp, lines []string) (string, bool) {
+	for _, line := range lines {
+		if re.MatchString(line) {
+			return strings.TrimSpace(line), true
+		}
+	}
+	return "", false
+}
+
 func (t *VerifyRunningConfigLines) ValidateInput(input any) error {
-	if len(t.RegexPatterns) == 0 {
+	if len(t.requiredPatterns()) == 0 && len(t.Absent) == 0 {
 		return fmt.Errorf("at least one regex pattern must be specified")
 	}
 
 	// Validate that each pattern is a valid regex
-	for i, pattern := range t.RegexPatterns {
-		if pattern == "" {
-			return fmt.Errorf("regex pattern at index %d is empty", i)
-		}
-		if _, err := regexp.Compile(pattern); err != nil {
-			return fmt.Errorf("invalid regex pattern at index %d '%s': %v", i, pattern, err)
+	fields := []struct {
+		name     string
+		patterns []string
+	}{
+		{"regex_patterns", t.RegexPatterns},
+		{"present", t.Present},
+		{"absent", t.Absent},
+	}
+	for _, field := range fields {
+		for i, pattern := range field.patterns {
+			if pattern == "" {
+				return fmt.Errorf("%s[%d]: pattern is empty", field.name, i)
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("%s[%d]: invalid regex pattern '%s': %v", field.name, i, pattern, err)
+			}
 		}
 	}
 
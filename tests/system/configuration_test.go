@@ -0,0 +1,150 @@
+package system
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyZeroTouch(t *testing.T) {
+	cases := []struct {
+		name    string
+		output  string
+		status  test.TestStatus
+		message string
+	}{
+		{"disabled", `{"mode": "disabled"}`, test.TestSuccess, "ZeroTouch is disabled"},
+		{"enabled", `{"mode": "enabled"}`, test.TestFailure, "ZeroTouch is enabled - should be disabled for security"},
+		{"active", `{"mode": "active"}`, test.TestFailure, "ZeroTouch is enabled - should be disabled for security"},
+		{"unknown shape", `{}`, test.TestError, "Unable to determine ZeroTouch status from device response"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, _ := NewVerifyZeroTouch(nil)
+			res := test.RunWithFake(t, tst, device.NewFakeDevice().OnJSON(t, "show zerotouch", tc.output))
+			if res.Status != tc.status || res.Message != tc.message {
+				t.Errorf("got %v %q, want %v %q", res.Status, res.Message, tc.status, tc.message)
+			}
+		})
+	}
+}
+
+func TestVerifyRunningConfigDiffs(t *testing.T) {
+	const diff = "--- flash:/startup-config\n" +
+		"+++ system:/running-config\n" +
+		"@@ -1,4 +1,5 @@\n" +
+		"-! Startup-config last modified at Mon Oct  5 10:00:00 2026\n" +
+		"+! Startup-config last modified at Tue Oct  6 11:00:00 2026\n" +
+		" hostname leaf1\n" +
+		"+ip route 10.9.9.0/24 10.0.0.1\n" +
+		" !\n" +
+		"-   shutdown\n"
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		output string
+		status test.TestStatus
+		want   string
+	}{
+		{"no diff", nil, "", test.TestSuccess, "configurations match"},
+		{"diff", nil, diff, test.TestFailure,
+			"4 lines differ between running and startup config. Differences: -! Startup-config"},
+		{"diff with ignored lines", map[string]any{"ignore_lines": []any{"^! Startup-config last modified"}}, diff,
+			test.TestFailure, "2 lines differ between running and startup config. Differences: +ip route 10.9.9.0/24 10.0.0.1; -shutdown"},
+		{"only ignored lines", map[string]any{"ignore_lines": []any{"^!", "route", "shutdown"}}, diff,
+			test.TestSuccess, "configurations match"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyRunningConfigDiffs(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice()
+			dev.Outputs["show running-config diffs"] = map[string]any{"output": tc.output}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRunningConfigDiffs_InvalidIgnorePattern(t *testing.T) {
+	tst, err := NewVerifyRunningConfigDiffs(map[string]any{"ignore_lines": []any{"("}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected invalid regex to be rejected")
+	}
+}
+
+func TestVerifyRunningConfigLines(t *testing.T) {
+	const runningConfig = "hostname leaf1\n" +
+		"ip ssh version 2\n" +
+		"username admin privilege 15 role network-admin nopassword\n" +
+		"ntp server vrf MGMT 10.0.0.1\n" +
+		"management api http-commands\n" +
+		"   no shutdown\n"
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"plain present lines", map[string]any{"present": []any{"hostname leaf1", "ip ssh version 2"}},
+			test.TestSuccess, "2 required and 0 forbidden"},
+		{"regex present", map[string]any{"present": []any{`^ntp server vrf \S+ [0-9.]+$`}},
+			test.TestSuccess, "1 required"},
+		{"legacy regex_patterns", map[string]any{"regex_patterns": []any{"^hostname"}, "present": []any{"^ntp"}},
+			test.TestSuccess, "2 required"},
+		{"missing line", map[string]any{"present": []any{"^banner motd"}}, test.TestFailure,
+			"required pattern '^banner motd' not found"},
+		{"absent satisfied", map[string]any{"absent": []any{"^management telnet"}}, test.TestSuccess,
+			"0 required and 1 forbidden"},
+		{"forbidden regex present", map[string]any{"absent": []any{`^username \S+ .*nopassword`}}, test.TestFailure,
+			"present: username admin privilege 15 role network-admin nopassword"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyRunningConfigLines(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice()
+			dev.Outputs["show running-config"] = map[string]any{"output": runningConfig}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRunningConfigLines_ValidateInput(t *testing.T) {
+	tst, _ := NewVerifyRunningConfigLines(nil)
+	if err := tst.ValidateInput(nil); err == nil {
+		t.Error("expected error with no patterns")
+	}
+	tst, _ = NewVerifyRunningConfigLines(map[string]any{"absent": []any{"["}})
+	if err := tst.ValidateInput(nil); err == nil || !strings.Contains(err.Error(), "absent[0]") {
+		t.Errorf("expected absent[0] regex error, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyCPUHistoryBelow verifies the sustained CPU utilization is below a
+// threshold.
+//
+// VerifyCPUUtilization samples the CPU at one instant, so a short spike
+// fails it and a long busy stretch can pass it. This test instead reads
+// the 1/5/15-minute load averages from `show processes summary` and fails
+// only when the 5-minute utilization exceeds `max_cpu_5min`.
+//
+// Load averages count runnable tasks across all cores, so each one is
+// divided by the core count to get a utilization percentage: on a 4-core
+// supervisor a load of 2.0 is 50%. The cores are counted from the per-CPU
+// entries in cpuInfo, falling back to cpuCount in `show version`.
+//
+// Expected Results:
+//   - Success: The 5-minute utilization is at or below max_cpu_5min (default 75%).
+//   - Failure: The 5-minute utilization exceeds max_cpu_5min.
+//   - Error: The load averages or the core count cannot be retrieved.
+//
+// Examples:
+//   - name: VerifyCPUHistoryBelow
+//     VerifyCPUHistoryBelow:
+//     max_cpu_5min: 60
+type VerifyCPUHistoryBelow struct {
+	test.BaseTest
+	MaxCPU5Min int `yaml:"max_cpu_5min,omitempty" json:"max_cpu_5min,omitempty"`
+}
+
+func NewVerifyCPUHistoryBelow(inputs map[string]any) (test.Test, error) {
+	t := &VerifyCPUHistoryBelow{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyCPUHistoryBelow",
+			TestDescription: "Verify the 5-minute CPU utilization is below the threshold",
+			TestCategories:  []string{"system", "performance"},
+		},
+		MaxCPU5Min: 75,
+	}
+
+	if err := test.GetInt(inputs, "max_cpu_5min", &t.MaxCPU5Min); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (t *VerifyCPUHistoryBelow) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show processes summary",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get CPU load averages: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected processes summary output: %v", err)
+		return result, nil
+	}
+
+	summary, _ := data["summary"].(map[string]any)
+	loadAvg, _ := summary["loadAvg"].(map[string]any)
+	loads := map[string]float64{}
+	for _, window := range []string{"1min", "5min", "15min"} {
+		if v, ok := loadAvg[window].(float64); ok {
+			loads[window] = v
+		}
+	}
+	if _, ok := loads["5min"]; !ok {
+		result.Status = test.TestError
+		result.Message = "Could not determine the 5-minute load average from system output"
+		return result, nil
+	}
+
+	cpuInfo, _ := summary["cpuInfo"].(map[string]any)
+	cores := perCPUCount(cpuInfo)
+	if cores == 0 {
+		cores = versionCPUCount(ctx, dev)
+	}
+	if cores == 0 {
+		result.Status = test.TestError
+		result.Message = "Could not determine the CPU core count from system output"
+		return result, nil
+	}
+
+	percent := func(window string) float64 { return loads[window] / float64(cores) * 100 }
+	oneMin, fiveMin, fifteenMin := percent("1min"), percent("5min"), percent("15min")
+
+	result.Details = map[string]any{
+		"cpu_cores":         cores,
+		"cpu_1min_percent":  oneMin,
+		"cpu_5min_percent":  fiveMin,
+		"cpu_15min_percent": fifteenMin,
+		"threshold_percent": t.MaxCPU5Min,
+	}
+	summaryText := fmt.Sprintf("1/5/15-minute averages %.1f%%/%.1f%%/%.1f%% over %d cores", oneMin, fiveMin, fifteenMin, cores)
+	if fiveMin > float64(t.MaxCPU5Min) {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("5-minute CPU average %.1f%% exceeds %d%% (%s)", fiveMin, t.MaxCPU5Min, summaryText)
+		return result, nil
+	}
+
+	result.Message = fmt.Sprintf("CPU %s", summaryText)
+	return result, nil
+}
+
+var perCPUKey = regexp.MustCompile(`^%Cpu(\d+)`)
+
+// perCPUCount counts the distinct per-core entries (%Cpu0, %Cpu1_id, ...)
+// in cpuInfo. The aggregate %Cpu(s) entry is not counted.
+func perCPUCount(cpuInfo map[string]any) int {
+	seen := map[string]bool{}
+	for key := range cpuInfo {
+		if m := perCPUKey.FindStringSubmatch(key); m != nil {
+			seen[m[1]] = true
+		}
+	}
+	return len(seen)
+}
+
+// versionCPUCount reads the core count from `show version`, returning 0
+// when the command fails or does not report it.
+func versionCPUCount(ctx context.Context, dev device.Device) int {
+	cmdResult, err := dev.Execute(ctx, device.Command{Template: "show version", Format: "json", UseCache: true})
+	if err != nil {
+		return 0
+	}
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		return 0
+	}
+	count, _ := data["cpuCount"].(float64)
+	return int(count)
+}
+
+func (t *VerifyCPUHistoryBelow) ValidateInput(input any) error {
+	if t.MaxCPU5Min <= 0 || t.MaxCPU5Min > 100 {
+		return fmt.Errorf("max_cpu_5min must be between 1 and 100, got %d", t.MaxCPU5Min)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package system
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyCPUHistoryBelow(t *testing.T) {
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		body   string
+		// version is the show version body; empty leaves it unregistered.
+		version string
+		status  test.TestStatus
+		want    string
+	}{
+		// Idle now, but busy for the last five minutes: 3.4 over 4 cores is 85%.
+		{"sustained load, idle now", map[string]any{"max_cpu_5min": 60}, `{"summary": {
+			"cpuInfo": {"%Cpu(s)_id": 95.0, "%Cpu0_id": 95.0, "%Cpu1_id": 96.0, "%Cpu2_id": 94.0, "%Cpu3_id": 95.0},
+			"loadAvg": {"1min": 0.2, "5min": 3.4, "15min": 2.8}}}`, "",
+			test.TestFailure, "5-minute CPU average 85.0% exceeds 60% (1/5/15-minute averages 5.0%/85.0%/70.0% over 4 cores)"},
+		{"spike only", nil, `{"summary": {
+			"cpuInfo": {"%Cpu(s)_id": 5.0, "%Cpu0_id": 4.0, "%Cpu1_id": 6.0},
+			"loadAvg": {"1min": 1.96, "5min": 0.6, "15min": 0.5}}}`, "",
+			test.TestSuccess, "1/5/15-minute averages 98.0%/30.0%/25.0% over 2 cores"},
+		// No per-core entries, so the core count comes from show version.
+		{"cores from show version", map[string]any{"max_cpu_5min": 90}, `{"summary": {"loadAvg": {"1min": 7.2, "5min": 6.8, "15min": 5.6}}}`,
+			`{"cpuCount": 8}`, test.TestSuccess, "90.0%/85.0%/70.0% over 8 cores"},
+		{"unknown core count", nil, `{"summary": {"loadAvg": {"1min": 0.9, "5min": 0.85, "15min": 0.7}}}`,
+			`{}`, test.TestError, "core count"},
+		{"no load average", nil, `{"summary": {}}`, "", test.TestError, "Could not determine"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyCPUHistoryBelow(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(tc.inputs); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show processes summary", tc.body)
+			if tc.version != "" {
+				dev.OnJSON(t, "show version", tc.version)
+			}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+	"github.com/fluidstackio/go-anta/tests/hardware"
+	"github.com/fluidstackio/go-anta/tests/routing"
+)
+
+// VerifyISSUReadiness verifies the preconditions for a hitless (ISSU/SSO)
+// upgrade in one pre-maintenance check.
+//
+// The test runs these checks and reports each unmet precondition:
+//  1. BGP graceful restart is negotiated with every peer
+//     (VerifyBGPGracefulRestart), with `minimum_restart_time` if given.
+//  2. MLAG reload delays match `reload_delay`/`reload_delay_non_mlag`
+//     when MLAG is enabled (VerifyMlagReloadDelay).
+//  3. On chassis with a peer supervisor, the standby is switchover-ready
+//     using `expected_protocol`, sso by default (VerifySupervisorRedundancy).
+//
+// A precondition that does not apply counts as met: MLAG on a device
+// without MLAG, graceful restart on a device with no BGP peers, or
+// redundancy on a single-supervisor system.
+//
+// Expected Results:
+//   - Success: Every applicable precondition is met.
+//   - Failure: One or more preconditions are unmet.
+//   - Error: A precondition could not be evaluated.
+//
+// Examples:
+//   - name: VerifyISSUReadiness
+//     VerifyISSUReadiness:
+//     minimum_restart_time: 300
+//     reload_delay: 300
+//     reload_delay_non_mlag: 330
+type VerifyISSUReadiness struct {
+	test.BaseTest
+	MinimumRestartTime int    `yaml:"minimum_restart_time,omitempty" json:"minimum_restart_time,omitempty"`
+	ReloadDelay        *int   `yaml:"reload_delay,omitempty" json:"reload_delay,omitempty"`
+	ReloadDelayNonMlag *int   `yaml:"reload_delay_non_mlag,omitempty" json:"reload_delay_non_mlag,omitempty"`
+	ExpectedProtocol   string `yaml:"expected_protocol,omitempty" json:"expected_protocol,omitempty"`
+
+	preconditions []issuPrecondition
+}
+
+// issuPrecondition is one ISSU requirement, checked by an existing test.
+// notApplicable, when set, recognises a failure of check that only means
+// the device lacks the feature, such as a fixed system's missing standby.
+type issuPrecondition struct {
+	name          string
+	check         test.Test
+	notApplicable func(*test.TestResult) bool
+}
+
+func NewVerifyISSUReadiness(inputs map[string]any) (test.Test, error) {
+	t := &VerifyISSUReadiness{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyISSUReadiness",
+			TestDescription: "Verify hitless upgrade preconditions are met",
+			TestCategories:  []string{"system", "upgrade"},
+		},
+		ExpectedProtocol: "sso",
+	}
+
+	if err := test.GetInt(inputs, "minimum_restart_time", &t.MinimumRestartTime); err != nil {
+		return nil, err
+	}
+	if err := test.GetString(inputs, "expected_protocol", &t.ExpectedProtocol); err != nil {
+		return nil, err
+	}
+
+	grInputs := map[string]any{}
+	if t.MinimumRestartTime > 0 {
+		grInputs["minimum_restart_time"] = t.MinimumRestartTime
+	}
+	gracefulRestart, err := routing.NewVerifyBGPGracefulRestart(grInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	mlagInputs := map[string]any{}
+	for _, key := range []string{"reload_delay", "reload_delay_non_mlag"} {
+		if v, ok := inputs[key]; ok {
+			mlagInputs[key] = v
+		}
+	}
+	reloadDelay, err := NewVerifyMlagReloadDelay(mlagInputs)
+	if err != nil {
+		return nil, err
+	}
+	t.ReloadDelay = reloadDelay.(*VerifyMlagReloadDelay).ReloadDelay
+	t.ReloadDelayNonMlag = reloadDelay.(*VerifyMlagReloadDelay).ReloadDelayNonMlag
+
+	redundancy, err := hardware.NewVerifySupervisorRedundancy(map[string]any{"expected_protocol": t.ExpectedProtocol})
+	if err != nil {
+		return nil, err
+	}
+
+	t.preconditions = []issuPrecondition{
+		{"BGP graceful restart", gracefulRestart, noBGPPeers},
+		{"MLAG reload delay", reloadDelay, nil},
+		{"supervisor redundancy", redundancy, singleSupervisor},
+	}
+	return t, nil
+}
+
+func (t *VerifyISSUReadiness) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	type preconditionRow struct {
+		Precondition string `json:"precondition"`
+		Status       string `json:"status"`
+		Message      string `json:"message,omitempty"`
+	}
+	rows := make([]preconditionRow, 0, len(t.preconditions))
+	unmet := []string{}
+	errored := []string{}
+
+	for _, p := range t.preconditions {
+		res, err := p.check.Execute(ctx, dev)
+		if err != nil {
+			res = &test.TestResult{Status: test.TestError, Message: err.Error()}
+		}
+		status := res.Status
+		if status == test.TestFailure && p.notApplicable != nil && p.notApplicable(res) {
+			status = test.TestSkipped
+		}
+		rows = append(rows, preconditionRow{Precondition: p.name, Status: status.String(), Message: res.Message})
+
+		switch status {
+		case test.TestFailure:
+			unmet = append(unmet, fmt.Sprintf("%s: %s", p.name, res.Message))
+		case test.TestError:
+			errored = append(errored, fmt.Sprintf("%s: %s", p.name, res.Message))
+		}
+	}
+	result.Details = map[string]any{"preconditions": rows}
+
+	switch {
+	case len(errored) > 0:
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Could not evaluate ISSU preconditions: %s", strings.Join(errored, "; "))
+	case len(unmet) > 0:
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("ISSU preconditions unmet: %s", strings.Join(unmet, "; "))
+	default:
+		result.Message = fmt.Sprintf("All %d ISSU preconditions met", len(t.preconditions))
+	}
+
+	return result, nil
+}
+
+func (t *VerifyISSUReadiness) ValidateInput(input any) error {
+	for _, p := range t.preconditions {
+		if err := p.check.ValidateInput(input); err != nil {
+			return fmt.Errorf("%s: %w", p.name, err)
+		}
+	}
+	return nil
+}
+
+// singleSupervisor reports whether a VerifySupervisorRedundancy failure
+// is only the absence of a peer supervisor, as on fixed systems.
+func singleSupervisor(res *test.TestResult) bool {
+	details := res.Details
+	switch peer, _ := details["peer_state"].(string); peer {
+	case "", "notInserted":
+		return true
+	}
+	return false
+}
+
+// noBGPPeers reports whether a VerifyBGPGracefulRestart failure is only
+// the absence of BGP peers.
+func noBGPPeers(res *test.TestResult) bool {
+	count, ok := res.Details["peer_count"].(int)
+	return ok && count == 0
+}
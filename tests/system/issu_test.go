@@ -0,0 +1,87 @@
+package system
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+const (
+	issuNeighbors = `{"vrfs": {"default": {"peerList": [{
+		"peerAddress": "10.0.0.1",
+		"neighborCapabilities": {
+			"gracefulRestartCap": {"advertised": true, "received": true, "restartTime": 300},
+			"gracefulRestartHelperCap": {"advertised": true, "received": true}}}]}}}`
+	issuNeighborsNoGR = `{"vrfs": {"default": {"peerList": [{
+		"peerAddress": "10.0.0.1",
+		"neighborCapabilities": {
+			"gracefulRestartHelperCap": {"advertised": true, "received": true}}}]}}}`
+	issuMlag       = `{"state": "active", "reloadDelay": 300, "reloadDelayNonMlag": 330}`
+	issuRedundancy = `{"configuredProtocol": "sso", "operationalProtocol": "sso",
+		"peerState": "standbyHot", "communicationDesc": "Up", "switchoverReady": true}`
+)
+
+func issuDevice(t *testing.T, neighbors, mlag, redundancy string) *device.FakeDevice {
+	return device.NewFakeDevice().
+		OnJSON(t, "show bgp neighbors vrf all", neighbors).
+		OnJSON(t, "show mlag detail", mlag).
+		OnJSON(t, "show redundancy status", redundancy)
+}
+
+func TestVerifyISSUReadiness(t *testing.T) {
+	inputs := map[string]any{"minimum_restart_time": 120, "reload_delay": 300}
+	cases := []struct {
+		name   string
+		dev    func(t *testing.T) *device.FakeDevice
+		status test.TestStatus
+		want   string
+	}{
+		{"ready", func(t *testing.T) *device.FakeDevice {
+			return issuDevice(t, issuNeighbors, issuMlag, issuRedundancy)
+		}, test.TestSuccess, "All 3 ISSU preconditions met"},
+		{"graceful restart missing", func(t *testing.T) *device.FakeDevice {
+			return issuDevice(t, issuNeighborsNoGR, issuMlag, issuRedundancy)
+		}, test.TestFailure, "ISSU preconditions unmet: BGP graceful restart: "},
+		{"reload delay wrong", func(t *testing.T) *device.FakeDevice {
+			return issuDevice(t, issuNeighbors, `{"state": "active", "reloadDelay": 180}`, issuRedundancy)
+		}, test.TestFailure, "MLAG reload delay: MLAG reload delay failures"},
+		{"standby not ready", func(t *testing.T) *device.FakeDevice {
+			return issuDevice(t, issuNeighbors, issuMlag, `{"configuredProtocol": "sso", "operationalProtocol": "rpr",
+				"peerState": "standby", "communicationDesc": "Up", "switchoverReady": false}`)
+		}, test.TestFailure, "supervisor redundancy: Supervisor redundancy degraded"},
+		{"fixed system without MLAG or BGP", func(t *testing.T) *device.FakeDevice {
+			return issuDevice(t, `{"vrfs": {}}`, `{"state": "disabled"}`, `{"peerState": "notInserted"}`)
+		}, test.TestSuccess, "All 3 ISSU preconditions met"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyISSUReadiness(inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(inputs); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			res := test.RunWithFake(t, tst, tc.dev(t))
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyISSUReadinessInputKeys(t *testing.T) {
+	inputs := map[string]any{"minimum_restart_time": 120, "reload_delay": 300, "expected_protocol": "sso"}
+	tst, err := NewVerifyISSUReadiness(inputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := test.ValidateInputKeys(inputs, tst); err != nil {
+		t.Errorf("ValidateInputKeys: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package system
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -46,6 +47,7 @@ func NewVerifyMlagStatus(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyMlagStatus",
 			TestDescription: "Verify overall MLAG health configuration",
 			TestCategories:  []string{"system", "mlag"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
@@ -575,14 +577,18 @@ func (t *VerifyMlagReloadDelay) ValidateInput(input any) error {
 //
 // The test performs the following checks:
 //  1. Verifies that MLAG is enabled on the device.
-//  2. Validates dual-primary detection delay configuration.
-//  3. Checks recovery delay settings for dual-primary scenarios.
-//  4. Confirms error handling and interface disabling behavior.
-//  5. Validates primary priority settings if configured.
+//  2. Verifies that dual-primary detection is enabled. Without it a peer-link
+//     failure leaves both peers primary, so this is checked even when no
+//     inputs are given.
+//  3. Validates dual-primary detection delay configuration.
+//  4. Checks recovery delay settings for dual-primary scenarios.
+//  5. Confirms the dual-primary action: `errdisabled: true` expects
+//     "errdisableAllInterfaces", `errdisabled: false` expects "none".
+//  6. Validates primary priority settings if configured.
 //
 // Expected Results:
-//   - Success: The test will pass if dual-primary detection parameters match expected values.
-//   - Failure: The test will fail if detection or recovery settings don't match requirements.
+//   - Success: The test will pass if dual-primary detection is enabled and its parameters match expected values.
+//   - Failure: The test will fail if dual-primary detection is disabled or settings don't match requirements.
 //   - Error: The test will report an error if dual-primary configuration cannot be retrieved.
 //   - Skipped: The test will be skipped if MLAG is disabled on the device.
 //
@@ -612,6 +618,7 @@ func NewVerifyMlagDualPrimary(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyMlagDualPrimary",
 			TestDescription: "Verify MLAG dual-primary detection configuration",
 			TestCategories:  []string{"system", "mlag"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 
@@ -672,30 +679,15 @@ func (t *VerifyMlagDualPrimary) Execute(ctx context.Context, dev device.Device)
 		return result, nil
 	}
 
-	var mlagState string
-	var dualPrimaryConfig DualPrimaryConfig
-
-	if data, ok := cmdResult.Output.(map[string]any); ok {
-		if state, ok := data["state"].(string); ok {
-			mlagState = state
-		}
-
-		if dualPrimary, ok := data["dualPrimary"].(map[string]any); ok {
-			if detectionDelay, ok := dualPrimary["detectionDelay"].(float64); ok {
-				dualPrimaryConfig.DetectionDelay = int(detectionDelay)
-			}
-			if recoveryDelay, ok := dualPrimary["recoveryDelay"].(float64); ok {
-				dualPrimaryConfig.RecoveryDelay = int(recoveryDelay)
-			}
-			if errdisabled, ok := dualPrimary["errdisabled"].(bool); ok {
-				dualPrimaryConfig.Errdisabled = errdisabled
-			}
-			if priority, ok := dualPrimary["primaryPriority"].(float64); ok {
-				dualPrimaryConfig.PrimaryPriority = int(priority)
-			}
-		}
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected MLAG detail output: %v", err)
+		return result, nil
 	}
 
+	mlagState, _ := data["state"].(string)
+
 	// Check if MLAG is disabled
 	if strings.EqualFold(mlagState, "disabled") || mlagState == "" {
 		result.Status = test.TestSkipped
@@ -703,6 +695,31 @@ func (t *VerifyMlagDualPrimary) Execute(ctx context.Context, dev device.Device)
 		return result, nil
 	}
 
+	// EOS reports the detection state and recovery delays at the top level
+	// and the configured delay, action and priority under `detail`.
+	var dualPrimaryConfig DualPrimaryConfig
+	dualPrimaryConfig.DetectionState, _ = data["dualPrimaryDetectionState"].(string)
+	if recoveryDelay, ok := data["dualPrimaryMlagRecoveryDelay"].(float64); ok {
+		dualPrimaryConfig.RecoveryDelay = int(recoveryDelay)
+	}
+	if detail, ok := data["detail"].(map[string]any); ok {
+		if detectionDelay, ok := detail["dualPrimaryDetectionDelay"].(float64); ok {
+			dualPrimaryConfig.DetectionDelay = int(detectionDelay)
+		}
+		if action, ok := detail["dualPrimaryAction"].(string); ok {
+			dualPrimaryConfig.Errdisabled = action == "errdisableAllInterfaces"
+		}
+		if priority, ok := detail["primaryPriority"].(float64); ok {
+			dualPrimaryConfig.PrimaryPriority = int(priority)
+		}
+	}
+
+	if dualPrimaryConfig.DetectionState == "" || strings.EqualFold(dualPrimaryConfig.DetectionState, "disabled") {
+		result.Status = test.TestFailure
+		result.Message = "MLAG dual-primary detection is disabled"
+		return result, nil
+	}
+
 	failures := []string{}
 
 	// Check detection delay if specified
@@ -746,6 +763,227 @@ func (t *VerifyMlagDualPrimary) ValidateInput(input any) error {
 	return nil
 }
 
+// VerifyMlagPortChannels verifies the MLAG peer-link and core port-channels are fully bundled.
+//
+// VerifyMlagStatus only reports the peer-link status MLAG itself sees, which
+// stays "up" while a single member of the peer-link port-channel is still
+// forwarding. This test ties `show mlag` to `show port-channel` so a
+// half-up bundle is caught before the last member fails.
+//
+// The test performs the following checks:
+//  1. Verifies that MLAG is enabled on the device.
+//  2. Verifies the peer link is the expected port-channel when `peer_link` is set.
+//  3. When `port_channels` is empty, discovers the MLAG port-channels from
+//     `show mlag interfaces`.
+//  4. For the peer link and every MLAG port-channel, confirms the
+//     port-channel exists, has at least one active member and no inactive members.
+//
+// Port-channel names are matched case-insensitively, as `peer_link` is.
+//
+// Expected Results:
+//   - Success: The test will pass if every checked port-channel has all members bundled.
+//   - Failure: The test will fail if a port-channel is missing, down, or has inactive members.
+//   - Error: The test will report an error if MLAG or port-channel information cannot be retrieved.
+//   - Skipped: The test will be skipped if MLAG is disabled on the device.
+//
+// Examples:
+//
+//   - name: VerifyMlagPortChannels peer link and MLAG port-channels
+//     VerifyMlagPortChannels: {}
+//
+//   - name: VerifyMlagPortChannels with core uplinks
+//     VerifyMlagPortChannels:
+//     peer_link: Port-Channel10
+//     port_channels: [Port-Channel1, Port-Channel2]
+type VerifyMlagPortChannels struct {
+	test.BaseTest
+	PeerLink     string   `yaml:"peer_link,omitempty" json:"peer_link,omitempty"`
+	PortChannels []string `yaml:"port_channels,omitempty" json:"port_channels,omitempty"`
+}
+
+func NewVerifyMlagPortChannels(inputs map[string]any) (test.Test, error) {
+	t := &VerifyMlagPortChannels{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyMlagPortChannels",
+			TestDescription: "Verify MLAG peer-link and core port-channels are fully bundled",
+			TestCategories:  []string{"system", "mlag"},
+		},
+	}
+
+	if inputs != nil {
+		if err := test.GetString(inputs, "peer_link", &t.PeerLink); err != nil {
+			return nil, err
+		}
+		if err := test.GetStringSlice(inputs, "port_channels", &t.PortChannels); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyMlagPortChannels) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	mlagResult, err := dev.Execute(ctx, device.Command{
+		Template: "show mlag",
+		Format:   "json",
+		UseCache: false,
+	})
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get MLAG status: %v", err)
+		return result, nil
+	}
+
+	mlag, err := test.AsMap(mlagResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected MLAG output: %v", err)
+		return result, nil
+	}
+
+	mlagState, _ := mlag["state"].(string)
+	if strings.EqualFold(mlagState, "disabled") || mlagState == "" {
+		result.Status = test.TestSkipped
+		result.Message = "MLAG is disabled"
+		return result, nil
+	}
+
+	failures := []string{}
+
+	peerLink, _ := mlag["peerLink"].(string)
+	if t.PeerLink != "" && !strings.EqualFold(peerLink, t.PeerLink) {
+		failures = append(failures, fmt.Sprintf("MLAG peer link is '%s', expected '%s'", peerLink, t.PeerLink))
+	}
+	if peerLink == "" {
+		peerLink = t.PeerLink
+	}
+
+	members := t.PortChannels
+	if len(members) == 0 {
+		intfResult, err := dev.Execute(ctx, device.Command{
+			Template: "show mlag interfaces",
+			Format:   "json",
+			UseCache: false,
+		})
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Failed to get MLAG interfaces: %v", err)
+			return result, nil
+		}
+		intfData, err := test.AsMap(intfResult.Output)
+		if err != nil {
+			result.Status = test.TestError
+			result.Message = fmt.Sprintf("Unexpected MLAG interfaces output: %v", err)
+			return result, nil
+		}
+		members = mlagPortChannels(intfData)
+	}
+
+	bundles := []string{}
+	seen := map[string]bool{}
+	for _, name := range append([]string{peerLink}, members...) {
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		bundles = append(bundles, name)
+	}
+	if len(bundles) == 0 {
+		result.Status = test.TestError
+		result.Message = "No MLAG peer link reported and no port_channels given"
+		return result, nil
+	}
+
+	pcResult, err := dev.Execute(ctx, device.Command{
+		Template: "show port-channel",
+		Format:   "json",
+		UseCache: false,
+	})
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get port-channels: %v", err)
+		return result, nil
+	}
+
+	pcData, err := test.AsMap(pcResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected port-channel output: %v", err)
+		return result, nil
+	}
+	portChannels := map[string]any{}
+	if pcs, ok := pcData["portChannels"].(map[string]any); ok {
+		for name, pc := range pcs {
+			portChannels[strings.ToLower(name)] = pc
+		}
+	}
+
+	for _, name := range bundles {
+		pc, ok := portChannels[strings.ToLower(name)].(map[string]any)
+		if !ok {
+			failures = append(failures, fmt.Sprintf("%s not found", name))
+			continue
+		}
+		active, _ := pc["activePorts"].(map[string]any)
+		inactive, _ := pc["inactivePorts"].(map[string]any)
+
+		if len(active) == 0 {
+			failures = append(failures, fmt.Sprintf("%s has no active members", name))
+			continue
+		}
+		if len(inactive) > 0 {
+			down := make([]string, 0, len(inactive))
+			for member := range inactive {
+				down = append(down, member)
+			}
+			sort.Strings(down)
+			failures = append(failures, fmt.Sprintf("%s degraded: %d/%d members active (inactive: %s)",
+				name, len(active), len(active)+len(inactive), strings.Join(down, ", ")))
+		}
+	}
+
+	if len(failures) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("MLAG port-channel failures: %v", failures)
+	}
+
+	return result, nil
+}
+
+func (t *VerifyMlagPortChannels) ValidateInput(input any) error {
+	for i, pc := range t.PortChannels {
+		if pc == "" {
+			return fmt.Errorf("port_channels[%d]: name must not be empty", i)
+		}
+	}
+	return nil
+}
+
+// mlagPortChannels returns the local port-channel of every MLAG in
+// `show mlag interfaces`, sorted.
+func mlagPortChannels(data map[string]any) []string {
+	names := []string{}
+	intfs, _ := data["interfaces"].(map[string]any)
+	for _, intf := range intfs {
+		intfMap, ok := intf.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _ := intfMap["localInterface"].(string); name != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Supporting data structures
 
 type MlagInfo struct {
@@ -762,6 +1000,7 @@ type MlagInterfaceInfo struct {
 }
 
 type DualPrimaryConfig struct {
+	DetectionState  string
 	DetectionDelay  int
 	RecoveryDelay   int
 	Errdisabled     bool
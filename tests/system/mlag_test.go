@@ -0,0 +1,115 @@
+package system
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyMlagDualPrimary(t *testing.T) {
+	const configured = `{
+  "state": "active",
+  "dualPrimaryDetectionState": "configured",
+  "dualPrimaryMlagRecoveryDelay": 60,
+  "detail": {"dualPrimaryDetectionDelay": 200, "dualPrimaryAction": "errdisableAllInterfaces"}
+}`
+	cases := []struct {
+		name   string
+		output string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"mlag disabled", `{"state": "disabled"}`, nil, test.TestSkipped, "MLAG is disabled"},
+		{"detection disabled", `{"state": "active", "dualPrimaryDetectionState": "disabled", "detail": {}}`, nil,
+			test.TestFailure, "dual-primary detection is disabled"},
+		{"matches", configured, map[string]any{"detection_delay": 200, "recovery_delay": 60, "errdisabled": true},
+			test.TestSuccess, ""},
+		{"wrong action", configured, map[string]any{"errdisabled": false},
+			test.TestFailure, "Dual-primary errdisabled: expected false, got true"},
+		{"wrong delay", configured, map[string]any{"detection_delay": 120},
+			test.TestFailure, "detection delay: expected 120, got 200"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyMlagDualPrimary(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show mlag detail", tc.output)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if tc.want != "" && !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyMlagPortChannels(t *testing.T) {
+	const mlag = `{"state": "active", "peerLink": "Port-Channel10", "peerLinkStatus": "up"}`
+	const portChannels = `{"portChannels": {
+  "Port-Channel10": {"activePorts": {"Ethernet49/1": {}}, "inactivePorts": {"Ethernet50/1": {"reasonUnconfigured": "link down"}}},
+  "Port-Channel1": {"activePorts": {"Ethernet1": {}, "Ethernet2": {}}, "inactivePorts": {}},
+  "Port-Channel2": {"activePorts": {}, "inactivePorts": {"Ethernet3": {}}}
+}}`
+	const mlagInterfaces = `{"interfaces": {
+  "1": {"localInterface": "Port-Channel1", "peerInterface": "Port-Channel1", "status": "active-full"},
+  "2": {"localInterface": "Port-Channel2", "peerInterface": "Port-Channel2", "status": "active-partial"}
+}}`
+	cases := []struct {
+		name   string
+		mlag   string
+		inputs map[string]any
+		status test.TestStatus
+		want   string
+	}{
+		{"mlag disabled", `{"state": "disabled"}`, nil, test.TestSkipped, "MLAG is disabled"},
+		{"degraded peer link", mlag, nil, test.TestFailure,
+			"Port-Channel10 degraded: 1/2 members active (inactive: Ethernet50/1)"},
+		{"core bundle down", mlag, map[string]any{"port_channels": []any{"Port-Channel1", "Port-Channel2"}}, test.TestFailure,
+			"Port-Channel2 has no active members"},
+		{"wrong peer link", mlag, map[string]any{"peer_link": "Port-Channel20"}, test.TestFailure,
+			"MLAG peer link is 'Port-Channel10', expected 'Port-Channel20'"},
+		{"discovered bundle down", mlag, nil, test.TestFailure,
+			"Port-Channel2 has no active members"},
+		{"names in any case", mlag, map[string]any{"peer_link": "port-channel10", "port_channels": []any{"port-channel2"}},
+			test.TestFailure, "port-channel2 has no active members"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyMlagPortChannels(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().
+				OnJSON(t, "show mlag", tc.mlag).
+				OnJSON(t, "show mlag interfaces", mlagInterfaces).
+				OnJSON(t, "show port-channel", portChannels)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyMlagPortChannels_HealthyBundles(t *testing.T) {
+	tst, _ := NewVerifyMlagPortChannels(map[string]any{"port_channels": []any{"Port-Channel1"}})
+	dev := device.NewFakeDevice().
+		OnJSON(t, "show mlag", `{"state": "active", "peerLink": "Port-Channel10"}`).
+		OnJSON(t, "show port-channel", `{"portChannels": {
+  "Port-Channel10": {"activePorts": {"Ethernet49/1": {}, "Ethernet50/1": {}}},
+  "Port-Channel1": {"activePorts": {"Ethernet1": {}}}
+}}`)
+	if res := test.RunWithFake(t, tst, dev); res.Status != test.TestSuccess {
+		t.Errorf("status = %v, want success (%s)", res.Status, res.Message)
+	}
+}
@@ -0,0 +1,313 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyNTPAuthentication verifies NTP authentication and trusted keys.
+//
+// EOS does not expose NTP authentication settings in structured output, so
+// this test reads the `ntp authenticate` and `ntp trusted-key` lines from
+// `show running-config section ntp`.
+//
+// The test performs the following checks:
+//  1. Validates that NTP authentication is enabled (or disabled, when
+//     `authenticate: false`).
+//  2. Confirms every key in `trusted_keys` is configured as trusted.
+//
+// Expected Results:
+//   - Success: Authentication matches `authenticate` and all expected keys are trusted.
+//   - Failure: Authentication is off when required, or an expected trusted key is missing.
+//   - Error: Unable to retrieve the NTP configuration.
+//
+// Examples:
+//   - name: VerifyNTPAuthentication
+//     VerifyNTPAuthentication:
+//     authenticate: true
+//     trusted_keys: [1, 2]
+type VerifyNTPAuthentication struct {
+	test.BaseTest
+	Authenticate bool  `yaml:"authenticate" json:"authenticate"`
+	TrustedKeys  []int `yaml:"trusted_keys,omitempty" json:"trusted_keys,omitempty"`
+}
+
+func NewVerifyNTPAuthentication(inputs map[string]any) (test.Test, error) {
+	t := &VerifyNTPAuthentication{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyNTPAuthentication",
+			TestDescription: "Verify NTP authentication and trusted keys",
+			TestCategories:  []string{"system", "time"},
+		},
+		Authenticate: true,
+	}
+
+	if err := test.GetBool(inputs, "authenticate", &t.Authenticate); err != nil {
+		return nil, err
+	}
+
+	if inputs != nil {
+		if keys, ok := inputs["trusted_keys"].([]any); ok {
+			for i, k := range keys {
+				if v, ok := k.(float64); ok {
+					t.TrustedKeys = append(t.TrustedKeys, int(v))
+				} else if v, ok := k.(int); ok {
+					t.TrustedKeys = append(t.TrustedKeys, v)
+				} else {
+					return nil, fmt.Errorf("trusted_keys[%d]: expected number, got %T", i, k)
+				}
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func (t *VerifyNTPAuthentication) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show running-config section ntp",
+		Format:   "text",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get NTP configuration: %v", err)
+		return result, nil
+	}
+
+	ntpConfig, _ := device.TextOutput(cmdResult.Output)
+	authenticate, trusted := parseNTPAuthConfig(ntpConfig)
+
+	issues := []string{}
+	if authenticate != t.Authenticate {
+		if t.Authenticate {
+			issues = append(issues, "NTP authentication is disabled")
+		} else {
+			issues = append(issues, "NTP authentication is unexpectedly enabled")
+		}
+	}
+
+	missing := []string{}
+	for _, key := range t.TrustedKeys {
+		if !trusted[key] {
+			missing = append(missing, strconv.Itoa(key))
+		}
+	}
+	if len(missing) > 0 {
+		issues = append(issues, fmt.Sprintf("trusted keys not configured: %s", strings.Join(missing, ", ")))
+	}
+
+	trustedList := make([]int, 0, len(trusted))
+	for key := range trusted {
+		trustedList = append(trustedList, key)
+	}
+	sort.Ints(trustedList)
+	result.Details = map[string]any{
+		"authenticate": authenticate,
+		"trusted_keys": trustedList,
+	}
+
+	if len(issues) > 0 {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("NTP authentication issues: %s", strings.Join(issues, "; "))
+	} else if authenticate {
+		result.Message = fmt.Sprintf("NTP authentication enabled with %d trusted key(s)", len(trustedList))
+	} else {
+		result.Message = "NTP authentication disabled as expected"
+	}
+
+	return result, nil
+}
+
+func (t *VerifyNTPAuthentication) ValidateInput(input any) error {
+	for i, key := range t.TrustedKeys {
+		if key < 1 || key > 65534 {
+			return fmt.Errorf("trusted_keys[%d]: key ID %d out of range 1-65534", i, key)
+		}
+	}
+	return nil
+}
+
+// VerifyNTPSynchronized verifies the device is synchronized to an NTP
+// server.
+//
+// Unlike VerifyNTP, which accepts candidate associations, this test
+// requires at least one association in the sys.peer condition: a device
+// that is only tracking candidates has not selected a time source yet.
+//
+// Expected Results:
+//   - Success: An association is in the sys.peer condition.
+//   - Failure: No association is in the sys.peer condition, or there are no associations.
+//   - Error: Unable to retrieve the NTP associations.
+//
+// Examples:
+//   - name: VerifyNTPSynchronized
+//     VerifyNTPSynchronized:
+type VerifyNTPSynchronized struct {
+	test.BaseTest
+}
+
+func NewVerifyNTPSynchronized(inputs map[string]any) (test.Test, error) {
+	return &VerifyNTPSynchronized{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyNTPSynchronized",
+			TestDescription: "Verify NTP is synchronized to a system peer",
+			TestCategories:  []string{"system", "time"},
+		},
+	}, nil
+}
+
+func (t *VerifyNTPSynchronized) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show ntp associations",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get NTP associations: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected NTP associations output: %v", err)
+		return result, nil
+	}
+
+	peers, _ := data["peers"].(map[string]any)
+	associations := make([]NTPAssociation, 0, len(peers))
+	for name, peerData := range peers {
+		peer, ok := peerData.(map[string]any)
+		if !ok {
+			continue
+		}
+		assoc := NTPAssociation{PeerAddress: name}
+		assoc.Condition, _ = peer["condition"].(string)
+		if stratum, ok := peer["stratumLevel"].(float64); ok {
+			assoc.Stratum = int(stratum)
+		}
+		associations = append(associations, assoc)
+	}
+	if len(associations) == 0 {
+		result.Status = test.TestFailure
+		result.Message = "No NTP associations"
+		return result, nil
+	}
+
+	// Best first: by condition rank, then stratum, then name so the
+	// reported association is stable.
+	sort.Slice(associations, func(i, j int) bool {
+		a, b := associations[i], associations[j]
+		if ra, rb := ntpConditionRank(a.Condition), ntpConditionRank(b.Condition); ra != rb {
+			return ra < rb
+		}
+		if a.Stratum != b.Stratum {
+			return a.Stratum < b.Stratum
+		}
+		return a.PeerAddress < b.PeerAddress
+	})
+	best := associations[0]
+	result.Details = map[string]any{
+		"best_association": best.PeerAddress,
+		"condition":        best.Condition,
+		"stratum":          best.Stratum,
+	}
+
+	if best.Condition != "sys.peer" {
+		result.Status = test.TestFailure
+		result.Message = fmt.Sprintf("NTP is not synchronized: best association %s is %s", best.PeerAddress, best.Condition)
+		return result, nil
+	}
+
+	result.Message = fmt.Sprintf("Synchronized to %s (stratum %d)", best.PeerAddress, best.Stratum)
+	return result, nil
+}
+
+func (t *VerifyNTPSynchronized) ValidateInput(input any) error {
+	return nil
+}
+
+// ntpConditionRank orders NTP association conditions from the selected
+// system peer down to rejected sources.
+func ntpConditionRank(condition string) int {
+	switch condition {
+	case "sys.peer":
+		return 0
+	case "candidate":
+		return 1
+	case "backup", "excess":
+		return 2
+	case "outlier":
+		return 3
+	case "falsetick":
+		return 4
+	default:
+		return 5
+	}
+}
+
+// parseNTPAuthConfig extracts `ntp authenticate` and the `ntp trusted-key`
+// list (comma-separated IDs and ranges, e.g. "1-3,5") from config text.
+func parseNTPAuthConfig(config string) (bool, map[int]bool) {
+	authenticate := false
+	trusted := map[int]bool{}
+
+	for _, line := range strings.Split(config, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "ntp" {
+			continue
+		}
+		switch fields[1] {
+		case "authenticate":
+			authenticate = true
+		case "trusted-key":
+			if len(fields) < 3 {
+				continue
+			}
+			for _, part := range strings.Split(fields[2], ",") {
+				lo, hi, isRange := strings.Cut(part, "-")
+				start, err := strconv.Atoi(lo)
+				if err != nil {
+					continue
+				}
+				end := start
+				if isRange {
+					if end, err = strconv.Atoi(hi); err != nil {
+						continue
+					}
+				}
+				for k := start; k <= end; k++ {
+					trusted[k] = true
+				}
+			}
+		}
+	}
+
+	return authenticate, trusted
+}
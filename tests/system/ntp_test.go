@@ -0,0 +1,92 @@
+package system
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyNTPAuthentication(t *testing.T) {
+	const authConfig = "ntp authentication-key 1 sha1 7 0207165218120E\n" +
+		"ntp authentication-key 2 sha1 7 0207165218120E\n" +
+		"ntp trusted-key 1-2,5\n" +
+		"ntp authenticate\n" +
+		"ntp server vrf MGMT 10.0.0.1 key 1\n"
+	const noAuthConfig = "ntp server vrf MGMT 10.0.0.1\n"
+
+	cases := []struct {
+		name   string
+		inputs map[string]any
+		config string
+		status test.TestStatus
+		want   string
+	}{
+		{"enabled with keys", map[string]any{"trusted_keys": []any{1, 2, 5}}, authConfig, test.TestSuccess,
+			"3 trusted key(s)"},
+		{"authentication disabled", map[string]any{"trusted_keys": []any{1}}, noAuthConfig, test.TestFailure,
+			"NTP authentication is disabled; trusted keys not configured: 1"},
+		{"missing key", map[string]any{"trusted_keys": []any{1, 3}}, authConfig, test.TestFailure,
+			"trusted keys not configured: 3"},
+		{"disabled as expected", map[string]any{"authenticate": false}, noAuthConfig, test.TestSuccess,
+			"disabled as expected"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyNTPAuthentication(tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tst.ValidateInput(nil); err != nil {
+				t.Fatalf("ValidateInput: %v", err)
+			}
+			dev := device.NewFakeDevice()
+			dev.Outputs["show running-config section ntp"] = map[string]any{"output": tc.config}
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
+
+func TestVerifyNTPSynchronized(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"sys.peer", `{"peers": {
+			"10.0.0.1": {"condition": "candidate", "stratumLevel": 2},
+			"10.0.0.2": {"condition": "sys.peer", "stratumLevel": 3}}}`,
+			test.TestSuccess, "Synchronized to 10.0.0.2 (stratum 3)"},
+		{"candidate only", `{"peers": {
+			"10.0.0.1": {"condition": "candidate", "stratumLevel": 2},
+			"10.0.0.2": {"condition": "reject", "stratumLevel": 16}}}`,
+			test.TestFailure, "best association 10.0.0.1 is candidate"},
+		{"unsynchronized", `{"peers": {"10.0.0.1": {"condition": "reject", "stratumLevel": 16}}}`,
+			test.TestFailure, "best association 10.0.0.1 is reject"},
+		{"no associations", `{"peers": {}}`, test.TestFailure, "No NTP associations"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyNTPSynchronized(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show ntp associations", tc.body)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+// VerifyNoReloadScheduled verifies no reload is scheduled on the device.
+//
+// A pending `reload at`/`reload in` left over from a change window would
+// take the device down unexpectedly, so this is a cheap pre-change check.
+//
+// Expected Results:
+//   - Success: `show reload` reports no scheduled reload.
+//   - Failure: A reload is scheduled; the message gives its time and reason.
+//   - Error: Unable to retrieve the reload schedule.
+//
+// Examples:
+//   - name: VerifyNoReloadScheduled
+//     VerifyNoReloadScheduled:
+type VerifyNoReloadScheduled struct {
+	test.BaseTest
+}
+
+func NewVerifyNoReloadScheduled(inputs map[string]any) (test.Test, error) {
+	return &VerifyNoReloadScheduled{
+		BaseTest: test.BaseTest{
+			TestName:        "VerifyNoReloadScheduled",
+			TestDescription: "Verify no reload is scheduled",
+			TestCategories:  []string{"system", "reload"},
+		},
+	}, nil
+}
+
+func (t *VerifyNoReloadScheduled) Execute(ctx context.Context, dev device.Device) (*test.TestResult, error) {
+	result := &test.TestResult{
+		TestName:   t.Name(),
+		DeviceName: dev.Name(),
+		Status:     test.TestSuccess,
+		Categories: t.Categories(),
+	}
+
+	cmd := device.Command{
+		Template: "show reload",
+		Format:   "json",
+		UseCache: false,
+	}
+
+	cmdResult, err := dev.Execute(ctx, cmd)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Failed to get reload schedule: %v", err)
+		return result, nil
+	}
+
+	data, err := test.AsMap(cmdResult.Output)
+	if err != nil {
+		result.Status = test.TestError
+		result.Message = fmt.Sprintf("Unexpected reload schedule output: %v", err)
+		return result, nil
+	}
+
+	// EOS reports the scheduled reload as reloadTime in epoch seconds and
+	// leaves it out (or zero) when nothing is scheduled.
+	reloadTime, _ := data["reloadTime"].(float64)
+	if reloadTime <= 0 {
+		result.Message = "No reload is scheduled"
+		return result, nil
+	}
+
+	at := time.Unix(int64(reloadTime), 0).UTC()
+	reason, _ := data["reason"].(string)
+	result.Status = test.TestFailure
+	result.Message = fmt.Sprintf("Reload scheduled at %s", at.Format(time.RFC3339))
+	if reason != "" {
+		result.Message += fmt.Sprintf(" (reason: %s)", reason)
+	}
+	result.Details = map[string]any{
+		"reload_time": at.Format(time.RFC3339),
+		"reason":      reason,
+	}
+	return result, nil
+}
+
+func (t *VerifyNoReloadScheduled) ValidateInput(input any) error {
+	return nil
+}
@@ -0,0 +1,39 @@
+package system
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fluidstackio/go-anta/pkg/device"
+	"github.com/fluidstackio/go-anta/pkg/test"
+)
+
+func TestVerifyNoReloadScheduled(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   string
+		status test.TestStatus
+		want   string
+	}{
+		{"not scheduled", `{}`, test.TestSuccess, "No reload is scheduled"},
+		{"zero time", `{"reloadTime": 0}`, test.TestSuccess, "No reload is scheduled"},
+		{"scheduled", `{"reloadTime": 1767225600, "reason": "maintenance"}`, test.TestFailure,
+			"Reload scheduled at 2026-01-01T00:00:00Z (reason: maintenance)"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tst, err := NewVerifyNoReloadScheduled(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev := device.NewFakeDevice().OnJSON(t, "show reload", tc.body)
+			res := test.RunWithFake(t, tst, dev)
+			if res.Status != tc.status {
+				t.Fatalf("status = %v, want %v (%s)", res.Status, tc.status, res.Message)
+			}
+			if !strings.Contains(res.Message, tc.want) {
+				t.Errorf("message %q should contain %q", res.Message, tc.want)
+			}
+		})
+	}
+}
@@ -120,7 +120,7 @@ func (t *VerifyEOSVersion) Execute(ctx context.Context, dev device.Device) (*tes
 		}
 	}
 
-	result.Details = map[string]string{
+	result.Details = map[string]any{
 		"current_version": currentVersion,
 	}
 
@@ -195,6 +195,7 @@ func NewVerifyUptime(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyUptime",
 			TestDescription: "Verify system uptime meets minimum requirements",
 			TestCategories:  []string{"system", "availability"},
+			TestSeverity:    test.SeverityMinor,
 		},
 		MinimumUptime: 3600,
 	}
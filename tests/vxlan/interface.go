@@ -36,6 +36,7 @@ func NewVerifyVxlan1Interface(inputs map[string]any) (test.Test, error) {
 			TestName:        "VerifyVxlan1Interface",
 			TestDescription: "Verify the Vxlan1 interface status",
 			TestCategories:  []string{"vxlan", "interface"},
+			TestSeverity:    test.SeverityCritical,
 		},
 	}
 